@@ -0,0 +1,126 @@
+// Command generate-genesis derives the genesis header of the current epoch of a ValSetDriver and writes it as
+// genesis_header.json:
+//
+//	generate-genesis -driver-address 0x... -rpc-url http://127.0.0.1:8545 -o genesis_header.json
+//
+// Voting power providers and keys providers on other chains than the driver need -chain-rpc chainID=url, once
+// per chain. For a ZK sig verifier, -circuit-version and -compressed-keys select the circuit whose valset hash the
+// extra data holds.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/go-errors/errors"
+
+	"middleware-offchain/pkg/genesis"
+	"middleware-offchain/pkg/proof"
+)
+
+// chainRPCs collects the repeated -chain-rpc flags.
+type chainRPCs map[uint64]string
+
+func (c chainRPCs) String() string {
+	parts := make([]string, 0, len(c))
+	for chainID, url := range c {
+		parts = append(parts, fmt.Sprintf("%d=%s", chainID, url))
+	}
+	return strings.Join(parts, ",")
+}
+
+func (c chainRPCs) Set(value string) error {
+	chain, url, ok := strings.Cut(value, "=")
+	if !ok {
+		return errors.Errorf("want chainID=url, got %q", value)
+	}
+	chainID, err := strconv.ParseUint(chain, 10, 64)
+	if err != nil {
+		return errors.Errorf("invalid chain ID %q: %w", chain, err)
+	}
+	c[chainID] = url
+	return nil
+}
+
+func main() {
+	if err := run(); err != nil {
+		slog.Error("generate-genesis failed", "error", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	rpcs := make(chainRPCs)
+	var (
+		driverAddress = flag.String("driver-address", "", "address of the ValSetDriver")
+		rpcURL        = flag.String("rpc-url", "", "RPC URL of the driver chain")
+		out           = flag.String("o", "", "output file, stdout if empty")
+		timeout       = flag.Duration("timeout", time.Minute, "timeout of all calls")
+		version       = flag.Int("circuit-version", int(proof.CircuitVersionV1), "circuit version of the ZK sig verifier")
+		compressed    = flag.Bool("compressed-keys", false, "the ZK sig verifier's circuit takes compressed keys")
+	)
+	flag.Var(rpcs, "chain-rpc", "chainID=url of another chain the driver config refers to, repeatable")
+	flag.Parse()
+
+	if !common.IsHexAddress(*driverAddress) || *rpcURL == "" {
+		flag.Usage()
+		return errors.Errorf("-driver-address and -rpc-url are required")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	client, err := ethclient.DialContext(ctx, *rpcURL)
+	if err != nil {
+		return errors.Errorf("failed to dial %s: %w", *rpcURL, err)
+	}
+	defer client.Close()
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return errors.Errorf("failed to read the chain ID: %w", err)
+	}
+
+	callers := map[uint64]bind.ContractCaller{chainID.Uint64(): client}
+	for id, url := range rpcs {
+		if _, ok := callers[id]; ok {
+			continue
+		}
+		other, err := ethclient.DialContext(ctx, url)
+		if err != nil {
+			return errors.Errorf("failed to dial %s: %w", url, err)
+		}
+		defer other.Close()
+		callers[id] = other
+	}
+
+	driver := genesis.CrossChainAddress{ChainId: chainID.Uint64(), Addr: common.HexToAddress(*driverAddress)}
+	proofConfig := proof.DefaultConfig()
+	proofConfig.CircuitVersion = proof.CircuitVersion(*version)
+	proofConfig.CompressedKeys = *compressed
+	g, err := genesis.Generate(ctx, genesis.NewContractReader(callers), driver, proofConfig)
+	if err != nil {
+		return err
+	}
+	data, err := genesis.EncodeFile(g)
+	if err != nil {
+		return err
+	}
+	if *out == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	if err := os.WriteFile(*out, data, 0o644); err != nil {
+		return errors.Errorf("failed to write %s: %w", *out, err)
+	}
+	slog.Info("genesis is written", "path", *out, "epoch", g.Header.Epoch, "validatorsSszMRoot", g.Header.ValidatorsSszMRoot)
+	return nil
+}
@@ -0,0 +1,537 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/go-errors/errors"
+
+	"middleware-offchain/pkg/proof"
+)
+
+type tierInfo struct {
+	Tier        proof.Tier  `json:"tier"`
+	Fingerprint common.Hash `json:"fingerprint"`
+}
+
+type setupOutput struct {
+	Backend  string                 `json:"backend"`
+	Manifest proof.ArtifactManifest `json:"manifest"`
+	Tiers    []tierInfo             `json:"tiers"`
+}
+
+// runSetup loads every tier, compiling and writing the artifacts of missing ones.
+func runSetup(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("setup", flag.ContinueOnError)
+	circuit := newCircuitFlags(fs)
+	cfg, err := parseFlags(fs, circuit, args, stderr)
+	if err != nil {
+		return err
+	}
+
+	p, err := newProver(cfg)
+	if err != nil {
+		return err
+	}
+	output := setupOutput{Backend: cfg.Backend.String(), Manifest: cfg.Manifest()}
+	for _, size := range proof.MaxValidators {
+		fingerprint, err := p.Fingerprint(proof.Tier(size))
+		if err != nil {
+			return err
+		}
+		output.Tiers = append(output.Tiers, tierInfo{Tier: proof.Tier(size), Fingerprint: fingerprint})
+	}
+	return writeOutput(stdout, output)
+}
+
+type exportVerifierOutput struct {
+	Files []string `json:"files"`
+}
+
+// runExportVerifier writes Verifier_N.sol of the selected tiers into the output directory.
+func runExportVerifier(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("export-verifier", flag.ContinueOnError)
+	circuit := newCircuitFlags(fs)
+	tier := fs.Int("tier", 0, "tier to export, every tier if 0")
+	out := fs.String("out", ".", "directory to write Verifier_N.sol into")
+	cfg, err := parseFlags(fs, circuit, args, stderr)
+	if err != nil {
+		return err
+	}
+
+	tiers := proof.MaxValidators
+	if *tier != 0 {
+		if !isTier(*tier) {
+			return usagef("unknown tier %d, tiers are %v", *tier, proof.MaxValidators)
+		}
+		tiers = []int{*tier}
+	}
+	if err := os.MkdirAll(*out, 0o755); err != nil {
+		return errors.Errorf("failed to create output directory: %w", err)
+	}
+
+	var output exportVerifierOutput
+	for _, size := range tiers {
+		path := filepath.Join(*out, "Verifier_"+strconv.Itoa(size)+".sol")
+		if err := exportVerifier(cfg, proof.Tier(size), path); err != nil {
+			return err
+		}
+		output.Files = append(output.Files, path)
+	}
+	return writeOutput(stdout, output)
+}
+
+func exportVerifier(cfg proof.Config, tier proof.Tier, path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return errors.Errorf("failed to create verifier: %w", err)
+	}
+	if err := proof.ExportVerifier(cfg, tier, f); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return errors.Errorf("failed to write verifier: %w", err)
+	}
+	return nil
+}
+
+// proveOutput is the result of prove. Proof is in the ProofData.Marshal layout verify and inspect take,
+// SolidityProof in the calldata layout of Verifier_N.sol. The valset and input hashes are only known for proofs
+// of a ProveInput.
+type proveOutput struct {
+	Proof                 hexutil.Bytes `json:"proof"`
+	SolidityProof         hexutil.Bytes `json:"solidityProof"`
+	SignersAggVotingPower *big.Int      `json:"signersAggVotingPower"`
+	SignerBitmap          hexutil.Bytes `json:"signerBitmap,omitempty"`
+	TotalActiveValidators int           `json:"totalActiveValidators,omitempty"`
+	ValsetHash            hexutil.Bytes `json:"valsetHash,omitempty"`
+	PublicInputHash       *common.Hash  `json:"publicInputHash,omitempty"`
+}
+
+// runProve proves a ProveInput JSON file, or a witness exported by proof.ExportWitness.
+func runProve(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("prove", flag.ContinueOnError)
+	circuit := newCircuitFlags(fs)
+	inputPath := fs.String("input", "", "ProveInput JSON file")
+	witnessPath := fs.String("witness", "", "witness file exported by proof.ExportWitness, instead of -input")
+	cfg, err := parseFlags(fs, circuit, args, stderr)
+	if err != nil {
+		return err
+	}
+	if (*inputPath == "") == (*witnessPath == "") {
+		return usagef("either -input or -witness is required")
+	}
+
+	var input proof.ProveInput
+	var witness []byte
+	if *inputPath != "" {
+		if input, err = readProveInput(*inputPath); err != nil {
+			return err
+		}
+	} else {
+		if witness, err = os.ReadFile(*witnessPath); err != nil {
+			return errors.Errorf("failed to read witness: %w", err)
+		}
+	}
+
+	p, err := proof.LoadZkProver(cfg)
+	if err != nil {
+		return err
+	}
+	var proofData proof.ProofData
+	if witness != nil {
+		proofData, err = p.ProveFromWitness(witness)
+	} else {
+		proofData, err = p.Prove(input)
+	}
+	if err != nil {
+		return err
+	}
+	solidityProof, err := proofData.MarshalSolidity()
+	if err != nil {
+		return err
+	}
+
+	output := proveOutput{
+		Proof:                 proofData.Marshal(),
+		SolidityProof:         solidityProof,
+		SignersAggVotingPower: proofData.SignersAggVotingPower,
+		SignerBitmap:          proofData.SignerBitmap,
+	}
+	if witness == nil {
+		normalized, err := proof.NormalizeValset(cfg.CircuitVersion, input.ValidatorData)
+		if err != nil {
+			return err
+		}
+		output.TotalActiveValidators = len(input.ValidatorData)
+		output.ValsetHash = proof.HashValsetWith(cfg.ValsetHash(), normalized)
+		publicInputHash := cfg.PublicInputHash(proofData.SignersAggVotingPower, proof.InputContext{
+			ValsetHash:      output.ValsetHash,
+			MessageG1:       input.MessageG1,
+			MessageHash:     input.MessageHash,
+			SignerBitmap:    proofData.SignerBitmap,
+			QuorumThreshold: input.QuorumThreshold,
+			Epoch:           input.Epoch,
+			KeyTag:          input.KeyTag,
+		})
+		output.PublicInputHash = &publicInputHash
+	}
+	return writeOutput(stdout, output)
+}
+
+type verifyOutput struct {
+	Valid           bool        `json:"valid"`
+	PublicInputHash common.Hash `json:"publicInputHash"`
+}
+
+// runVerify checks a proof against the public input hash, given directly or derived from the header context.
+func runVerify(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("verify", flag.ContinueOnError)
+	circuit := newCircuitFlags(fs)
+	proofHex := fs.String("proof", "", "proof in the ProofData.Marshal layout, hex")
+	totalActiveValidators := fs.Int("total-active-validators", 0, "number of active validators, selects the tier")
+	inputHashHex := fs.String("input-hash", "", "public input hash, instead of the header context")
+	valsetHashHex := fs.String("valset-hash", "", "valset hash of the header")
+	messageHex := fs.String("message", "", "signed message as a raw G1 point, hex")
+	messageHashHex := fs.String("message-hash", "", "signed message hash, hash-to-G1 circuits only")
+	signerBitmapHex := fs.String("bitmap", "", "signer bitmap, signer-bitmap circuits only")
+	quorumThreshold := fs.String("quorum-threshold", "", "quorum threshold, V4 and later")
+	epoch := fs.Uint64("epoch", 0, "epoch, V4 and later")
+	keyTag := fs.Uint("key-tag", 0, "key tag, V4 and later")
+	cfg, err := parseFlags(fs, circuit, args, stderr)
+	if err != nil {
+		return err
+	}
+
+	proofBytes, err := decodeHex("proof", *proofHex)
+	if err != nil {
+		return err
+	}
+	proofData, err := proof.UnmarshalProofData(cfg.Backend, proofBytes)
+	if err != nil {
+		return usagef("%v", err)
+	}
+	if *totalActiveValidators <= 0 {
+		return usagef("-total-active-validators is required")
+	}
+
+	var publicInputHash common.Hash
+	if *inputHashHex != "" {
+		inputHash, err := decodeHex("input hash", *inputHashHex)
+		if err != nil {
+			return err
+		}
+		publicInputHash = common.BytesToHash(inputHash)
+	} else {
+		inputContext, err := parseInputContext(cfg, *valsetHashHex, *messageHex, *messageHashHex, *signerBitmapHex, *quorumThreshold)
+		if err != nil {
+			return err
+		}
+		inputContext.Epoch = *epoch
+		if *keyTag > 255 {
+			return usagef("key tag %d doesn't fit a byte", *keyTag)
+		}
+		inputContext.KeyTag = uint8(*keyTag)
+		publicInputHash = cfg.PublicInputHash(proofData.SignersAggVotingPower, inputContext)
+	}
+
+	if _, err := proof.SelectTier(*totalActiveValidators); err != nil {
+		return err
+	}
+	valid, err := proof.VerifyProof(cfg, *totalActiveValidators, publicInputHash, proofBytes)
+	if errors.Is(err, proof.ErrInvalidProof) {
+		return &invalidProofError{reason: err.Error()}
+	}
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return &invalidProofError{reason: "rejected by the verifier"}
+	}
+	return writeOutput(stdout, verifyOutput{Valid: true, PublicInputHash: publicInputHash})
+}
+
+func parseInputContext(cfg proof.Config, valsetHashHex, messageHex, messageHashHex, signerBitmapHex, quorumThreshold string) (proof.InputContext, error) {
+	var inputContext proof.InputContext
+	var err error
+	if inputContext.ValsetHash, err = decodeHex("valset hash", valsetHashHex); err != nil {
+		return proof.InputContext{}, err
+	}
+	if len(inputContext.ValsetHash) != 32 {
+		return proof.InputContext{}, usagef("-valset-hash or -input-hash is required")
+	}
+
+	if cfg.HashToG1 {
+		messageHash, err := decodeHex("message hash", messageHashHex)
+		if err != nil {
+			return proof.InputContext{}, err
+		}
+		if len(messageHash) != 32 {
+			return proof.InputContext{}, usagef("-message-hash of 32 bytes is required by hash-to-G1 circuits")
+		}
+		copy(inputContext.MessageHash[:], messageHash)
+	} else {
+		message, err := decodeHex("message", messageHex)
+		if err != nil {
+			return proof.InputContext{}, err
+		}
+		if err := inputContext.MessageG1.Unmarshal(message); err != nil {
+			return proof.InputContext{}, usagef("invalid message: %v", err)
+		}
+	}
+
+	if cfg.SignerBitmap {
+		if inputContext.SignerBitmap, err = decodeHex("signer bitmap", signerBitmapHex); err != nil {
+			return proof.InputContext{}, err
+		}
+	}
+	if cfg.CircuitVersion >= proof.CircuitVersionV4 {
+		threshold, ok := new(big.Int).SetString(quorumThreshold, 10)
+		if !ok {
+			return proof.InputContext{}, usagef("-quorum-threshold is required by V4 and later circuits")
+		}
+		inputContext.QuorumThreshold = threshold
+	}
+	return inputContext, nil
+}
+
+type checkVerifierOutput struct {
+	Tier  proof.Tier           `json:"tier"`
+	Diffs []proof.VerifierDiff `json:"diffs"`
+}
+
+// runCheckVerifier reports every verifying-key constant of a Verifier_N.sol that differs from the key on disk.
+func runCheckVerifier(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("check-verifier", flag.ContinueOnError)
+	circuit := newCircuitFlags(fs)
+	tier := fs.Int("tier", 0, "tier of the verifier")
+	solPath := fs.String("sol", "", "Verifier_N.sol to check")
+	cfg, err := parseFlags(fs, circuit, args, stderr)
+	if err != nil {
+		return err
+	}
+	if !isTier(*tier) {
+		return usagef("unknown tier %d, tiers are %v", *tier, proof.MaxValidators)
+	}
+	if *solPath == "" {
+		return usagef("-sol is required")
+	}
+
+	src, err := os.ReadFile(*solPath)
+	if err != nil {
+		return errors.Errorf("failed to read verifier: %w", err)
+	}
+	diffs, err := proof.CheckVerifierDrift(cfg, proof.Tier(*tier), src)
+	if err != nil {
+		return err
+	}
+	if err := writeOutput(stdout, checkVerifierOutput{Tier: proof.Tier(*tier), Diffs: diffs}); err != nil {
+		return err
+	}
+	if len(diffs) > 0 {
+		return &driftError{nbDiffs: len(diffs)}
+	}
+	return nil
+}
+
+// point is a decoded proof point. Valid reports whether it is on the curve and in the subgroup.
+type point struct {
+	Raw   hexutil.Bytes `json:"raw"`
+	Valid bool          `json:"valid"`
+	Error string        `json:"error,omitempty"`
+}
+
+type inspectOutput struct {
+	Backend               string        `json:"backend"`
+	Length                int           `json:"length"`
+	A                     *point        `json:"a,omitempty"`
+	B                     *point        `json:"b,omitempty"`
+	C                     *point        `json:"c,omitempty"`
+	Commitment            *point        `json:"commitment,omitempty"`
+	CommitmentPok         *point        `json:"commitmentPok,omitempty"`
+	PlonkProof            hexutil.Bytes `json:"plonkProof,omitempty"`
+	SignersAggVotingPower *big.Int      `json:"signersAggVotingPower"`
+}
+
+// runInspect decodes a proof without verifying it. A Groth16 proof with a point off the curve or outside the
+// subgroup is reported as invalid.
+func runInspect(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("inspect", flag.ContinueOnError)
+	circuit := newCircuitFlags(fs)
+	proofHex := fs.String("proof", "", "proof in the ProofData.Marshal layout, hex")
+	cfg, err := parseFlags(fs, circuit, args, stderr)
+	if err != nil {
+		return err
+	}
+	proofBytes, err := decodeHex("proof", *proofHex)
+	if err != nil {
+		return err
+	}
+	proofData, err := proof.UnmarshalProofData(cfg.Backend, proofBytes)
+	if err != nil {
+		return usagef("%v", err)
+	}
+
+	output := inspectOutput{
+		Backend:               cfg.Backend.String(),
+		Length:                len(proofBytes),
+		SignersAggVotingPower: proofData.SignersAggVotingPower,
+	}
+	if cfg.Backend == proof.BackendPlonk {
+		output.PlonkProof = proofData.Proof
+		return writeOutput(stdout, output)
+	}
+
+	output.A = decodeG1(proofData.Proof[:64])
+	output.B = decodeG2(proofData.Proof[64:192])
+	output.C = decodeG1(proofData.Proof[192:256])
+	output.Commitment = decodeG1(proofData.Commitments)
+	output.CommitmentPok = decodeG1(proofData.CommitmentPok)
+	if err := writeOutput(stdout, output); err != nil {
+		return err
+	}
+	for _, p := range []struct {
+		name  string
+		point *point
+	}{{"A", output.A}, {"B", output.B}, {"C", output.C}, {"commitment", output.Commitment}, {"commitment pok", output.CommitmentPok}} {
+		if !p.point.Valid {
+			return &invalidProofError{reason: p.name + ": " + p.point.Error}
+		}
+	}
+	return nil
+}
+
+type diagnosticStage struct {
+	Stage  proof.DiagnosticStage `json:"stage"`
+	Error  string                `json:"error,omitempty"`
+	Values map[string]string     `json:"values"`
+}
+
+type diagnoseOutput struct {
+	Tier        proof.Tier            `json:"tier"`
+	FailedStage proof.DiagnosticStage `json:"failedStage,omitempty"`
+	Stages      []diagnosticStage     `json:"stages"`
+}
+
+// runDiagnose checks a ProveInput JSON file against the configured circuit stage by stage, without proving. An
+// input the circuit rejects is reported as a failure, after the report.
+func runDiagnose(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("diagnose", flag.ContinueOnError)
+	circuit := newCircuitFlags(fs)
+	inputPath := fs.String("input", "", "ProveInput JSON file")
+	cfg, err := parseFlags(fs, circuit, args, stderr)
+	if err != nil {
+		return err
+	}
+	if *inputPath == "" {
+		return usagef("-input is required")
+	}
+	input, err := readProveInput(*inputPath)
+	if err != nil {
+		return err
+	}
+
+	report := proof.Diagnose(cfg, input)
+	output := diagnoseOutput{Tier: report.Tier}
+	for _, stage := range report.Stages {
+		result := diagnosticStage{Stage: stage.Stage, Values: stage.Values}
+		if stage.Err != nil {
+			result.Error = stage.Err.Error()
+			output.FailedStage = stage.Stage
+		}
+		output.Stages = append(output.Stages, result)
+	}
+	if err := writeOutput(stdout, output); err != nil {
+		return err
+	}
+	return report.Err()
+}
+
+func readProveInput(path string) (proof.ProveInput, error) {
+	var input proof.ProveInput
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return input, errors.Errorf("failed to read input: %w", err)
+	}
+	if err := json.Unmarshal(data, &input); err != nil {
+		return input, usagef("invalid input: %v", err)
+	}
+	return input, nil
+}
+
+func decodeG1(raw []byte) *point {
+	var p bn254.G1Affine
+	_, err := p.SetBytes(raw)
+	return newPoint(raw, err)
+}
+
+func decodeG2(raw []byte) *point {
+	var p bn254.G2Affine
+	_, err := p.SetBytes(raw)
+	return newPoint(raw, err)
+}
+
+func newPoint(raw []byte, err error) *point {
+	p := &point{Raw: raw, Valid: err == nil}
+	if err != nil {
+		p.Error = err.Error()
+	}
+	return p
+}
+
+// newProver loads the prover of cfg, running the setup of missing tiers. ZkProver panics if its artifacts can't be
+// loaded or generated.
+func newProver(cfg proof.Config) (p *proof.ZkProver, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("failed to load prover: %v", r)
+		}
+	}()
+	return proof.NewZkProverWithConfig(cfg), nil
+}
+
+func isTier(size int) bool {
+	for _, m := range proof.MaxValidators {
+		if m == size {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeHex(name, s string) ([]byte, error) {
+	if s == "" {
+		return nil, nil
+	}
+	data, err := hexutil.Decode(ensure0x(strings.TrimSpace(s)))
+	if err != nil {
+		return nil, usagef("invalid %s: %v", name, err)
+	}
+	return data, nil
+}
+
+func ensure0x(s string) string {
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		return s
+	}
+	return "0x" + s
+}
+
+func writeOutput(w io.Writer, output any) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(output); err != nil {
+		return errors.Errorf("failed to write output: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+// Command relay-zk runs the circuit operations of pkg/proof from scripts:
+//
+//	relay-zk setup            compile every tier and write its artifacts
+//	relay-zk export-verifier  regenerate Verifier_N.sol from the verifying keys
+//	relay-zk prove            prove a ProveInput JSON file
+//	relay-zk verify           verify a proof against its header context
+//	relay-zk inspect          decode a proof and report each component
+//	relay-zk check-verifier   compare a deployed Verifier_N.sol with the verifying key
+//	relay-zk diagnose         report the first circuit stage a ProveInput JSON file fails
+//
+// Every subcommand takes the circuit flags -circuits-dir, -circuit-version, -backend and the mode flags, and
+// prints its result as JSON on stdout. The exit code is 0 on success, 1 if the operation failed, 2 on invalid
+// arguments, 3 if a proof was checked and is invalid and 4 if a verifier doesn't match the verifying key. Only
+// setup generates artifacts, the other subcommands fail if they are missing.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/go-errors/errors"
+
+	"middleware-offchain/pkg/proof"
+)
+
+// Exit codes.
+const (
+	exitOK           = 0
+	exitFailure      = 1
+	exitUsage        = 2
+	exitInvalidProof = 3
+	exitDrift        = 4
+)
+
+// usageError marks errors in the arguments, reported with exitUsage.
+type usageError struct {
+	msg string
+}
+
+func (e *usageError) Error() string {
+	return e.msg
+}
+
+func usagef(format string, args ...any) error {
+	return &usageError{msg: fmt.Sprintf(format, args...)}
+}
+
+// invalidProofError marks proofs that were checked and rejected, reported with exitInvalidProof.
+type invalidProofError struct {
+	reason string
+}
+
+func (e *invalidProofError) Error() string {
+	return "invalid proof: " + e.reason
+}
+
+// driftError marks verifiers whose constants differ from the verifying key, reported with exitDrift.
+type driftError struct {
+	nbDiffs int
+}
+
+func (e *driftError) Error() string {
+	return fmt.Sprintf("verifier differs from the verifying key in %d constants", e.nbDiffs)
+}
+
+type command struct {
+	name  string
+	usage string
+	run   func(args []string, stdout, stderr io.Writer) error
+}
+
+var commands = []command{
+	{name: "setup", usage: "compile every tier and write its artifacts", run: runSetup},
+	{name: "export-verifier", usage: "regenerate Verifier_N.sol from the verifying keys", run: runExportVerifier},
+	{name: "prove", usage: "prove a ProveInput JSON file", run: runProve},
+	{name: "verify", usage: "verify a proof against its header context", run: runVerify},
+	{name: "inspect", usage: "decode a proof and report each component", run: runInspect},
+	{name: "check-verifier", usage: "compare a deployed Verifier_N.sol with the verifying key", run: runCheckVerifier},
+	{name: "diagnose", usage: "report the first circuit stage a ProveInput JSON file fails", run: runDiagnose},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run executes the subcommand in args and returns the exit code.
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		printUsage(stderr)
+		return exitUsage
+	}
+	for _, c := range commands {
+		if c.name != args[0] {
+			continue
+		}
+		err := c.run(args[1:], stdout, stderr)
+		if err == nil {
+			return exitOK
+		}
+		fmt.Fprintf(stderr, "relay-zk %s: %v\n", c.name, err)
+		var usageErr *usageError
+		var invalidProofErr *invalidProofError
+		var driftErr *driftError
+		switch {
+		case errors.As(err, &usageErr):
+			return exitUsage
+		case errors.As(err, &invalidProofErr):
+			return exitInvalidProof
+		case errors.As(err, &driftErr):
+			return exitDrift
+		default:
+			return exitFailure
+		}
+	}
+	fmt.Fprintf(stderr, "relay-zk: unknown command %q\n", args[0])
+	printUsage(stderr)
+	return exitUsage
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, "usage: relay-zk <command> [flags]")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %-16s %s\n", c.name, c.usage)
+	}
+}
+
+// circuitFlags registers the flags selecting the circuit artifacts.
+type circuitFlags struct {
+	circuitsDir    *string
+	circuitVersion *int
+	backend        *string
+	splitInputHash *bool
+	hashToG1       *bool
+	signerBitmap   *bool
+	compressedKeys *bool
+}
+
+func newCircuitFlags(fs *flag.FlagSet) circuitFlags {
+	return circuitFlags{
+		circuitsDir:    fs.String("circuits-dir", "circuits", "directory holding the circuit artifacts"),
+		circuitVersion: fs.Int("circuit-version", int(proof.CircuitVersionV1), "circuit version"),
+		backend:        fs.String("backend", proof.BackendGroth16.String(), "proving backend, groth16 or plonk"),
+		splitInputHash: fs.Bool("split-input-hash", false, "expose the input hash as two public inputs"),
+		hashToG1:       fs.Bool("hash-to-g1", false, "hash the message to G1 in-circuit"),
+		signerBitmap:   fs.Bool("signer-bitmap", false, "bind the signer bitmap into the input hash"),
+		compressedKeys: fs.Bool("compressed-keys", false, "take compressed keys and commit with keccak256"),
+	}
+}
+
+// config selects the circuits directory and returns the configured circuit.
+func (f circuitFlags) config() (proof.Config, error) {
+	backend, err := proof.ParseBackend(*f.backend)
+	if err != nil {
+		return proof.Config{}, usagef("%v", err)
+	}
+	proof.InitCircuitsDir(*f.circuitsDir)
+	return proof.Config{
+		CircuitVersion: proof.CircuitVersion(*f.circuitVersion),
+		Backend:        backend,
+		SplitInputHash: *f.splitInputHash,
+		HashToG1:       *f.hashToG1,
+		SignerBitmap:   *f.signerBitmap,
+		CompressedKeys: *f.compressedKeys,
+	}, nil
+}
+
+// parseFlags parses args into fs and returns the configured circuit. flag reports parse errors with the usage
+// of fs on stderr.
+func parseFlags(fs *flag.FlagSet, circuit circuitFlags, args []string, stderr io.Writer) (proof.Config, error) {
+	fs.SetOutput(stderr)
+	if err := fs.Parse(args); err != nil {
+		return proof.Config{}, usagef("%v", err)
+	}
+	if fs.NArg() > 0 {
+		return proof.Config{}, usagef("unexpected arguments %v", fs.Args())
+	}
+	return circuit.config()
+}
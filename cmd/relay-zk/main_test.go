@@ -0,0 +1,228 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"middleware-offchain/pkg/proof"
+)
+
+const testCircuitsDir = "../../pkg/proof/circuits"
+
+func TestUsageExitCodes(t *testing.T) {
+	for _, args := range [][]string{
+		nil,
+		{"unknown"},
+		{"prove", "-circuits-dir", testCircuitsDir},
+		{"prove", "-backend", "bulletproofs", "-input", "input.json"},
+		{"verify", "-proof", "0x1234"},
+		{"inspect", "-proof", "not hex"},
+		{"export-verifier", "-tier", "7"},
+		{"setup", "extra"},
+		{"check-verifier", "-tier", "10"},
+		{"diagnose"},
+	} {
+		if code, _, stderr := runCommand(args...); code != exitUsage {
+			t.Errorf("relay-zk %v exited with %d, want %d: %s", args, code, exitUsage, stderr)
+		}
+	}
+}
+
+func TestExportVerifier(t *testing.T) {
+	out := t.TempDir()
+	code, _, stderr := runCommand("export-verifier", "-circuits-dir", testCircuitsDir, "-out", out)
+	if code != exitOK {
+		t.Fatalf("export-verifier exited with %d: %s", code, stderr)
+	}
+	exported, err := os.ReadFile(filepath.Join(out, "Verifier_10.sol"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	written, err := os.ReadFile(filepath.Join(testCircuitsDir, "Verifier_10.sol"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(exported, written) {
+		t.Fatal("exported verifier differs from Verifier_10.sol")
+	}
+}
+
+func TestCheckVerifier(t *testing.T) {
+	sol := filepath.Join(testCircuitsDir, "Verifier_10.sol")
+	if code, _, stderr := runCommand("check-verifier", "-circuits-dir", testCircuitsDir, "-tier", "10", "-sol", sol); code != exitOK {
+		t.Fatalf("check-verifier exited with %d: %s", code, stderr)
+	}
+
+	src, err := os.ReadFile(sol)
+	if err != nil {
+		t.Fatal(err)
+	}
+	drifted := filepath.Join(t.TempDir(), "Verifier_10.sol")
+	if err := os.WriteFile(drifted, regexp.MustCompile(`DELTA_NEG_X_0 = \d+;`).ReplaceAll(src, []byte("DELTA_NEG_X_0 = 1;")), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	code, stdout, _ := runCommand("check-verifier", "-circuits-dir", testCircuitsDir, "-tier", "10", "-sol", drifted)
+	if code != exitDrift {
+		t.Fatalf("check-verifier of a drifted verifier exited with %d, want %d", code, exitDrift)
+	}
+	var output checkVerifierOutput
+	if err := json.Unmarshal([]byte(stdout), &output); err != nil {
+		t.Fatal(err)
+	}
+	if len(output.Diffs) != 1 || output.Diffs[0].Constant != "DELTA_NEG_X_0" {
+		t.Fatalf("got diffs %v, want DELTA_NEG_X_0 only", output.Diffs)
+	}
+}
+
+// TestProveVerifyInspect proves an input file with the committed V1 artifacts, and checks verify and inspect
+// accept the proof and reject it for another message or with a tampered point.
+func TestProveVerifyInspect(t *testing.T) {
+	input, message := signedInput(10)
+	data, err := json.Marshal(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inputPath := filepath.Join(t.TempDir(), "input.json")
+	if err := os.WriteFile(inputPath, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	code, stdout, stderr := runCommand("prove", "-circuits-dir", testCircuitsDir, "-input", inputPath)
+	if code != exitOK {
+		t.Fatalf("prove exited with %d: %s", code, stderr)
+	}
+	var proved proveOutput
+	if err := json.Unmarshal([]byte(stdout), &proved); err != nil {
+		t.Fatal(err)
+	}
+	if len(proved.Proof) != 416 {
+		t.Fatalf("got a proof of %d bytes, want 416", len(proved.Proof))
+	}
+
+	verify := func(message string) int {
+		code, _, stderr := runCommand("verify", "-circuits-dir", testCircuitsDir,
+			"-proof", proved.Proof.String(),
+			"-total-active-validators", "10",
+			"-valset-hash", proved.ValsetHash.String(),
+			"-message", message)
+		if code != exitOK {
+			t.Log(stderr)
+		}
+		return code
+	}
+	if code := verify(message); code != exitOK {
+		t.Fatalf("verify exited with %d", code)
+	}
+	otherMessage := signedMessage(43)
+	if code := verify(otherMessage); code != exitInvalidProof {
+		t.Fatalf("verify of another message exited with %d, want %d", code, exitInvalidProof)
+	}
+
+	code, stdout, stderr = runCommand("inspect", "-proof", proved.Proof.String())
+	if code != exitOK {
+		t.Fatalf("inspect exited with %d: %s", code, stderr)
+	}
+	var inspected inspectOutput
+	if err := json.Unmarshal([]byte(stdout), &inspected); err != nil {
+		t.Fatal(err)
+	}
+	if inspected.SignersAggVotingPower.Cmp(big.NewInt(1000)) != 0 || !bytes.Equal(inspected.A.Raw, proved.Proof[:64]) {
+		t.Fatalf("inspect reported %+v", inspected)
+	}
+
+	tampered := bytes.Clone(proved.Proof)
+	tampered[63] ^= 1
+	if code, _, _ := runCommand("inspect", "-proof", hexutil.Encode(tampered)); code != exitInvalidProof {
+		t.Fatalf("inspect of a tampered proof exited with %d, want %d", code, exitInvalidProof)
+	}
+	// a proof that can't be read is a failure, not an invalid proof
+	if code, _, _ := runCommand("verify", "-circuits-dir", testCircuitsDir, "-proof", hexutil.Encode(tampered),
+		"-total-active-validators", "10", "-input-hash", "0x01"); code != exitFailure {
+		t.Fatalf("verify of an unreadable proof exited with %d, want %d", code, exitFailure)
+	}
+
+	// only setup generates artifacts
+	emptyDir := t.TempDir()
+	if code, _, _ := runCommand("verify", "-circuits-dir", emptyDir, "-proof", proved.Proof.String(),
+		"-total-active-validators", "10", "-input-hash", "0x01"); code != exitFailure {
+		t.Fatalf("verify without artifacts exited with %d, want %d", code, exitFailure)
+	}
+	if code, _, _ := runCommand("prove", "-circuits-dir", emptyDir, "-input", inputPath); code != exitFailure {
+		t.Fatalf("prove without artifacts exited with %d, want %d", code, exitFailure)
+	}
+	if entries, err := os.ReadDir(emptyDir); err != nil || len(entries) != 0 {
+		t.Fatalf("verify and prove wrote %d artifacts: %v", len(entries), err)
+	}
+}
+
+func runCommand(args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	code := run(args, &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+// signedInput returns a V1 input where every one of n validators signed, and its message as a hex raw G1 point.
+func TestDiagnose(t *testing.T) {
+	input, _ := signedInput(10)
+	diagnose := func(input proof.ProveInput) (int, diagnoseOutput) {
+		data, err := json.Marshal(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		inputPath := filepath.Join(t.TempDir(), "input.json")
+		if err := os.WriteFile(inputPath, data, 0o600); err != nil {
+			t.Fatal(err)
+		}
+		code, stdout, _ := runCommand("diagnose", "-input", inputPath)
+		var output diagnoseOutput
+		if err := json.Unmarshal([]byte(stdout), &output); err != nil {
+			t.Fatal(err)
+		}
+		return code, output
+	}
+
+	code, output := diagnose(input)
+	if code != exitOK || output.FailedStage != "" || len(output.Stages) != 6 {
+		t.Fatalf("diagnose exited with %d: %+v", code, output)
+	}
+
+	_, _, g1, _ := bn254.Generators()
+	input.Signature.Add(&input.Signature, &g1)
+	code, output = diagnose(input)
+	if code != exitFailure || output.FailedStage != proof.StageSignature || output.Stages[len(output.Stages)-1].Values["alpha"] == "" {
+		t.Fatalf("diagnose of a wrong signature exited with %d: %+v", code, output)
+	}
+}
+
+func signedInput(n int) (proof.ProveInput, string) {
+	_, _, g1, g2 := bn254.Generators()
+	message := new(bn254.G1Affine).ScalarMultiplication(&g1, big.NewInt(42))
+
+	input := proof.ProveInput{ValidatorData: make([]proof.ValidatorData, n), MessageG1: *message}
+	aggPrivateKey := new(big.Int)
+	for i := range input.ValidatorData {
+		privateKey := big.NewInt(int64(i + 10))
+		aggPrivateKey.Add(aggPrivateKey, privateKey)
+		input.ValidatorData[i] = proof.ValidatorData{VotingPower: big.NewInt(100)}
+		input.ValidatorData[i].Key.ScalarMultiplication(&g1, privateKey)
+		input.ValidatorData[i].KeyG2.ScalarMultiplication(&g2, privateKey)
+	}
+	input.Signature.ScalarMultiplication(message, aggPrivateKey)
+	input.SignersAggKeyG2.ScalarMultiplication(&g2, aggPrivateKey)
+	return input, signedMessage(42)
+}
+
+func signedMessage(scalar int64) string {
+	_, _, g1, _ := bn254.Generators()
+	message := new(bn254.G1Affine).ScalarMultiplication(&g1, big.NewInt(scalar))
+	raw := message.RawBytes()
+	return hexutil.Encode(raw[:])
+}
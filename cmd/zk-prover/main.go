@@ -0,0 +1,140 @@
+// Command zk-prover serves a proof.ZkProver on a local, token-authenticated HTTP API, so consumers share one set
+// of loaded proving keys instead of embedding them.
+//
+//	POST /v1/jobs              submit a job from {"witness": WitnessFile} or {"proveInput": ProveInput}
+//	GET  /v1/jobs/{id}         poll a job
+//	GET  /v1/jobs/{id}/events  stream the job status as newline-delimited JSON until it finishes
+//	POST /v1/verify            verify {"totalActiveValidators", "publicInputHash", "proof"}
+//	GET  /v1/tiers             list the loaded tiers with their artifact fingerprints
+//
+// Every request needs the header "Authorization: Bearer <token>", with the token read from ZK_PROVER_TOKEN or
+// -token-file. The service only listens on loopback addresses. Request bodies are limited to 16 MiB, and finished
+// jobs can be polled for an hour.
+package main
+
+import (
+	"context"
+	"flag"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/go-errors/errors"
+
+	"middleware-offchain/pkg/proof"
+)
+
+const tokenEnv = "ZK_PROVER_TOKEN"
+
+func main() {
+	if err := runMain(); err != nil {
+		slog.Error("zk-prover failed", "error", err)
+		os.Exit(1)
+	}
+}
+
+func runMain() error {
+	var (
+		listen         = flag.String("listen", "127.0.0.1:7071", "loopback address to listen on")
+		tokenFile      = flag.String("token-file", "", "file holding the API token, instead of "+tokenEnv)
+		circuitsDir    = flag.String("circuits-dir", "circuits", "directory holding the circuit artifacts")
+		circuitVersion = flag.Int("circuit-version", int(proof.CircuitVersionV1), "circuit version")
+		backend        = flag.String("backend", proof.BackendGroth16.String(), "proving backend, groth16 or plonk")
+		splitInputHash = flag.Bool("split-input-hash", false, "expose the input hash as two public inputs")
+		hashToG1       = flag.Bool("hash-to-g1", false, "hash the message to G1 in-circuit")
+		signerBitmap   = flag.Bool("signer-bitmap", false, "bind the signer bitmap into the input hash")
+		compressedKeys = flag.Bool("compressed-keys", false, "take compressed keys and commit with keccak256")
+	)
+	flag.Parse()
+
+	token, err := readToken(*tokenFile)
+	if err != nil {
+		return err
+	}
+	if err := checkLoopback(*listen); err != nil {
+		return err
+	}
+	cfg := proof.Config{
+		CircuitVersion: proof.CircuitVersion(*circuitVersion),
+		SplitInputHash: *splitInputHash,
+		HashToG1:       *hashToG1,
+		SignerBitmap:   *signerBitmap,
+		CompressedKeys: *compressedKeys,
+	}
+	if cfg.Backend, err = proof.ParseBackend(*backend); err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	proof.InitCircuitsDir(*circuitsDir)
+	prover, err := proof.LoadZkProver(cfg)
+	if err != nil {
+		return errors.Errorf("failed to load the prover: %w", err)
+	}
+	s := newServer(prover, token)
+
+	listener, err := net.Listen("tcp", *listen)
+	if err != nil {
+		return errors.Errorf("failed to listen: %w", err)
+	}
+	slog.Info("zk-prover is listening", "address", listener.Addr().String())
+	return serve(ctx, listener, s)
+}
+
+// serve runs the prover and the API on listener until ctx is done.
+func serve(ctx context.Context, listener net.Listener, s *server) error {
+	go s.run(ctx)
+
+	httpServer := &http.Server{
+		Handler:           s.handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = httpServer.Shutdown(shutdownCtx)
+	}()
+	if err := httpServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return errors.Errorf("failed to serve: %w", err)
+	}
+	return nil
+}
+
+func readToken(tokenFile string) (string, error) {
+	token := os.Getenv(tokenEnv)
+	if tokenFile != "" {
+		data, err := os.ReadFile(tokenFile)
+		if err != nil {
+			return "", errors.Errorf("failed to read token: %w", err)
+		}
+		token = string(data)
+	}
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return "", errors.Errorf("no API token, set %s or -token-file", tokenEnv)
+	}
+	return token, nil
+}
+
+// checkLoopback fails for addresses reachable from other hosts. The API is meant for local consumers only.
+func checkLoopback(address string) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return errors.Errorf("invalid listen address %q: %w", address, err)
+	}
+	if host == "localhost" {
+		return nil
+	}
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return errors.Errorf("listen address %q is not a loopback address", address)
+	}
+	return nil
+}
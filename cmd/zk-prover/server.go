@@ -0,0 +1,417 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/go-errors/errors"
+
+	"middleware-offchain/pkg/proof"
+)
+
+// prover is the part of proof.ZkProver the service runs jobs on.
+type prover interface {
+	Config() proof.Config
+	Prove(proveInput proof.ProveInput) (proof.ProofData, error)
+	ProveFromWitness(data []byte) (proof.ProofData, error)
+	Verify(totalActiveValidators int, publicInputHash common.Hash, proofBytes []byte) (bool, error)
+	Fingerprint(tier proof.Tier) (common.Hash, error)
+}
+
+// jobQueueSize bounds the jobs waiting for the prover. Submissions beyond it are rejected, so a client can't make
+// the service hold an unbounded backlog of witnesses.
+const jobQueueSize = 64
+
+// maxRequestBytes bounds request bodies. A ProveInput of the largest tier takes well below 1 MiB.
+const maxRequestBytes = 16 << 20
+
+// Finished jobs are kept for polling for finishedJobTTL, and at most maxFinishedJobs of them, the oldest being
+// evicted first.
+const (
+	finishedJobTTL  = time.Hour
+	maxFinishedJobs = 1024
+)
+
+// Job states. A job is queued until the prover picks it up, and ends up done or failed.
+const (
+	jobQueued  = "queued"
+	jobProving = "proving"
+	jobDone    = "done"
+	jobFailed  = "failed"
+)
+
+// jobRequest submits a job from either Witness, an exported proof.WitnessFile, or ProveInput.
+type jobRequest struct {
+	Witness    json.RawMessage   `json:"witness,omitempty"`
+	ProveInput *proof.ProveInput `json:"proveInput,omitempty"`
+}
+
+// jobStatus reports a job. Proof is in the ProofData.Marshal layout proof.ZkProver.Verify takes, SolidityProof
+// in the calldata layout of Verifier_N.sol.
+type jobStatus struct {
+	ID                    string        `json:"id"`
+	State                 string        `json:"state"`
+	Error                 string        `json:"error,omitempty"`
+	Proof                 hexutil.Bytes `json:"proof,omitempty"`
+	SolidityProof         hexutil.Bytes `json:"solidityProof,omitempty"`
+	SignersAggVotingPower *big.Int      `json:"signersAggVotingPower,omitempty"`
+	SignerBitmap          hexutil.Bytes `json:"signerBitmap,omitempty"`
+}
+
+func (s jobStatus) finished() bool {
+	return s.State == jobDone || s.State == jobFailed
+}
+
+type job struct {
+	request jobRequest
+
+	mu         sync.Mutex
+	status     jobStatus
+	finishedAt time.Time // zero until the job finishes
+	// changed is closed and replaced on every status update
+	changed chan struct{}
+}
+
+// snapshot returns the current status and a channel closed on its next update.
+func (j *job) snapshot() (jobStatus, <-chan struct{}) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.status, j.changed
+}
+
+func (j *job) update(f func(status *jobStatus)) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	f(&j.status)
+	close(j.changed)
+	j.changed = make(chan struct{})
+}
+
+// tierInfo describes a loaded tier.
+type tierInfo struct {
+	Tier        proof.Tier  `json:"tier"`
+	Fingerprint common.Hash `json:"fingerprint"`
+}
+
+type tiersResponse struct {
+	Backend  string                 `json:"backend"`
+	Manifest proof.ArtifactManifest `json:"manifest"`
+	Tiers    []tierInfo             `json:"tiers"`
+}
+
+type verifyRequest struct {
+	TotalActiveValidators int           `json:"totalActiveValidators"`
+	PublicInputHash       common.Hash   `json:"publicInputHash"`
+	Proof                 hexutil.Bytes `json:"proof"`
+}
+
+type verifyResponse struct {
+	Valid bool   `json:"valid"`
+	Error string `json:"error,omitempty"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// server runs proving jobs one at a time on a single prover, which already uses every core.
+type server struct {
+	prover prover
+	token  []byte
+	queue  chan *job
+
+	// finished jobs are evicted after jobTTL, or beyond maxFinished of them
+	jobTTL      time.Duration
+	maxFinished int
+
+	mu   sync.Mutex
+	jobs map[string]*job
+}
+
+func newServer(p prover, token string) *server {
+	return &server{
+		prover:      p,
+		token:       []byte(token),
+		queue:       make(chan *job, jobQueueSize),
+		jobTTL:      finishedJobTTL,
+		maxFinished: maxFinishedJobs,
+		jobs:        make(map[string]*job),
+	}
+}
+
+// run proves queued jobs until ctx is done.
+func (s *server) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case j := <-s.queue:
+			s.prove(j)
+		}
+	}
+}
+
+func (s *server) prove(j *job) {
+	j.update(func(status *jobStatus) { status.State = jobProving })
+
+	proofData, solidityProof, err := s.proveRequest(j.request)
+	j.update(func(status *jobStatus) {
+		j.finishedAt = time.Now()
+		if err != nil {
+			slog.Warn("Proving job failed", "id", status.ID, "error", err)
+			status.State = jobFailed
+			status.Error = err.Error()
+			return
+		}
+		slog.Info("Proving job is done", "id", status.ID)
+		status.State = jobDone
+		status.Proof = proofData.Marshal()
+		status.SolidityProof = solidityProof
+		status.SignersAggVotingPower = proofData.SignersAggVotingPower
+		status.SignerBitmap = proofData.SignerBitmap
+	})
+	// the inputs are not needed anymore
+	j.request = jobRequest{}
+	s.evictJobs()
+}
+
+// proveRequest proves a job request. A panic of the prover on a malformed request fails the job instead of
+// crashing the service.
+func (s *server) proveRequest(request jobRequest) (proofData proof.ProofData, solidityProof []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			slog.Error("Prover panicked", "panic", r, "stack", string(debug.Stack()))
+			err = errors.Errorf("prover panicked: %v", r)
+		}
+	}()
+	if request.ProveInput != nil {
+		proofData, err = s.prover.Prove(*request.ProveInput)
+	} else {
+		proofData, err = s.prover.ProveFromWitness(request.Witness)
+	}
+	if err != nil {
+		return proof.ProofData{}, nil, err
+	}
+	solidityProof, err = proofData.MarshalSolidity()
+	return proofData, solidityProof, err
+}
+
+// evictJobs drops the finished jobs older than jobTTL, then the oldest finished ones beyond maxFinished.
+func (s *server) evictJobs() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	type finishedJob struct {
+		id         string
+		finishedAt time.Time
+	}
+	var finished []finishedJob
+	for id, j := range s.jobs {
+		j.mu.Lock()
+		finishedAt := j.finishedAt
+		j.mu.Unlock()
+		if finishedAt.IsZero() {
+			continue
+		}
+		if time.Since(finishedAt) > s.jobTTL {
+			delete(s.jobs, id)
+			continue
+		}
+		finished = append(finished, finishedJob{id: id, finishedAt: finishedAt})
+	}
+	if len(finished) <= s.maxFinished {
+		return
+	}
+	sort.Slice(finished, func(i, k int) bool { return finished[i].finishedAt.Before(finished[k].finishedAt) })
+	for _, f := range finished[:len(finished)-s.maxFinished] {
+		delete(s.jobs, f.id)
+	}
+}
+
+// handler returns the API. Every route needs the bearer token.
+func (s *server) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/jobs", s.handleSubmit)
+	mux.HandleFunc("GET /v1/jobs/{id}", s.handleStatus)
+	mux.HandleFunc("GET /v1/jobs/{id}/events", s.handleEvents)
+	mux.HandleFunc("POST /v1/verify", s.handleVerify)
+	mux.HandleFunc("GET /v1/tiers", s.handleTiers)
+	return s.authenticate(mux)
+}
+
+func (s *server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), s.token) != 1 {
+			writeJSON(w, http.StatusUnauthorized, errorResponse{Error: "invalid token"})
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *server) handleSubmit(w http.ResponseWriter, r *http.Request) {
+	var request jobRequest
+	if err := decodeRequest(w, r, &request); err != nil {
+		writeJSON(w, requestErrorStatus(err), errorResponse{Error: "invalid job: " + err.Error()})
+		return
+	}
+	if (request.ProveInput == nil) == (len(request.Witness) == 0) {
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: "a job needs either a witness or a prove input"})
+		return
+	}
+
+	id, err := newJobID()
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, errorResponse{Error: err.Error()})
+		return
+	}
+	j := &job{
+		request: request,
+		status:  jobStatus{ID: id, State: jobQueued},
+		changed: make(chan struct{}),
+	}
+	// the job is known before the worker can pick it up, so its status is never missing
+	s.mu.Lock()
+	s.jobs[id] = j
+	s.mu.Unlock()
+	select {
+	case s.queue <- j:
+	default:
+		s.mu.Lock()
+		delete(s.jobs, id)
+		s.mu.Unlock()
+		writeJSON(w, http.StatusServiceUnavailable, errorResponse{Error: "job queue is full"})
+		return
+	}
+
+	slog.Info("Proving job queued", "id", id)
+	status, _ := j.snapshot()
+	writeJSON(w, http.StatusAccepted, status)
+}
+
+func (s *server) handleStatus(w http.ResponseWriter, r *http.Request) {
+	j, ok := s.job(r.PathValue("id"))
+	if !ok {
+		writeJSON(w, http.StatusNotFound, errorResponse{Error: "unknown job"})
+		return
+	}
+	status, _ := j.snapshot()
+	writeJSON(w, http.StatusOK, status)
+}
+
+// handleEvents streams the job status as newline-delimited JSON, once now and on every change, until the job
+// finishes or the client goes away.
+func (s *server) handleEvents(w http.ResponseWriter, r *http.Request) {
+	j, ok := s.job(r.PathValue("id"))
+	if !ok {
+		writeJSON(w, http.StatusNotFound, errorResponse{Error: "unknown job"})
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeJSON(w, http.StatusInternalServerError, errorResponse{Error: "streaming is not supported"})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+	encoder := json.NewEncoder(w)
+	for {
+		status, changed := j.snapshot()
+		if err := encoder.Encode(status); err != nil {
+			return
+		}
+		flusher.Flush()
+		if status.finished() {
+			return
+		}
+		select {
+		case <-r.Context().Done():
+			return
+		case <-changed:
+		}
+	}
+}
+
+func (s *server) handleVerify(w http.ResponseWriter, r *http.Request) {
+	var request verifyRequest
+	if err := decodeRequest(w, r, &request); err != nil {
+		writeJSON(w, requestErrorStatus(err), errorResponse{Error: "invalid verify request: " + err.Error()})
+		return
+	}
+	valid, err := s.prover.Verify(request.TotalActiveValidators, request.PublicInputHash, request.Proof)
+	response := verifyResponse{Valid: valid && err == nil}
+	if err != nil {
+		response.Error = err.Error()
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *server) handleTiers(w http.ResponseWriter, _ *http.Request) {
+	cfg := s.prover.Config()
+	response := tiersResponse{
+		Backend:  cfg.Backend.String(),
+		Manifest: cfg.Manifest(),
+		Tiers:    make([]tierInfo, 0, len(proof.MaxValidators)),
+	}
+	for _, size := range proof.MaxValidators {
+		fingerprint, err := s.prover.Fingerprint(proof.Tier(size))
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, errorResponse{Error: err.Error()})
+			return
+		}
+		response.Tiers = append(response.Tiers, tierInfo{Tier: proof.Tier(size), Fingerprint: fingerprint})
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *server) job(id string) (*job, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	j, ok := s.jobs[id]
+	return j, ok
+}
+
+// decodeRequest decodes a JSON body of at most maxRequestBytes.
+func decodeRequest(w http.ResponseWriter, r *http.Request, request any) error {
+	return json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBytes)).Decode(request)
+}
+
+// requestErrorStatus returns the status code of a decodeRequest error.
+func requestErrorStatus(err error) int {
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		return http.StatusRequestEntityTooLarge
+	}
+	return http.StatusBadRequest
+}
+
+func newJobID() (string, error) {
+	var id [16]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(id[:]), nil
+}
+
+func writeJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		slog.Debug("Failed to write response", "error", err)
+	}
+}
@@ -0,0 +1,299 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"middleware-offchain/pkg/proof"
+)
+
+const testToken = "test-token"
+
+// TestServer runs the service on localhost with the V1 artifacts in pkg/proof/circuits, proves the same input once
+// from a ProveInput and once from an exported witness, and verifies the proof through the API. The artifacts are
+// not committed, so it is skipped until relay-zk setup generated them.
+func TestServer(t *testing.T) {
+	proof.InitCircuitsDir("../../pkg/proof/circuits")
+	prover, err := proof.LoadZkProver(proof.DefaultConfig())
+	if err != nil {
+		t.Skipf("no artifacts to prove with: %v", err)
+	}
+	c := startServer(t, newServer(prover, testToken))
+
+	// unauthenticated requests are rejected
+	unauthenticated := &client{t: t, url: c.url, token: "wrong"}
+	if code := unauthenticated.do(http.MethodGet, "/v1/tiers", nil, nil); code != http.StatusUnauthorized {
+		t.Fatalf("got status %d without a valid token", code)
+	}
+
+	var tiers tiersResponse
+	if code := c.do(http.MethodGet, "/v1/tiers", nil, &tiers); code != http.StatusOK {
+		t.Fatalf("listing tiers failed with status %d", code)
+	}
+	fingerprint, err := proof.ArtifactFingerprint(proof.DefaultConfig(), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tiers.Tiers) != len(proof.MaxValidators) || tiers.Tiers[0].Tier != 10 || tiers.Tiers[0].Fingerprint != fingerprint {
+		t.Fatalf("got tiers %+v, want tier 10 with fingerprint %s", tiers.Tiers, fingerprint)
+	}
+
+	input, inputHash := signedInput(t, 10)
+	witness, err := proof.ExportWitness(proof.DefaultConfig(), input)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// polled ProveInput job
+	var submitted jobStatus
+	if code := c.do(http.MethodPost, "/v1/jobs", jobRequest{ProveInput: &input}, &submitted); code != http.StatusAccepted {
+		t.Fatalf("submitting a job failed with status %d", code)
+	}
+	status := c.poll(submitted.ID)
+	if status.State != jobDone {
+		t.Fatalf("job failed: %s", status.Error)
+	}
+	c.assertValid(len(input.ValidatorData), inputHash, status.Proof, true)
+
+	// streamed witness job
+	if code := c.do(http.MethodPost, "/v1/jobs", jobRequest{Witness: witness}, &submitted); code != http.StatusAccepted {
+		t.Fatalf("submitting a job failed with status %d", code)
+	}
+	status = c.stream(submitted.ID)
+	if status.State != jobDone {
+		t.Fatalf("job failed: %s", status.Error)
+	}
+	c.assertValid(len(input.ValidatorData), inputHash, status.Proof, true)
+	c.assertValid(len(input.ValidatorData), common.Hash{1}, status.Proof, false)
+
+	if code := c.do(http.MethodPost, "/v1/jobs", jobRequest{}, nil); code != http.StatusBadRequest {
+		t.Fatalf("got status %d for an empty job", code)
+	}
+	if code := c.do(http.MethodGet, "/v1/jobs/unknown", nil, nil); code != http.StatusNotFound {
+		t.Fatalf("got status %d for an unknown job", code)
+	}
+}
+
+// panickingProver panics on prove inputs and fails on witnesses.
+type panickingProver struct{}
+
+func (panickingProver) Config() proof.Config { return proof.DefaultConfig() }
+
+func (panickingProver) Prove(proof.ProveInput) (proof.ProofData, error) {
+	panic("malformed input")
+}
+
+func (panickingProver) ProveFromWitness([]byte) (proof.ProofData, error) {
+	return proof.ProofData{}, errors.New("malformed witness")
+}
+
+func (panickingProver) Verify(int, common.Hash, []byte) (bool, error) { return false, nil }
+
+func (panickingProver) Fingerprint(proof.Tier) (common.Hash, error) { return common.Hash{}, nil }
+
+// TestServerFailures checks that a panicking prover fails the job, that oversized bodies are rejected and that
+// finished jobs are evicted beyond the cap.
+func TestServerFailures(t *testing.T) {
+	s := newServer(panickingProver{}, testToken)
+	s.maxFinished = 1
+	c := startServer(t, s)
+
+	input, _ := signedInput(t, 1)
+	var panicked, failed jobStatus
+	if code := c.do(http.MethodPost, "/v1/jobs", jobRequest{ProveInput: &input}, &panicked); code != http.StatusAccepted {
+		t.Fatalf("submitting a job failed with status %d", code)
+	}
+	if status := c.poll(panicked.ID); status.State != jobFailed || !strings.Contains(status.Error, "panicked") {
+		t.Fatalf("got job %+v", status)
+	}
+
+	// the service survived the panic; the second finished job evicts the first
+	if code := c.do(http.MethodPost, "/v1/jobs", jobRequest{Witness: json.RawMessage(`"0x01"`)}, &failed); code != http.StatusAccepted {
+		t.Fatalf("submitting a job failed with status %d", code)
+	}
+	if status := c.poll(failed.ID); status.State != jobFailed {
+		t.Fatalf("got job %+v", status)
+	}
+	if code := c.do(http.MethodGet, "/v1/jobs/"+panicked.ID, nil, nil); code != http.StatusNotFound {
+		t.Fatalf("got status %d for an evicted job", code)
+	}
+
+	oversized := jobRequest{Witness: json.RawMessage(`"` + strings.Repeat("0", maxRequestBytes) + `"`)}
+	if code := c.do(http.MethodPost, "/v1/jobs", oversized, nil); code != http.StatusRequestEntityTooLarge {
+		t.Fatalf("got status %d for an oversized job", code)
+	}
+}
+
+func TestCheckLoopback(t *testing.T) {
+	for address, loopback := range map[string]bool{
+		"127.0.0.1:7071": true,
+		"[::1]:7071":     true,
+		"localhost:7071": true,
+		"0.0.0.0:7071":   false,
+		":7071":          false,
+		"10.0.0.1:7071":  false,
+	} {
+		if err := checkLoopback(address); (err == nil) != loopback {
+			t.Errorf("checkLoopback(%q) = %v", address, err)
+		}
+	}
+}
+
+// startServer serves s on localhost until the test ends.
+func startServer(t *testing.T, s *server) *client {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+	go func() { served <- serve(ctx, listener, s) }()
+	t.Cleanup(func() {
+		cancel()
+		if err := <-served; err != nil {
+			t.Error(err)
+		}
+	})
+	return &client{t: t, url: "http://" + listener.Addr().String(), token: testToken}
+}
+
+type client struct {
+	t     *testing.T
+	url   string
+	token string
+}
+
+// do sends body as JSON and decodes the response into response, if given. It returns the status code.
+func (c *client) do(method, path string, body, response any) int {
+	c.t.Helper()
+	resp := c.send(method, path, body)
+	defer resp.Body.Close()
+	if response != nil && resp.StatusCode < 300 {
+		if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+			c.t.Fatal(err)
+		}
+	}
+	return resp.StatusCode
+}
+
+func (c *client) send(method, path string, body any) *http.Response {
+	c.t.Helper()
+	var reader bytes.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			c.t.Fatal(err)
+		}
+		reader.Reset(data)
+	}
+	req, err := http.NewRequest(method, c.url+path, &reader)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	return resp
+}
+
+func (c *client) poll(id string) jobStatus {
+	c.t.Helper()
+	deadline := time.Now().Add(5 * time.Minute)
+	for time.Now().Before(deadline) {
+		var status jobStatus
+		if code := c.do(http.MethodGet, "/v1/jobs/"+id, nil, &status); code != http.StatusOK {
+			c.t.Fatalf("polling job failed with status %d", code)
+		}
+		if status.finished() {
+			return status
+		}
+		time.Sleep(time.Second)
+	}
+	c.t.Fatal("job didn't finish")
+	return jobStatus{}
+}
+
+// stream reads the status events of a job and returns the last one. The job must be reported as proving before
+// it finishes.
+func (c *client) stream(id string) jobStatus {
+	c.t.Helper()
+	resp := c.send(http.MethodGet, "/v1/jobs/"+id+"/events", nil)
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		c.t.Fatalf("streaming job failed with status %d", resp.StatusCode)
+	}
+
+	var states []string
+	var status jobStatus
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		if err := json.Unmarshal(scanner.Bytes(), &status); err != nil {
+			c.t.Fatal(err)
+		}
+		states = append(states, status.State)
+	}
+	if err := scanner.Err(); err != nil {
+		c.t.Fatal(err)
+	}
+	if len(states) < 2 || states[len(states)-2] != jobProving || !status.finished() {
+		c.t.Fatalf("got states %v", states)
+	}
+	return status
+}
+
+func (c *client) assertValid(totalActiveValidators int, inputHash common.Hash, proofBytes []byte, valid bool) {
+	c.t.Helper()
+	var response verifyResponse
+	request := verifyRequest{TotalActiveValidators: totalActiveValidators, PublicInputHash: inputHash, Proof: proofBytes}
+	if code := c.do(http.MethodPost, "/v1/verify", request, &response); code != http.StatusOK {
+		c.t.Fatalf("verifying failed with status %d", code)
+	}
+	if response.Valid != valid {
+		c.t.Fatalf("got valid %v (%s), want %v", response.Valid, response.Error, valid)
+	}
+}
+
+// signedInput returns a V1 input where every one of n validators signed, and its public input hash.
+func signedInput(t *testing.T, n int) (proof.ProveInput, common.Hash) {
+	t.Helper()
+	_, _, g1, g2 := bn254.Generators()
+	message := new(bn254.G1Affine).ScalarMultiplication(&g1, big.NewInt(42))
+
+	input := proof.ProveInput{ValidatorData: make([]proof.ValidatorData, n), MessageG1: *message}
+	aggPrivateKey := new(big.Int)
+	for i := range input.ValidatorData {
+		privateKey := big.NewInt(int64(i + 10))
+		aggPrivateKey.Add(aggPrivateKey, privateKey)
+		input.ValidatorData[i] = proof.ValidatorData{VotingPower: big.NewInt(100)}
+		input.ValidatorData[i].Key.ScalarMultiplication(&g1, privateKey)
+		input.ValidatorData[i].KeyG2.ScalarMultiplication(&g2, privateKey)
+	}
+	input.Signature.ScalarMultiplication(message, aggPrivateKey)
+	input.SignersAggKeyG2.ScalarMultiplication(&g2, aggPrivateKey)
+
+	normalized, err := proof.NormalizeValset(proof.CircuitVersionV1, input.ValidatorData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signersVotingPower := make([]byte, 32)
+	big.NewInt(int64(100 * n)).FillBytes(signersVotingPower)
+	messageBytes := message.RawBytes()
+	return input, crypto.Keccak256Hash(proof.HashValset(normalized), signersVotingPower, messageBytes[:])
+}
@@ -0,0 +1,20 @@
+// Package bindings holds the abigen bindings of the relay contracts, generated from the ABIs in abi/, and
+// conversions between their structs and the pkg/proof types.
+//
+// Each ABI is the one of a deployed module: its interface and the base interfaces the module implements, so
+// ValSetDriver also has the IEpochManager functions and KeyRegistry the IOzEIP712 ones. After changing an
+// interface, update abi/ from `forge inspect <Interface> abi` of every interface listed below and run go generate.
+package bindings
+
+// Settlement: ISettlement, INetworkManager, IOzEIP712
+//go:generate go run github.com/ethereum/go-ethereum/cmd/abigen@v1.15.11 --abi abi/Settlement.json --pkg bindings --type Settlement --out settlement.go
+// ValSetDriver: IValSetDriver, IEpochManager, INetworkManager
+//go:generate go run github.com/ethereum/go-ethereum/cmd/abigen@v1.15.11 --abi abi/ValSetDriver.json --pkg bindings --type ValSetDriver --out val_set_driver.go
+// KeyRegistry: IKeyRegistry, IOzEIP712
+//go:generate go run github.com/ethereum/go-ethereum/cmd/abigen@v1.15.11 --abi abi/KeyRegistry.json --pkg bindings --type KeyRegistry --out key_registry.go
+// VotingPowerProvider: IVotingPowerProvider, INetworkManager, IOzEIP712
+//go:generate go run github.com/ethereum/go-ethereum/cmd/abigen@v1.15.11 --abi abi/VotingPowerProvider.json --pkg bindings --type VotingPowerProvider --out voting_power_provider.go
+// SigVerifier: ISigVerifier
+//go:generate go run github.com/ethereum/go-ethereum/cmd/abigen@v1.15.11 --abi abi/SigVerifier.json --pkg bindings --type SigVerifier --out sig_verifier.go
+// Verifier: IVerifier
+//go:generate go run github.com/ethereum/go-ethereum/cmd/abigen@v1.15.11 --abi abi/Verifier.json --pkg bindings --type Verifier --out verifier.go
@@ -0,0 +1,365 @@
+package bindings
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-errors/errors"
+
+	"middleware-offchain/pkg/proof"
+)
+
+// mockBackend is a bind.ContractBackend serving the view functions of one contract from handlers and recording
+// the transactions sent to it.
+type mockBackend struct {
+	abi      *abi.ABI
+	handlers map[string]func(args []any) ([]any, error)
+	sent     []*types.Transaction
+}
+
+func newMockBackend(t *testing.T, metaData *bind.MetaData) *mockBackend {
+	parsed, err := metaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &mockBackend{abi: parsed, handlers: make(map[string]func([]any) ([]any, error))}
+}
+
+func (b *mockBackend) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{0x01}, nil
+}
+
+func (b *mockBackend) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	method, err := b.abi.MethodById(call.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	handler, ok := b.handlers[method.Name]
+	if !ok {
+		return nil, errors.Errorf("unexpected call of %s", method.Name)
+	}
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	results, err := handler(args)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(results...)
+}
+
+func (b *mockBackend) HeaderByNumber(context.Context, *big.Int) (*types.Header, error) {
+	return &types.Header{Number: big.NewInt(1), BaseFee: big.NewInt(1)}, nil
+}
+
+func (b *mockBackend) PendingCodeAt(context.Context, common.Address) ([]byte, error) {
+	return []byte{0x01}, nil
+}
+
+func (b *mockBackend) PendingNonceAt(context.Context, common.Address) (uint64, error) {
+	return uint64(len(b.sent)), nil
+}
+
+func (b *mockBackend) SuggestGasPrice(context.Context) (*big.Int, error) {
+	return big.NewInt(1), nil
+}
+
+func (b *mockBackend) SuggestGasTipCap(context.Context) (*big.Int, error) {
+	return big.NewInt(1), nil
+}
+
+func (b *mockBackend) EstimateGas(context.Context, ethereum.CallMsg) (uint64, error) {
+	return 1_000_000, nil
+}
+
+func (b *mockBackend) SendTransaction(_ context.Context, tx *types.Transaction) error {
+	b.sent = append(b.sent, tx)
+	return nil
+}
+
+func (b *mockBackend) FilterLogs(context.Context, ethereum.FilterQuery) ([]types.Log, error) {
+	return nil, nil
+}
+
+func (b *mockBackend) SubscribeFilterLogs(context.Context, ethereum.FilterQuery, chan<- types.Log) (ethereum.Subscription, error) {
+	return nil, errors.Errorf("subscriptions are not supported")
+}
+
+var contractAddress = common.HexToAddress("0x5e")
+
+func testHeader() proof.ValSetHeader {
+	return proof.ValSetHeader{
+		Version:            1,
+		RequiredKeyTag:     15,
+		Epoch:              7,
+		CaptureTimestamp:   1_750_000_000,
+		QuorumThreshold:    big.NewInt(467),
+		TotalVotingPower:   big.NewInt(700),
+		ValidatorsSszMRoot: common.HexToHash("0x55"),
+	}
+}
+
+func TestSettlementHeader(t *testing.T) {
+	header := testHeader()
+	extraDataKey := common.HexToHash("0x87a67850cbb7616e7e927c1dcc669f1e70010471a73855d240f5837529ae1430")
+
+	backend := newMockBackend(t, SettlementMetaData)
+	backend.handlers["getValSetHeaderAt"] = func(args []any) ([]any, error) {
+		if args[0].(*big.Int).Uint64() != header.Epoch {
+			return nil, errors.Errorf("no header at epoch %s", args[0])
+		}
+		return []any{NewValSetHeader(header)}, nil
+	}
+	backend.handlers["getExtraDataAt"] = func(args []any) ([]any, error) {
+		if args[1].([32]byte) != extraDataKey {
+			return []any{common.Hash{}}, nil
+		}
+		return []any{common.BigToHash(big.NewInt(2))}, nil
+	}
+	settlement, err := NewSettlement(contractAddress, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	read, err := settlement.GetValSetHeaderAt(nil, big.NewInt(7))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ProofValSetHeader(read)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Hash() != header.Hash() {
+		t.Fatalf("got header %+v, want %+v", got, header)
+	}
+	if _, err := settlement.GetValSetHeaderAt(nil, big.NewInt(8)); err == nil {
+		t.Fatal("read a header of an uncommitted epoch")
+	}
+
+	value, err := settlement.GetExtraDataAt(nil, big.NewInt(7), extraDataKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if common.Hash(value).Big().Int64() != 2 {
+		t.Fatalf("got extra data %x", value)
+	}
+
+	read.Epoch = new(big.Int).Lsh(big.NewInt(1), 48)
+	if _, err := ProofValSetHeader(read); err == nil {
+		t.Fatal("converted an epoch beyond uint48")
+	}
+}
+
+func TestCommitValSetHeader(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(31337))
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend := newMockBackend(t, SettlementMetaData)
+	settlement, err := NewSettlement(contractAddress, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	header := testHeader()
+	extraData := []ISettlementExtraData{NewExtraData(common.HexToHash("0x01"), common.HexToHash("0x02"))}
+	proofBytes := []byte{0xaa, 0xbb}
+	if _, err := settlement.CommitValSetHeader(opts, NewValSetHeader(header), extraData, proofBytes); err != nil {
+		t.Fatal(err)
+	}
+	if len(backend.sent) != 1 || *backend.sent[0].To() != contractAddress {
+		t.Fatalf("sent %d transactions", len(backend.sent))
+	}
+
+	data := backend.sent[0].Data()
+	method, err := backend.abi.MethodById(data[:4])
+	if err != nil {
+		t.Fatal(err)
+	}
+	args, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		sentHeader    ISettlementValSetHeader
+		sentExtraData []ISettlementExtraData
+	)
+	abi.ConvertType(args[0], &sentHeader)
+	abi.ConvertType(args[1], &sentExtraData)
+	sent, err := ProofValSetHeader(sentHeader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if method.Name != "commitValSetHeader" || sent.Hash() != header.Hash() ||
+		ExtraDataMap(sentExtraData)[common.HexToHash("0x01")] != common.HexToHash("0x02") || string(args[2].([]byte)) != string(proofBytes) {
+		t.Fatalf("sent %s(%v)", method.Name, args)
+	}
+}
+
+func TestCommitValSetHeaderEvent(t *testing.T) {
+	parsed, err := SettlementMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	header := NewValSetHeader(testHeader())
+	extraData := []ISettlementExtraData{NewExtraData(common.HexToHash("0x01"), common.HexToHash("0x02"))}
+	event := parsed.Events["CommitValSetHeader"]
+	data, err := event.Inputs.NonIndexed().Pack(header, extraData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	filterer, err := NewSettlementFilterer(contractAddress, newMockBackend(t, SettlementMetaData))
+	if err != nil {
+		t.Fatal(err)
+	}
+	committed, err := filterer.ParseCommitValSetHeader(types.Log{Address: contractAddress, Topics: []common.Hash{event.ID}, Data: data})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ProofValSetHeader(committed.ValSetHeader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Hash() != testHeader().Hash() || len(committed.ExtraData) != 1 || committed.ExtraData[0] != extraData[0] {
+		t.Fatalf("parsed %+v", committed)
+	}
+}
+
+func TestValSetDriverConfig(t *testing.T) {
+	config := IValSetDriverConfig{
+		NumAggregators:          big.NewInt(1),
+		NumCommitters:           big.NewInt(1),
+		VotingPowerProviders:    []IValSetDriverCrossChainAddress{{ChainId: 111, Addr: common.HexToAddress("0xa0")}},
+		KeysProvider:            IValSetDriverCrossChainAddress{ChainId: 111, Addr: common.HexToAddress("0xa0")},
+		Settlements:             []IValSetDriverCrossChainAddress{{ChainId: 222, Addr: common.HexToAddress("0xb0")}},
+		MaxVotingPower:          big.NewInt(400),
+		MinInclusionVotingPower: big.NewInt(100),
+		MaxValidatorsCount:      big.NewInt(10),
+		RequiredKeyTags:         []uint8{15},
+		QuorumThresholds:        []IValSetDriverQuorumThreshold{{KeyTag: 15, QuorumThreshold: big.NewInt(666_666_666_666_666_667)}},
+		RequiredHeaderKeyTag:    15,
+		VerificationType:        0,
+	}
+	backend := newMockBackend(t, ValSetDriverMetaData)
+	backend.handlers["getCurrentEpoch"] = func([]any) ([]any, error) {
+		return []any{big.NewInt(3)}, nil
+	}
+	backend.handlers["getConfigAt"] = func([]any) ([]any, error) {
+		return []any{config}, nil
+	}
+	driver, err := NewValSetDriverCaller(contractAddress, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	epoch, err := driver.GetCurrentEpoch(nil)
+	if err != nil || epoch.Int64() != 3 {
+		t.Fatalf("got epoch %v, %v", epoch, err)
+	}
+	got, err := driver.GetConfigAt(nil, big.NewInt(1_750_000_000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Settlements[0] != config.Settlements[0] || got.MaxVotingPower.Int64() != 400 || got.RequiredHeaderKeyTag != 15 {
+		t.Fatalf("got config %+v", got)
+	}
+	if threshold, ok := got.QuorumThreshold(15); !ok || threshold.Cmp(config.QuorumThresholds[0].QuorumThreshold) != 0 {
+		t.Fatalf("got quorum threshold %v", threshold)
+	}
+	if _, ok := got.QuorumThreshold(16); ok {
+		t.Fatal("found a quorum threshold of a key tag without one")
+	}
+}
+
+func TestVotingPowersAndKeys(t *testing.T) {
+	operator := common.HexToAddress("0x01")
+	providerBackend := newMockBackend(t, VotingPowerProviderMetaData)
+	providerBackend.handlers["getVotingPowersAt"] = func([]any) ([]any, error) {
+		return []any{[]IVotingPowerProviderOperatorVotingPower{{
+			Operator: operator,
+			Vaults: []IVotingPowerProviderVaultValue{
+				{Vault: common.HexToAddress("0xee01"), Value: big.NewInt(300)},
+				{Vault: common.HexToAddress("0xee02"), Value: big.NewInt(200)},
+			},
+		}}}, nil
+	}
+	provider, err := NewVotingPowerProviderCaller(contractAddress, providerBackend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	votingPowers, err := provider.GetVotingPowersAt(nil, [][]byte{}, big.NewInt(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(votingPowers) != 1 || votingPowers[0].Operator != operator || votingPowers[0].VotingPower().Int64() != 500 {
+		t.Fatalf("got voting powers %+v", votingPowers)
+	}
+
+	registryBackend := newMockBackend(t, KeyRegistryMetaData)
+	registryBackend.handlers["getKeysAt0"] = func([]any) ([]any, error) {
+		return []any{[]IKeyRegistryOperatorWithKeys{{Operator: operator, Keys: []IKeyRegistryKey{{Tag: 15, Payload: make([]byte, 64)}}}}}, nil
+	}
+	registry, err := NewKeyRegistryCaller(contractAddress, registryBackend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, err := registry.GetKeysAt0(nil, big.NewInt(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 1 || keys[0].Operator != operator || keys[0].Keys[0].Tag != 15 || len(keys[0].Keys[0].Payload) != 64 {
+		t.Fatalf("got keys %+v", keys)
+	}
+}
+
+func TestVerifyProofArgs(t *testing.T) {
+	data := make([]byte, 416)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	proofData, err := proof.UnmarshalProofData(proof.BackendGroth16, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicInputHash := common.HexToHash("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+	args, err := NewVerifyProofArgs(proofData, publicInputHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	backend := newMockBackend(t, VerifierMetaData)
+	backend.handlers["verifyProof"] = func(in []any) ([]any, error) {
+		zkProof := in[0].([8]*big.Int)
+		commitmentPok := in[2].([2]*big.Int)
+		input := in[3].([1]*big.Int)
+		if zkProof[1].Cmp(new(big.Int).SetBytes(data[32:64])) != 0 || commitmentPok[1].Cmp(new(big.Int).SetBytes(data[352:384])) != 0 ||
+			input[0].BitLen() != 253 {
+			return nil, errors.Errorf("execution reverted")
+		}
+		return nil, nil
+	}
+	verifier, err := NewVerifierCaller(contractAddress, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := verifier.VerifyProof(nil, args.Proof, args.Commitments, args.CommitmentPok, args.Input); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewVerifyProofArgs(proof.ProofData{Backend: proof.BackendPlonk}, publicInputHash); err == nil {
+		t.Fatal("split a PLONK proof")
+	}
+}
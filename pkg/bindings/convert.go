@@ -0,0 +1,123 @@
+package bindings
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+
+	"middleware-offchain/pkg/proof"
+)
+
+// maxUint48 bounds the epochs and timestamps of the contracts.
+const maxUint48 = 1<<48 - 1
+
+// inputHashMask clears the top three bits of a public input hash, as SigVerifierBlsBn254ZK does.
+var inputHashMask = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 253), big.NewInt(1))
+
+// NewValSetHeader converts a proof.ValSetHeader into the header Settlement takes.
+func NewValSetHeader(h proof.ValSetHeader) ISettlementValSetHeader {
+	return ISettlementValSetHeader{
+		Version:            h.Version,
+		RequiredKeyTag:     h.RequiredKeyTag,
+		Epoch:              new(big.Int).SetUint64(h.Epoch),
+		CaptureTimestamp:   new(big.Int).SetUint64(h.CaptureTimestamp),
+		QuorumThreshold:    new(big.Int).Set(h.QuorumThreshold),
+		TotalVotingPower:   new(big.Int).Set(h.TotalVotingPower),
+		ValidatorsSszMRoot: h.ValidatorsSszMRoot,
+	}
+}
+
+// ProofValSetHeader converts a header read from Settlement into a proof.ValSetHeader.
+func ProofValSetHeader(h ISettlementValSetHeader) (proof.ValSetHeader, error) {
+	epoch, err := uint48(h.Epoch)
+	if err != nil {
+		return proof.ValSetHeader{}, errors.Errorf("invalid epoch: %w", err)
+	}
+	captureTimestamp, err := uint48(h.CaptureTimestamp)
+	if err != nil {
+		return proof.ValSetHeader{}, errors.Errorf("invalid capture timestamp: %w", err)
+	}
+	if h.QuorumThreshold == nil || h.TotalVotingPower == nil {
+		return proof.ValSetHeader{}, errors.Errorf("header without quorum threshold or total voting power")
+	}
+	return proof.ValSetHeader{
+		Version:            h.Version,
+		RequiredKeyTag:     h.RequiredKeyTag,
+		Epoch:              epoch,
+		CaptureTimestamp:   captureTimestamp,
+		QuorumThreshold:    new(big.Int).Set(h.QuorumThreshold),
+		TotalVotingPower:   new(big.Int).Set(h.TotalVotingPower),
+		ValidatorsSszMRoot: h.ValidatorsSszMRoot,
+	}, nil
+}
+
+// NewExtraData returns the extra data entry of key.
+func NewExtraData(key, value common.Hash) ISettlementExtraData {
+	return ISettlementExtraData{Key: key, Value: value}
+}
+
+// ExtraDataMap indexes extra data by key, the way Settlement stores it.
+func ExtraDataMap(extraData []ISettlementExtraData) map[common.Hash]common.Hash {
+	values := make(map[common.Hash]common.Hash, len(extraData))
+	for _, data := range extraData {
+		values[data.Key] = data.Value
+	}
+	return values
+}
+
+// QuorumThreshold returns the quorum threshold of keyTag, a share of 1e18 of the total voting power.
+func (c IValSetDriverConfig) QuorumThreshold(keyTag uint8) (*big.Int, bool) {
+	for _, threshold := range c.QuorumThresholds {
+		if threshold.KeyTag == keyTag {
+			return threshold.QuorumThreshold, true
+		}
+	}
+	return nil, false
+}
+
+// VotingPower returns the sum of the vault voting powers of the operator.
+func (p IVotingPowerProviderOperatorVotingPower) VotingPower() *big.Int {
+	votingPower := new(big.Int)
+	for _, vault := range p.Vaults {
+		votingPower.Add(votingPower, vault.Value)
+	}
+	return votingPower
+}
+
+// VerifyProofArgs are the arguments of IVerifier.verifyProof.
+type VerifyProofArgs struct {
+	Proof         [8]*big.Int
+	Commitments   [2]*big.Int
+	CommitmentPok [2]*big.Int
+	Input         [1]*big.Int
+}
+
+// NewVerifyProofArgs splits a Groth16 proof the way SigVerifierBlsBn254ZK passes it to its verifier, with the
+// masked publicInputHash as input. publicInputHash is the unmasked digest of proof.Config.PublicInputHash.
+func NewVerifyProofArgs(p proof.ProofData, publicInputHash common.Hash) (VerifyProofArgs, error) {
+	if p.Backend != proof.BackendGroth16 {
+		return VerifyProofArgs{}, errors.Errorf("IVerifier takes Groth16 proofs, not %s", p.Backend)
+	}
+	if len(p.Proof) != 256 || len(p.Commitments) != 64 || len(p.CommitmentPok) != 64 {
+		return VerifyProofArgs{}, errors.Errorf("invalid Groth16 proof lengths %d, %d and %d", len(p.Proof), len(p.Commitments), len(p.CommitmentPok))
+	}
+	var args VerifyProofArgs
+	for i := range args.Proof {
+		args.Proof[i] = new(big.Int).SetBytes(p.Proof[32*i : 32*(i+1)])
+	}
+	for i := range args.Commitments {
+		args.Commitments[i] = new(big.Int).SetBytes(p.Commitments[32*i : 32*(i+1)])
+		args.CommitmentPok[i] = new(big.Int).SetBytes(p.CommitmentPok[32*i : 32*(i+1)])
+	}
+	args.Input[0] = new(big.Int).SetBytes(publicInputHash[:])
+	args.Input[0].And(args.Input[0], inputHashMask)
+	return args, nil
+}
+
+func uint48(v *big.Int) (uint64, error) {
+	if v == nil || v.Sign() < 0 || v.Cmp(big.NewInt(maxUint48)) > 0 {
+		return 0, errors.Errorf("%v is not a uint48", v)
+	}
+	return v.Uint64(), nil
+}
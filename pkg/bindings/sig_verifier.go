@@ -0,0 +1,243 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// SigVerifierMetaData contains all meta data concerning the SigVerifier contract.
+var SigVerifierMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"VERIFICATION_TYPE\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint32\",\"type\":\"uint32\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"verifyQuorumSig\",\"inputs\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"settlement\"},{\"internalType\":\"uint48\",\"type\":\"uint48\",\"name\":\"epoch\"},{\"internalType\":\"bytes\",\"type\":\"bytes\",\"name\":\"message\"},{\"internalType\":\"uint8\",\"type\":\"uint8\",\"name\":\"keyTag\"},{\"internalType\":\"uint256\",\"type\":\"uint256\",\"name\":\"quorumThreshold\"},{\"internalType\":\"bytes\",\"type\":\"bytes\",\"name\":\"proof\"}],\"outputs\":[{\"internalType\":\"bool\",\"type\":\"bool\",\"name\":\"\"}],\"stateMutability\":\"view\"}]",
+}
+
+// SigVerifierABI is the input ABI used to generate the binding from.
+// Deprecated: Use SigVerifierMetaData.ABI instead.
+var SigVerifierABI = SigVerifierMetaData.ABI
+
+// SigVerifier is an auto generated Go binding around an Ethereum contract.
+type SigVerifier struct {
+	SigVerifierCaller     // Read-only binding to the contract
+	SigVerifierTransactor // Write-only binding to the contract
+	SigVerifierFilterer   // Log filterer for contract events
+}
+
+// SigVerifierCaller is an auto generated read-only Go binding around an Ethereum contract.
+type SigVerifierCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SigVerifierTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type SigVerifierTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SigVerifierFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type SigVerifierFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SigVerifierSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type SigVerifierSession struct {
+	Contract     *SigVerifier      // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// SigVerifierCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type SigVerifierCallerSession struct {
+	Contract *SigVerifierCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts      // Call options to use throughout this session
+}
+
+// SigVerifierTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type SigVerifierTransactorSession struct {
+	Contract     *SigVerifierTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts      // Transaction auth options to use throughout this session
+}
+
+// SigVerifierRaw is an auto generated low-level Go binding around an Ethereum contract.
+type SigVerifierRaw struct {
+	Contract *SigVerifier // Generic contract binding to access the raw methods on
+}
+
+// SigVerifierCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type SigVerifierCallerRaw struct {
+	Contract *SigVerifierCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// SigVerifierTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type SigVerifierTransactorRaw struct {
+	Contract *SigVerifierTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewSigVerifier creates a new instance of SigVerifier, bound to a specific deployed contract.
+func NewSigVerifier(address common.Address, backend bind.ContractBackend) (*SigVerifier, error) {
+	contract, err := bindSigVerifier(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &SigVerifier{SigVerifierCaller: SigVerifierCaller{contract: contract}, SigVerifierTransactor: SigVerifierTransactor{contract: contract}, SigVerifierFilterer: SigVerifierFilterer{contract: contract}}, nil
+}
+
+// NewSigVerifierCaller creates a new read-only instance of SigVerifier, bound to a specific deployed contract.
+func NewSigVerifierCaller(address common.Address, caller bind.ContractCaller) (*SigVerifierCaller, error) {
+	contract, err := bindSigVerifier(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SigVerifierCaller{contract: contract}, nil
+}
+
+// NewSigVerifierTransactor creates a new write-only instance of SigVerifier, bound to a specific deployed contract.
+func NewSigVerifierTransactor(address common.Address, transactor bind.ContractTransactor) (*SigVerifierTransactor, error) {
+	contract, err := bindSigVerifier(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SigVerifierTransactor{contract: contract}, nil
+}
+
+// NewSigVerifierFilterer creates a new log filterer instance of SigVerifier, bound to a specific deployed contract.
+func NewSigVerifierFilterer(address common.Address, filterer bind.ContractFilterer) (*SigVerifierFilterer, error) {
+	contract, err := bindSigVerifier(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &SigVerifierFilterer{contract: contract}, nil
+}
+
+// bindSigVerifier binds a generic wrapper to an already deployed contract.
+func bindSigVerifier(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := SigVerifierMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SigVerifier *SigVerifierRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SigVerifier.Contract.SigVerifierCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SigVerifier *SigVerifierRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SigVerifier.Contract.SigVerifierTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SigVerifier *SigVerifierRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SigVerifier.Contract.SigVerifierTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SigVerifier *SigVerifierCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SigVerifier.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SigVerifier *SigVerifierTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SigVerifier.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SigVerifier *SigVerifierTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SigVerifier.Contract.contract.Transact(opts, method, params...)
+}
+
+// VERIFICATIONTYPE is a free data retrieval call binding the contract method 0xd916d4e2.
+//
+// Solidity: function VERIFICATION_TYPE() view returns(uint32)
+func (_SigVerifier *SigVerifierCaller) VERIFICATIONTYPE(opts *bind.CallOpts) (uint32, error) {
+	var out []interface{}
+	err := _SigVerifier.contract.Call(opts, &out, "VERIFICATION_TYPE")
+
+	if err != nil {
+		return *new(uint32), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint32)).(*uint32)
+
+	return out0, err
+
+}
+
+// VERIFICATIONTYPE is a free data retrieval call binding the contract method 0xd916d4e2.
+//
+// Solidity: function VERIFICATION_TYPE() view returns(uint32)
+func (_SigVerifier *SigVerifierSession) VERIFICATIONTYPE() (uint32, error) {
+	return _SigVerifier.Contract.VERIFICATIONTYPE(&_SigVerifier.CallOpts)
+}
+
+// VERIFICATIONTYPE is a free data retrieval call binding the contract method 0xd916d4e2.
+//
+// Solidity: function VERIFICATION_TYPE() view returns(uint32)
+func (_SigVerifier *SigVerifierCallerSession) VERIFICATIONTYPE() (uint32, error) {
+	return _SigVerifier.Contract.VERIFICATIONTYPE(&_SigVerifier.CallOpts)
+}
+
+// VerifyQuorumSig is a free data retrieval call binding the contract method 0xed273934.
+//
+// Solidity: function verifyQuorumSig(address settlement, uint48 epoch, bytes message, uint8 keyTag, uint256 quorumThreshold, bytes proof) view returns(bool)
+func (_SigVerifier *SigVerifierCaller) VerifyQuorumSig(opts *bind.CallOpts, settlement common.Address, epoch *big.Int, message []byte, keyTag uint8, quorumThreshold *big.Int, proof []byte) (bool, error) {
+	var out []interface{}
+	err := _SigVerifier.contract.Call(opts, &out, "verifyQuorumSig", settlement, epoch, message, keyTag, quorumThreshold, proof)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// VerifyQuorumSig is a free data retrieval call binding the contract method 0xed273934.
+//
+// Solidity: function verifyQuorumSig(address settlement, uint48 epoch, bytes message, uint8 keyTag, uint256 quorumThreshold, bytes proof) view returns(bool)
+func (_SigVerifier *SigVerifierSession) VerifyQuorumSig(settlement common.Address, epoch *big.Int, message []byte, keyTag uint8, quorumThreshold *big.Int, proof []byte) (bool, error) {
+	return _SigVerifier.Contract.VerifyQuorumSig(&_SigVerifier.CallOpts, settlement, epoch, message, keyTag, quorumThreshold, proof)
+}
+
+// VerifyQuorumSig is a free data retrieval call binding the contract method 0xed273934.
+//
+// Solidity: function verifyQuorumSig(address settlement, uint48 epoch, bytes message, uint8 keyTag, uint256 quorumThreshold, bytes proof) view returns(bool)
+func (_SigVerifier *SigVerifierCallerSession) VerifyQuorumSig(settlement common.Address, epoch *big.Int, message []byte, keyTag uint8, quorumThreshold *big.Int, proof []byte) (bool, error) {
+	return _SigVerifier.Contract.VerifyQuorumSig(&_SigVerifier.CallOpts, settlement, epoch, message, keyTag, quorumThreshold, proof)
+}
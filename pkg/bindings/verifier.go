@@ -0,0 +1,210 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// VerifierMetaData contains all meta data concerning the Verifier contract.
+var VerifierMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"verifyProof\",\"inputs\":[{\"internalType\":\"uint256[8]\",\"type\":\"uint256[8]\",\"name\":\"proof\"},{\"internalType\":\"uint256[2]\",\"type\":\"uint256[2]\",\"name\":\"commitments\"},{\"internalType\":\"uint256[2]\",\"type\":\"uint256[2]\",\"name\":\"commitmentPok\"},{\"internalType\":\"uint256[1]\",\"type\":\"uint256[1]\",\"name\":\"input\"}],\"outputs\":[],\"stateMutability\":\"view\"}]",
+}
+
+// VerifierABI is the input ABI used to generate the binding from.
+// Deprecated: Use VerifierMetaData.ABI instead.
+var VerifierABI = VerifierMetaData.ABI
+
+// Verifier is an auto generated Go binding around an Ethereum contract.
+type Verifier struct {
+	VerifierCaller     // Read-only binding to the contract
+	VerifierTransactor // Write-only binding to the contract
+	VerifierFilterer   // Log filterer for contract events
+}
+
+// VerifierCaller is an auto generated read-only Go binding around an Ethereum contract.
+type VerifierCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VerifierTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type VerifierTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VerifierFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type VerifierFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VerifierSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type VerifierSession struct {
+	Contract     *Verifier         // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// VerifierCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type VerifierCallerSession struct {
+	Contract *VerifierCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts   // Call options to use throughout this session
+}
+
+// VerifierTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type VerifierTransactorSession struct {
+	Contract     *VerifierTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
+}
+
+// VerifierRaw is an auto generated low-level Go binding around an Ethereum contract.
+type VerifierRaw struct {
+	Contract *Verifier // Generic contract binding to access the raw methods on
+}
+
+// VerifierCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type VerifierCallerRaw struct {
+	Contract *VerifierCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// VerifierTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type VerifierTransactorRaw struct {
+	Contract *VerifierTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewVerifier creates a new instance of Verifier, bound to a specific deployed contract.
+func NewVerifier(address common.Address, backend bind.ContractBackend) (*Verifier, error) {
+	contract, err := bindVerifier(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Verifier{VerifierCaller: VerifierCaller{contract: contract}, VerifierTransactor: VerifierTransactor{contract: contract}, VerifierFilterer: VerifierFilterer{contract: contract}}, nil
+}
+
+// NewVerifierCaller creates a new read-only instance of Verifier, bound to a specific deployed contract.
+func NewVerifierCaller(address common.Address, caller bind.ContractCaller) (*VerifierCaller, error) {
+	contract, err := bindVerifier(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &VerifierCaller{contract: contract}, nil
+}
+
+// NewVerifierTransactor creates a new write-only instance of Verifier, bound to a specific deployed contract.
+func NewVerifierTransactor(address common.Address, transactor bind.ContractTransactor) (*VerifierTransactor, error) {
+	contract, err := bindVerifier(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &VerifierTransactor{contract: contract}, nil
+}
+
+// NewVerifierFilterer creates a new log filterer instance of Verifier, bound to a specific deployed contract.
+func NewVerifierFilterer(address common.Address, filterer bind.ContractFilterer) (*VerifierFilterer, error) {
+	contract, err := bindVerifier(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &VerifierFilterer{contract: contract}, nil
+}
+
+// bindVerifier binds a generic wrapper to an already deployed contract.
+func bindVerifier(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := VerifierMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Verifier *VerifierRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Verifier.Contract.VerifierCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Verifier *VerifierRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Verifier.Contract.VerifierTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Verifier *VerifierRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Verifier.Contract.VerifierTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Verifier *VerifierCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Verifier.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Verifier *VerifierTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Verifier.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Verifier *VerifierTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Verifier.Contract.contract.Transact(opts, method, params...)
+}
+
+// VerifyProof is a free data retrieval call binding the contract method 0x43db3c72.
+//
+// Solidity: function verifyProof(uint256[8] proof, uint256[2] commitments, uint256[2] commitmentPok, uint256[1] input) view returns()
+func (_Verifier *VerifierCaller) VerifyProof(opts *bind.CallOpts, proof [8]*big.Int, commitments [2]*big.Int, commitmentPok [2]*big.Int, input [1]*big.Int) error {
+	var out []interface{}
+	err := _Verifier.contract.Call(opts, &out, "verifyProof", proof, commitments, commitmentPok, input)
+
+	if err != nil {
+		return err
+	}
+
+	return err
+
+}
+
+// VerifyProof is a free data retrieval call binding the contract method 0x43db3c72.
+//
+// Solidity: function verifyProof(uint256[8] proof, uint256[2] commitments, uint256[2] commitmentPok, uint256[1] input) view returns()
+func (_Verifier *VerifierSession) VerifyProof(proof [8]*big.Int, commitments [2]*big.Int, commitmentPok [2]*big.Int, input [1]*big.Int) error {
+	return _Verifier.Contract.VerifyProof(&_Verifier.CallOpts, proof, commitments, commitmentPok, input)
+}
+
+// VerifyProof is a free data retrieval call binding the contract method 0x43db3c72.
+//
+// Solidity: function verifyProof(uint256[8] proof, uint256[2] commitments, uint256[2] commitmentPok, uint256[1] input) view returns()
+func (_Verifier *VerifierCallerSession) VerifyProof(proof [8]*big.Int, commitments [2]*big.Int, commitmentPok [2]*big.Int, input [1]*big.Int) error {
+	return _Verifier.Contract.VerifyProof(&_Verifier.CallOpts, proof, commitments, commitmentPok, input)
+}
@@ -0,0 +1,3127 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// IVotingPowerProviderOperatorVotingPower is an auto generated low-level Go binding around an user-defined struct.
+type IVotingPowerProviderOperatorVotingPower struct {
+	Operator common.Address
+	Vaults   []IVotingPowerProviderVaultValue
+}
+
+// IVotingPowerProviderVaultValue is an auto generated low-level Go binding around an user-defined struct.
+type IVotingPowerProviderVaultValue struct {
+	Vault common.Address
+	Value *big.Int
+}
+
+// VotingPowerProviderMetaData contains all meta data concerning the VotingPowerProvider contract.
+var VotingPowerProviderMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"OPERATOR_REGISTRY\",\"inputs\":[],\"outputs\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"VAULT_FACTORY\",\"inputs\":[],\"outputs\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getSlashingDataAt\",\"inputs\":[{\"internalType\":\"uint48\",\"type\":\"uint48\",\"name\":\"timestamp\"},{\"internalType\":\"bytes\",\"type\":\"bytes\",\"name\":\"hint\"}],\"outputs\":[{\"internalType\":\"bool\",\"type\":\"bool\",\"name\":\"requireSlasher\"},{\"internalType\":\"uint48\",\"type\":\"uint48\",\"name\":\"minVaultEpochDuration\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getSlashingData\",\"inputs\":[],\"outputs\":[{\"internalType\":\"bool\",\"type\":\"bool\",\"name\":\"requireSlasher\"},{\"internalType\":\"uint48\",\"type\":\"uint48\",\"name\":\"minVaultEpochDuration\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isTokenRegisteredAt\",\"inputs\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"token\"},{\"internalType\":\"uint48\",\"type\":\"uint48\",\"name\":\"timestamp\"}],\"outputs\":[{\"internalType\":\"bool\",\"type\":\"bool\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isTokenRegistered\",\"inputs\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"token\"}],\"outputs\":[{\"internalType\":\"bool\",\"type\":\"bool\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTokensAt\",\"inputs\":[{\"internalType\":\"uint48\",\"type\":\"uint48\",\"name\":\"timestamp\"}],\"outputs\":[{\"internalType\":\"address[]\",\"type\":\"address[]\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTokens\",\"inputs\":[],\"outputs\":[{\"internalType\":\"address[]\",\"type\":\"address[]\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isOperatorRegistered\",\"inputs\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"operator\"}],\"outputs\":[{\"internalType\":\"bool\",\"type\":\"bool\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isOperatorRegisteredAt\",\"inputs\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"operator\"},{\"internalType\":\"uint48\",\"type\":\"uint48\",\"name\":\"timestamp\"}],\"outputs\":[{\"internalType\":\"bool\",\"type\":\"bool\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOperatorsAt\",\"inputs\":[{\"internalType\":\"uint48\",\"type\":\"uint48\",\"name\":\"timestamp\"}],\"outputs\":[{\"internalType\":\"address[]\",\"type\":\"address[]\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOperators\",\"inputs\":[],\"outputs\":[{\"internalType\":\"address[]\",\"type\":\"address[]\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isSharedVaultRegistered\",\"inputs\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"vault\"}],\"outputs\":[{\"internalType\":\"bool\",\"type\":\"bool\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isSharedVaultRegisteredAt\",\"inputs\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"vault\"},{\"internalType\":\"uint48\",\"type\":\"uint48\",\"name\":\"timestamp\"}],\"outputs\":[{\"internalType\":\"bool\",\"type\":\"bool\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getSharedVaultsAt\",\"inputs\":[{\"internalType\":\"uint48\",\"type\":\"uint48\",\"name\":\"timestamp\"}],\"outputs\":[{\"internalType\":\"address[]\",\"type\":\"address[]\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getSharedVaults\",\"inputs\":[],\"outputs\":[{\"internalType\":\"address[]\",\"type\":\"address[]\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isOperatorVaultRegisteredAt\",\"inputs\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"vault\"},{\"internalType\":\"uint48\",\"type\":\"uint48\",\"name\":\"timestamp\"}],\"outputs\":[{\"internalType\":\"bool\",\"type\":\"bool\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isOperatorVaultRegistered\",\"inputs\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"vault\"}],\"outputs\":[{\"internalType\":\"bool\",\"type\":\"bool\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isOperatorVaultRegisteredAt\",\"inputs\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"operator\"},{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"vault\"},{\"internalType\":\"uint48\",\"type\":\"uint48\",\"name\":\"timestamp\"}],\"outputs\":[{\"internalType\":\"bool\",\"type\":\"bool\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isOperatorVaultRegistered\",\"inputs\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"operator\"},{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"vault\"}],\"outputs\":[{\"internalType\":\"bool\",\"type\":\"bool\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOperatorVaultsAt\",\"inputs\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"operator\"},{\"internalType\":\"uint48\",\"type\":\"uint48\",\"name\":\"timestamp\"}],\"outputs\":[{\"internalType\":\"address[]\",\"type\":\"address[]\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOperatorVaults\",\"inputs\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"operator\"}],\"outputs\":[{\"internalType\":\"address[]\",\"type\":\"address[]\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOperatorStakesAt\",\"inputs\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"operator\"},{\"internalType\":\"uint48\",\"type\":\"uint48\",\"name\":\"timestamp\"}],\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"vault\"},{\"internalType\":\"uint256\",\"type\":\"uint256\",\"name\":\"value\"}],\"internalType\":\"structIVotingPowerProvider.VaultValue[]\",\"type\":\"tuple[]\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOperatorStakes\",\"inputs\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"operator\"}],\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"vault\"},{\"internalType\":\"uint256\",\"type\":\"uint256\",\"name\":\"value\"}],\"internalType\":\"structIVotingPowerProvider.VaultValue[]\",\"type\":\"tuple[]\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOperatorVotingPowersAt\",\"inputs\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"operator\"},{\"internalType\":\"bytes\",\"type\":\"bytes\",\"name\":\"extraData\"},{\"internalType\":\"uint48\",\"type\":\"uint48\",\"name\":\"timestamp\"}],\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"vault\"},{\"internalType\":\"uint256\",\"type\":\"uint256\",\"name\":\"value\"}],\"internalType\":\"structIVotingPowerProvider.VaultValue[]\",\"type\":\"tuple[]\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOperatorVotingPowers\",\"inputs\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"operator\"},{\"internalType\":\"bytes\",\"type\":\"bytes\",\"name\":\"extraData\"}],\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"vault\"},{\"internalType\":\"uint256\",\"type\":\"uint256\",\"name\":\"value\"}],\"internalType\":\"structIVotingPowerProvider.VaultValue[]\",\"type\":\"tuple[]\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getVotingPowersAt\",\"inputs\":[{\"internalType\":\"bytes[]\",\"type\":\"bytes[]\",\"name\":\"extraData\"},{\"internalType\":\"uint48\",\"type\":\"uint48\",\"name\":\"timestamp\"}],\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"operator\"},{\"components\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"vault\"},{\"internalType\":\"uint256\",\"type\":\"uint256\",\"name\":\"value\"}],\"internalType\":\"structIVotingPowerProvider.VaultValue[]\",\"type\":\"tuple[]\",\"name\":\"vaults\"}],\"internalType\":\"structIVotingPowerProvider.OperatorVotingPower[]\",\"type\":\"tuple[]\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getVotingPowers\",\"inputs\":[{\"internalType\":\"bytes[]\",\"type\":\"bytes[]\",\"name\":\"extraData\"}],\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"operator\"},{\"components\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"vault\"},{\"internalType\":\"uint256\",\"type\":\"uint256\",\"name\":\"value\"}],\"internalType\":\"structIVotingPowerProvider.VaultValue[]\",\"type\":\"tuple[]\",\"name\":\"vaults\"}],\"internalType\":\"structIVotingPowerProvider.OperatorVotingPower[]\",\"type\":\"tuple[]\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"registerOperator\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"registerOperatorWithSignature\",\"inputs\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"operator\"},{\"internalType\":\"bytes\",\"type\":\"bytes\",\"name\":\"signature\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"unregisterOperator\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"unregisterOperatorWithSignature\",\"inputs\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"operator\"},{\"internalType\":\"bytes\",\"type\":\"bytes\",\"name\":\"signature\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"invalidateOldSignatures\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"SetSlashingData\",\"inputs\":[{\"internalType\":\"bool\",\"type\":\"bool\",\"name\":\"requireSlasher\",\"indexed\":false},{\"internalType\":\"uint48\",\"type\":\"uint48\",\"name\":\"minVaultEpochDuration\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RegisterToken\",\"inputs\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"token\",\"indexed\":true}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"UnregisterToken\",\"inputs\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"token\",\"indexed\":true}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RegisterOperator\",\"inputs\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"operator\",\"indexed\":true}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"UnregisterOperator\",\"inputs\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"operator\",\"indexed\":true}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RegisterSharedVault\",\"inputs\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"vault\",\"indexed\":true}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"UnregisterSharedVault\",\"inputs\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"vault\",\"indexed\":true}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RegisterOperatorVault\",\"inputs\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"operator\",\"indexed\":true},{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"vault\",\"indexed\":true}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"UnregisterOperatorVault\",\"inputs\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"operator\",\"indexed\":true},{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"vault\",\"indexed\":true}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"VotingPowerProvider_InvalidOperator\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"VotingPowerProvider_InvalidOperatorVault\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"VotingPowerProvider_InvalidSharedVault\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"VotingPowerProvider_InvalidSignature\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"VotingPowerProvider_InvalidToken\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"VotingPowerProvider_InvalidVault\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"VotingPowerProvider_OperatorAlreadyRegistered\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"VotingPowerProvider_OperatorNotRegistered\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"VotingPowerProvider_OperatorVaultAlreadyIsRegistered\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"VotingPowerProvider_OperatorVaultNotRegistered\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"VotingPowerProvider_SharedVaultAlreadyIsRegistered\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"VotingPowerProvider_SharedVaultNotRegistered\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"VotingPowerProvider_TokenAlreadyIsRegistered\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"VotingPowerProvider_TokenNotRegistered\",\"inputs\":[]},{\"type\":\"function\",\"name\":\"NETWORK\",\"inputs\":[],\"outputs\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"SUBNETWORK_IDENTIFIER\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint96\",\"type\":\"uint96\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"SUBNETWORK\",\"inputs\":[],\"outputs\":[{\"internalType\":\"bytes32\",\"type\":\"bytes32\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"InitSubnetwork\",\"inputs\":[{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"network\",\"indexed\":false},{\"internalType\":\"uint96\",\"type\":\"uint96\",\"name\":\"subnetworkId\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"NetworkManager_InvalidNetwork\",\"inputs\":[]},{\"type\":\"function\",\"name\":\"eip712Domain\",\"inputs\":[],\"outputs\":[{\"internalType\":\"bytes1\",\"type\":\"bytes1\",\"name\":\"fields\"},{\"internalType\":\"string\",\"type\":\"string\",\"name\":\"name\"},{\"internalType\":\"string\",\"type\":\"string\",\"name\":\"version\"},{\"internalType\":\"uint256\",\"type\":\"uint256\",\"name\":\"chainId\"},{\"internalType\":\"address\",\"type\":\"address\",\"name\":\"verifyingContract\"},{\"internalType\":\"bytes32\",\"type\":\"bytes32\",\"name\":\"salt\"},{\"internalType\":\"uint256[]\",\"type\":\"uint256[]\",\"name\":\"extensions\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hashTypedDataV4\",\"inputs\":[{\"internalType\":\"bytes32\",\"type\":\"bytes32\",\"name\":\"structHash\"}],\"outputs\":[{\"internalType\":\"bytes32\",\"type\":\"bytes32\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hashTypedDataV4CrossChain\",\"inputs\":[{\"internalType\":\"bytes32\",\"type\":\"bytes32\",\"name\":\"structHash\"}],\"outputs\":[{\"internalType\":\"bytes32\",\"type\":\"bytes32\",\"name\":\"\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"InitEIP712\",\"inputs\":[{\"internalType\":\"string\",\"type\":\"string\",\"name\":\"name\",\"indexed\":false},{\"internalType\":\"string\",\"type\":\"string\",\"name\":\"version\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"EIP712DomainChanged\",\"inputs\":[],\"anonymous\":false}]",
+}
+
+// VotingPowerProviderABI is the input ABI used to generate the binding from.
+// Deprecated: Use VotingPowerProviderMetaData.ABI instead.
+var VotingPowerProviderABI = VotingPowerProviderMetaData.ABI
+
+// VotingPowerProvider is an auto generated Go binding around an Ethereum contract.
+type VotingPowerProvider struct {
+	VotingPowerProviderCaller     // Read-only binding to the contract
+	VotingPowerProviderTransactor // Write-only binding to the contract
+	VotingPowerProviderFilterer   // Log filterer for contract events
+}
+
+// VotingPowerProviderCaller is an auto generated read-only Go binding around an Ethereum contract.
+type VotingPowerProviderCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VotingPowerProviderTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type VotingPowerProviderTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VotingPowerProviderFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type VotingPowerProviderFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VotingPowerProviderSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type VotingPowerProviderSession struct {
+	Contract     *VotingPowerProvider // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts        // Call options to use throughout this session
+	TransactOpts bind.TransactOpts    // Transaction auth options to use throughout this session
+}
+
+// VotingPowerProviderCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type VotingPowerProviderCallerSession struct {
+	Contract *VotingPowerProviderCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts              // Call options to use throughout this session
+}
+
+// VotingPowerProviderTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type VotingPowerProviderTransactorSession struct {
+	Contract     *VotingPowerProviderTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts              // Transaction auth options to use throughout this session
+}
+
+// VotingPowerProviderRaw is an auto generated low-level Go binding around an Ethereum contract.
+type VotingPowerProviderRaw struct {
+	Contract *VotingPowerProvider // Generic contract binding to access the raw methods on
+}
+
+// VotingPowerProviderCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type VotingPowerProviderCallerRaw struct {
+	Contract *VotingPowerProviderCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// VotingPowerProviderTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type VotingPowerProviderTransactorRaw struct {
+	Contract *VotingPowerProviderTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewVotingPowerProvider creates a new instance of VotingPowerProvider, bound to a specific deployed contract.
+func NewVotingPowerProvider(address common.Address, backend bind.ContractBackend) (*VotingPowerProvider, error) {
+	contract, err := bindVotingPowerProvider(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &VotingPowerProvider{VotingPowerProviderCaller: VotingPowerProviderCaller{contract: contract}, VotingPowerProviderTransactor: VotingPowerProviderTransactor{contract: contract}, VotingPowerProviderFilterer: VotingPowerProviderFilterer{contract: contract}}, nil
+}
+
+// NewVotingPowerProviderCaller creates a new read-only instance of VotingPowerProvider, bound to a specific deployed contract.
+func NewVotingPowerProviderCaller(address common.Address, caller bind.ContractCaller) (*VotingPowerProviderCaller, error) {
+	contract, err := bindVotingPowerProvider(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &VotingPowerProviderCaller{contract: contract}, nil
+}
+
+// NewVotingPowerProviderTransactor creates a new write-only instance of VotingPowerProvider, bound to a specific deployed contract.
+func NewVotingPowerProviderTransactor(address common.Address, transactor bind.ContractTransactor) (*VotingPowerProviderTransactor, error) {
+	contract, err := bindVotingPowerProvider(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &VotingPowerProviderTransactor{contract: contract}, nil
+}
+
+// NewVotingPowerProviderFilterer creates a new log filterer instance of VotingPowerProvider, bound to a specific deployed contract.
+func NewVotingPowerProviderFilterer(address common.Address, filterer bind.ContractFilterer) (*VotingPowerProviderFilterer, error) {
+	contract, err := bindVotingPowerProvider(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &VotingPowerProviderFilterer{contract: contract}, nil
+}
+
+// bindVotingPowerProvider binds a generic wrapper to an already deployed contract.
+func bindVotingPowerProvider(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := VotingPowerProviderMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_VotingPowerProvider *VotingPowerProviderRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _VotingPowerProvider.Contract.VotingPowerProviderCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_VotingPowerProvider *VotingPowerProviderRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _VotingPowerProvider.Contract.VotingPowerProviderTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_VotingPowerProvider *VotingPowerProviderRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _VotingPowerProvider.Contract.VotingPowerProviderTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_VotingPowerProvider *VotingPowerProviderCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _VotingPowerProvider.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_VotingPowerProvider *VotingPowerProviderTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _VotingPowerProvider.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_VotingPowerProvider *VotingPowerProviderTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _VotingPowerProvider.Contract.contract.Transact(opts, method, params...)
+}
+
+// NETWORK is a free data retrieval call binding the contract method 0x8759e6d1.
+//
+// Solidity: function NETWORK() view returns(address)
+func (_VotingPowerProvider *VotingPowerProviderCaller) NETWORK(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "NETWORK")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// NETWORK is a free data retrieval call binding the contract method 0x8759e6d1.
+//
+// Solidity: function NETWORK() view returns(address)
+func (_VotingPowerProvider *VotingPowerProviderSession) NETWORK() (common.Address, error) {
+	return _VotingPowerProvider.Contract.NETWORK(&_VotingPowerProvider.CallOpts)
+}
+
+// NETWORK is a free data retrieval call binding the contract method 0x8759e6d1.
+//
+// Solidity: function NETWORK() view returns(address)
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) NETWORK() (common.Address, error) {
+	return _VotingPowerProvider.Contract.NETWORK(&_VotingPowerProvider.CallOpts)
+}
+
+// OPERATORREGISTRY is a free data retrieval call binding the contract method 0x83ce0322.
+//
+// Solidity: function OPERATOR_REGISTRY() view returns(address)
+func (_VotingPowerProvider *VotingPowerProviderCaller) OPERATORREGISTRY(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "OPERATOR_REGISTRY")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// OPERATORREGISTRY is a free data retrieval call binding the contract method 0x83ce0322.
+//
+// Solidity: function OPERATOR_REGISTRY() view returns(address)
+func (_VotingPowerProvider *VotingPowerProviderSession) OPERATORREGISTRY() (common.Address, error) {
+	return _VotingPowerProvider.Contract.OPERATORREGISTRY(&_VotingPowerProvider.CallOpts)
+}
+
+// OPERATORREGISTRY is a free data retrieval call binding the contract method 0x83ce0322.
+//
+// Solidity: function OPERATOR_REGISTRY() view returns(address)
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) OPERATORREGISTRY() (common.Address, error) {
+	return _VotingPowerProvider.Contract.OPERATORREGISTRY(&_VotingPowerProvider.CallOpts)
+}
+
+// SUBNETWORK is a free data retrieval call binding the contract method 0x773e6b54.
+//
+// Solidity: function SUBNETWORK() view returns(bytes32)
+func (_VotingPowerProvider *VotingPowerProviderCaller) SUBNETWORK(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "SUBNETWORK")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBNETWORK is a free data retrieval call binding the contract method 0x773e6b54.
+//
+// Solidity: function SUBNETWORK() view returns(bytes32)
+func (_VotingPowerProvider *VotingPowerProviderSession) SUBNETWORK() ([32]byte, error) {
+	return _VotingPowerProvider.Contract.SUBNETWORK(&_VotingPowerProvider.CallOpts)
+}
+
+// SUBNETWORK is a free data retrieval call binding the contract method 0x773e6b54.
+//
+// Solidity: function SUBNETWORK() view returns(bytes32)
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) SUBNETWORK() ([32]byte, error) {
+	return _VotingPowerProvider.Contract.SUBNETWORK(&_VotingPowerProvider.CallOpts)
+}
+
+// SUBNETWORKIDENTIFIER is a free data retrieval call binding the contract method 0xabacb807.
+//
+// Solidity: function SUBNETWORK_IDENTIFIER() view returns(uint96)
+func (_VotingPowerProvider *VotingPowerProviderCaller) SUBNETWORKIDENTIFIER(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "SUBNETWORK_IDENTIFIER")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// SUBNETWORKIDENTIFIER is a free data retrieval call binding the contract method 0xabacb807.
+//
+// Solidity: function SUBNETWORK_IDENTIFIER() view returns(uint96)
+func (_VotingPowerProvider *VotingPowerProviderSession) SUBNETWORKIDENTIFIER() (*big.Int, error) {
+	return _VotingPowerProvider.Contract.SUBNETWORKIDENTIFIER(&_VotingPowerProvider.CallOpts)
+}
+
+// SUBNETWORKIDENTIFIER is a free data retrieval call binding the contract method 0xabacb807.
+//
+// Solidity: function SUBNETWORK_IDENTIFIER() view returns(uint96)
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) SUBNETWORKIDENTIFIER() (*big.Int, error) {
+	return _VotingPowerProvider.Contract.SUBNETWORKIDENTIFIER(&_VotingPowerProvider.CallOpts)
+}
+
+// VAULTFACTORY is a free data retrieval call binding the contract method 0x103f2907.
+//
+// Solidity: function VAULT_FACTORY() view returns(address)
+func (_VotingPowerProvider *VotingPowerProviderCaller) VAULTFACTORY(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "VAULT_FACTORY")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// VAULTFACTORY is a free data retrieval call binding the contract method 0x103f2907.
+//
+// Solidity: function VAULT_FACTORY() view returns(address)
+func (_VotingPowerProvider *VotingPowerProviderSession) VAULTFACTORY() (common.Address, error) {
+	return _VotingPowerProvider.Contract.VAULTFACTORY(&_VotingPowerProvider.CallOpts)
+}
+
+// VAULTFACTORY is a free data retrieval call binding the contract method 0x103f2907.
+//
+// Solidity: function VAULT_FACTORY() view returns(address)
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) VAULTFACTORY() (common.Address, error) {
+	return _VotingPowerProvider.Contract.VAULTFACTORY(&_VotingPowerProvider.CallOpts)
+}
+
+// Eip712Domain is a free data retrieval call binding the contract method 0x84b0196e.
+//
+// Solidity: function eip712Domain() view returns(bytes1 fields, string name, string version, uint256 chainId, address verifyingContract, bytes32 salt, uint256[] extensions)
+func (_VotingPowerProvider *VotingPowerProviderCaller) Eip712Domain(opts *bind.CallOpts) (struct {
+	Fields            [1]byte
+	Name              string
+	Version           string
+	ChainId           *big.Int
+	VerifyingContract common.Address
+	Salt              [32]byte
+	Extensions        []*big.Int
+}, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "eip712Domain")
+
+	outstruct := new(struct {
+		Fields            [1]byte
+		Name              string
+		Version           string
+		ChainId           *big.Int
+		VerifyingContract common.Address
+		Salt              [32]byte
+		Extensions        []*big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Fields = *abi.ConvertType(out[0], new([1]byte)).(*[1]byte)
+	outstruct.Name = *abi.ConvertType(out[1], new(string)).(*string)
+	outstruct.Version = *abi.ConvertType(out[2], new(string)).(*string)
+	outstruct.ChainId = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.VerifyingContract = *abi.ConvertType(out[4], new(common.Address)).(*common.Address)
+	outstruct.Salt = *abi.ConvertType(out[5], new([32]byte)).(*[32]byte)
+	outstruct.Extensions = *abi.ConvertType(out[6], new([]*big.Int)).(*[]*big.Int)
+
+	return *outstruct, err
+
+}
+
+// Eip712Domain is a free data retrieval call binding the contract method 0x84b0196e.
+//
+// Solidity: function eip712Domain() view returns(bytes1 fields, string name, string version, uint256 chainId, address verifyingContract, bytes32 salt, uint256[] extensions)
+func (_VotingPowerProvider *VotingPowerProviderSession) Eip712Domain() (struct {
+	Fields            [1]byte
+	Name              string
+	Version           string
+	ChainId           *big.Int
+	VerifyingContract common.Address
+	Salt              [32]byte
+	Extensions        []*big.Int
+}, error) {
+	return _VotingPowerProvider.Contract.Eip712Domain(&_VotingPowerProvider.CallOpts)
+}
+
+// Eip712Domain is a free data retrieval call binding the contract method 0x84b0196e.
+//
+// Solidity: function eip712Domain() view returns(bytes1 fields, string name, string version, uint256 chainId, address verifyingContract, bytes32 salt, uint256[] extensions)
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) Eip712Domain() (struct {
+	Fields            [1]byte
+	Name              string
+	Version           string
+	ChainId           *big.Int
+	VerifyingContract common.Address
+	Salt              [32]byte
+	Extensions        []*big.Int
+}, error) {
+	return _VotingPowerProvider.Contract.Eip712Domain(&_VotingPowerProvider.CallOpts)
+}
+
+// GetOperatorStakes is a free data retrieval call binding the contract method 0x93816a13.
+//
+// Solidity: function getOperatorStakes(address operator) view returns((address,uint256)[])
+func (_VotingPowerProvider *VotingPowerProviderCaller) GetOperatorStakes(opts *bind.CallOpts, operator common.Address) ([]IVotingPowerProviderVaultValue, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "getOperatorStakes", operator)
+
+	if err != nil {
+		return *new([]IVotingPowerProviderVaultValue), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]IVotingPowerProviderVaultValue)).(*[]IVotingPowerProviderVaultValue)
+
+	return out0, err
+
+}
+
+// GetOperatorStakes is a free data retrieval call binding the contract method 0x93816a13.
+//
+// Solidity: function getOperatorStakes(address operator) view returns((address,uint256)[])
+func (_VotingPowerProvider *VotingPowerProviderSession) GetOperatorStakes(operator common.Address) ([]IVotingPowerProviderVaultValue, error) {
+	return _VotingPowerProvider.Contract.GetOperatorStakes(&_VotingPowerProvider.CallOpts, operator)
+}
+
+// GetOperatorStakes is a free data retrieval call binding the contract method 0x93816a13.
+//
+// Solidity: function getOperatorStakes(address operator) view returns((address,uint256)[])
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) GetOperatorStakes(operator common.Address) ([]IVotingPowerProviderVaultValue, error) {
+	return _VotingPowerProvider.Contract.GetOperatorStakes(&_VotingPowerProvider.CallOpts, operator)
+}
+
+// GetOperatorStakesAt is a free data retrieval call binding the contract method 0xa54e116e.
+//
+// Solidity: function getOperatorStakesAt(address operator, uint48 timestamp) view returns((address,uint256)[])
+func (_VotingPowerProvider *VotingPowerProviderCaller) GetOperatorStakesAt(opts *bind.CallOpts, operator common.Address, timestamp *big.Int) ([]IVotingPowerProviderVaultValue, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "getOperatorStakesAt", operator, timestamp)
+
+	if err != nil {
+		return *new([]IVotingPowerProviderVaultValue), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]IVotingPowerProviderVaultValue)).(*[]IVotingPowerProviderVaultValue)
+
+	return out0, err
+
+}
+
+// GetOperatorStakesAt is a free data retrieval call binding the contract method 0xa54e116e.
+//
+// Solidity: function getOperatorStakesAt(address operator, uint48 timestamp) view returns((address,uint256)[])
+func (_VotingPowerProvider *VotingPowerProviderSession) GetOperatorStakesAt(operator common.Address, timestamp *big.Int) ([]IVotingPowerProviderVaultValue, error) {
+	return _VotingPowerProvider.Contract.GetOperatorStakesAt(&_VotingPowerProvider.CallOpts, operator, timestamp)
+}
+
+// GetOperatorStakesAt is a free data retrieval call binding the contract method 0xa54e116e.
+//
+// Solidity: function getOperatorStakesAt(address operator, uint48 timestamp) view returns((address,uint256)[])
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) GetOperatorStakesAt(operator common.Address, timestamp *big.Int) ([]IVotingPowerProviderVaultValue, error) {
+	return _VotingPowerProvider.Contract.GetOperatorStakesAt(&_VotingPowerProvider.CallOpts, operator, timestamp)
+}
+
+// GetOperatorVaults is a free data retrieval call binding the contract method 0x14d7e25b.
+//
+// Solidity: function getOperatorVaults(address operator) view returns(address[])
+func (_VotingPowerProvider *VotingPowerProviderCaller) GetOperatorVaults(opts *bind.CallOpts, operator common.Address) ([]common.Address, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "getOperatorVaults", operator)
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetOperatorVaults is a free data retrieval call binding the contract method 0x14d7e25b.
+//
+// Solidity: function getOperatorVaults(address operator) view returns(address[])
+func (_VotingPowerProvider *VotingPowerProviderSession) GetOperatorVaults(operator common.Address) ([]common.Address, error) {
+	return _VotingPowerProvider.Contract.GetOperatorVaults(&_VotingPowerProvider.CallOpts, operator)
+}
+
+// GetOperatorVaults is a free data retrieval call binding the contract method 0x14d7e25b.
+//
+// Solidity: function getOperatorVaults(address operator) view returns(address[])
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) GetOperatorVaults(operator common.Address) ([]common.Address, error) {
+	return _VotingPowerProvider.Contract.GetOperatorVaults(&_VotingPowerProvider.CallOpts, operator)
+}
+
+// GetOperatorVaultsAt is a free data retrieval call binding the contract method 0x49f993ec.
+//
+// Solidity: function getOperatorVaultsAt(address operator, uint48 timestamp) view returns(address[])
+func (_VotingPowerProvider *VotingPowerProviderCaller) GetOperatorVaultsAt(opts *bind.CallOpts, operator common.Address, timestamp *big.Int) ([]common.Address, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "getOperatorVaultsAt", operator, timestamp)
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetOperatorVaultsAt is a free data retrieval call binding the contract method 0x49f993ec.
+//
+// Solidity: function getOperatorVaultsAt(address operator, uint48 timestamp) view returns(address[])
+func (_VotingPowerProvider *VotingPowerProviderSession) GetOperatorVaultsAt(operator common.Address, timestamp *big.Int) ([]common.Address, error) {
+	return _VotingPowerProvider.Contract.GetOperatorVaultsAt(&_VotingPowerProvider.CallOpts, operator, timestamp)
+}
+
+// GetOperatorVaultsAt is a free data retrieval call binding the contract method 0x49f993ec.
+//
+// Solidity: function getOperatorVaultsAt(address operator, uint48 timestamp) view returns(address[])
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) GetOperatorVaultsAt(operator common.Address, timestamp *big.Int) ([]common.Address, error) {
+	return _VotingPowerProvider.Contract.GetOperatorVaultsAt(&_VotingPowerProvider.CallOpts, operator, timestamp)
+}
+
+// GetOperatorVotingPowers is a free data retrieval call binding the contract method 0x63ff1140.
+//
+// Solidity: function getOperatorVotingPowers(address operator, bytes extraData) view returns((address,uint256)[])
+func (_VotingPowerProvider *VotingPowerProviderCaller) GetOperatorVotingPowers(opts *bind.CallOpts, operator common.Address, extraData []byte) ([]IVotingPowerProviderVaultValue, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "getOperatorVotingPowers", operator, extraData)
+
+	if err != nil {
+		return *new([]IVotingPowerProviderVaultValue), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]IVotingPowerProviderVaultValue)).(*[]IVotingPowerProviderVaultValue)
+
+	return out0, err
+
+}
+
+// GetOperatorVotingPowers is a free data retrieval call binding the contract method 0x63ff1140.
+//
+// Solidity: function getOperatorVotingPowers(address operator, bytes extraData) view returns((address,uint256)[])
+func (_VotingPowerProvider *VotingPowerProviderSession) GetOperatorVotingPowers(operator common.Address, extraData []byte) ([]IVotingPowerProviderVaultValue, error) {
+	return _VotingPowerProvider.Contract.GetOperatorVotingPowers(&_VotingPowerProvider.CallOpts, operator, extraData)
+}
+
+// GetOperatorVotingPowers is a free data retrieval call binding the contract method 0x63ff1140.
+//
+// Solidity: function getOperatorVotingPowers(address operator, bytes extraData) view returns((address,uint256)[])
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) GetOperatorVotingPowers(operator common.Address, extraData []byte) ([]IVotingPowerProviderVaultValue, error) {
+	return _VotingPowerProvider.Contract.GetOperatorVotingPowers(&_VotingPowerProvider.CallOpts, operator, extraData)
+}
+
+// GetOperatorVotingPowersAt is a free data retrieval call binding the contract method 0x380f9945.
+//
+// Solidity: function getOperatorVotingPowersAt(address operator, bytes extraData, uint48 timestamp) view returns((address,uint256)[])
+func (_VotingPowerProvider *VotingPowerProviderCaller) GetOperatorVotingPowersAt(opts *bind.CallOpts, operator common.Address, extraData []byte, timestamp *big.Int) ([]IVotingPowerProviderVaultValue, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "getOperatorVotingPowersAt", operator, extraData, timestamp)
+
+	if err != nil {
+		return *new([]IVotingPowerProviderVaultValue), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]IVotingPowerProviderVaultValue)).(*[]IVotingPowerProviderVaultValue)
+
+	return out0, err
+
+}
+
+// GetOperatorVotingPowersAt is a free data retrieval call binding the contract method 0x380f9945.
+//
+// Solidity: function getOperatorVotingPowersAt(address operator, bytes extraData, uint48 timestamp) view returns((address,uint256)[])
+func (_VotingPowerProvider *VotingPowerProviderSession) GetOperatorVotingPowersAt(operator common.Address, extraData []byte, timestamp *big.Int) ([]IVotingPowerProviderVaultValue, error) {
+	return _VotingPowerProvider.Contract.GetOperatorVotingPowersAt(&_VotingPowerProvider.CallOpts, operator, extraData, timestamp)
+}
+
+// GetOperatorVotingPowersAt is a free data retrieval call binding the contract method 0x380f9945.
+//
+// Solidity: function getOperatorVotingPowersAt(address operator, bytes extraData, uint48 timestamp) view returns((address,uint256)[])
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) GetOperatorVotingPowersAt(operator common.Address, extraData []byte, timestamp *big.Int) ([]IVotingPowerProviderVaultValue, error) {
+	return _VotingPowerProvider.Contract.GetOperatorVotingPowersAt(&_VotingPowerProvider.CallOpts, operator, extraData, timestamp)
+}
+
+// GetOperators is a free data retrieval call binding the contract method 0x27a099d8.
+//
+// Solidity: function getOperators() view returns(address[])
+func (_VotingPowerProvider *VotingPowerProviderCaller) GetOperators(opts *bind.CallOpts) ([]common.Address, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "getOperators")
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetOperators is a free data retrieval call binding the contract method 0x27a099d8.
+//
+// Solidity: function getOperators() view returns(address[])
+func (_VotingPowerProvider *VotingPowerProviderSession) GetOperators() ([]common.Address, error) {
+	return _VotingPowerProvider.Contract.GetOperators(&_VotingPowerProvider.CallOpts)
+}
+
+// GetOperators is a free data retrieval call binding the contract method 0x27a099d8.
+//
+// Solidity: function getOperators() view returns(address[])
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) GetOperators() ([]common.Address, error) {
+	return _VotingPowerProvider.Contract.GetOperators(&_VotingPowerProvider.CallOpts)
+}
+
+// GetOperatorsAt is a free data retrieval call binding the contract method 0xa2e33009.
+//
+// Solidity: function getOperatorsAt(uint48 timestamp) view returns(address[])
+func (_VotingPowerProvider *VotingPowerProviderCaller) GetOperatorsAt(opts *bind.CallOpts, timestamp *big.Int) ([]common.Address, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "getOperatorsAt", timestamp)
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetOperatorsAt is a free data retrieval call binding the contract method 0xa2e33009.
+//
+// Solidity: function getOperatorsAt(uint48 timestamp) view returns(address[])
+func (_VotingPowerProvider *VotingPowerProviderSession) GetOperatorsAt(timestamp *big.Int) ([]common.Address, error) {
+	return _VotingPowerProvider.Contract.GetOperatorsAt(&_VotingPowerProvider.CallOpts, timestamp)
+}
+
+// GetOperatorsAt is a free data retrieval call binding the contract method 0xa2e33009.
+//
+// Solidity: function getOperatorsAt(uint48 timestamp) view returns(address[])
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) GetOperatorsAt(timestamp *big.Int) ([]common.Address, error) {
+	return _VotingPowerProvider.Contract.GetOperatorsAt(&_VotingPowerProvider.CallOpts, timestamp)
+}
+
+// GetSharedVaults is a free data retrieval call binding the contract method 0xc28474cd.
+//
+// Solidity: function getSharedVaults() view returns(address[])
+func (_VotingPowerProvider *VotingPowerProviderCaller) GetSharedVaults(opts *bind.CallOpts) ([]common.Address, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "getSharedVaults")
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetSharedVaults is a free data retrieval call binding the contract method 0xc28474cd.
+//
+// Solidity: function getSharedVaults() view returns(address[])
+func (_VotingPowerProvider *VotingPowerProviderSession) GetSharedVaults() ([]common.Address, error) {
+	return _VotingPowerProvider.Contract.GetSharedVaults(&_VotingPowerProvider.CallOpts)
+}
+
+// GetSharedVaults is a free data retrieval call binding the contract method 0xc28474cd.
+//
+// Solidity: function getSharedVaults() view returns(address[])
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) GetSharedVaults() ([]common.Address, error) {
+	return _VotingPowerProvider.Contract.GetSharedVaults(&_VotingPowerProvider.CallOpts)
+}
+
+// GetSharedVaultsAt is a free data retrieval call binding the contract method 0x4a0c7c17.
+//
+// Solidity: function getSharedVaultsAt(uint48 timestamp) view returns(address[])
+func (_VotingPowerProvider *VotingPowerProviderCaller) GetSharedVaultsAt(opts *bind.CallOpts, timestamp *big.Int) ([]common.Address, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "getSharedVaultsAt", timestamp)
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetSharedVaultsAt is a free data retrieval call binding the contract method 0x4a0c7c17.
+//
+// Solidity: function getSharedVaultsAt(uint48 timestamp) view returns(address[])
+func (_VotingPowerProvider *VotingPowerProviderSession) GetSharedVaultsAt(timestamp *big.Int) ([]common.Address, error) {
+	return _VotingPowerProvider.Contract.GetSharedVaultsAt(&_VotingPowerProvider.CallOpts, timestamp)
+}
+
+// GetSharedVaultsAt is a free data retrieval call binding the contract method 0x4a0c7c17.
+//
+// Solidity: function getSharedVaultsAt(uint48 timestamp) view returns(address[])
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) GetSharedVaultsAt(timestamp *big.Int) ([]common.Address, error) {
+	return _VotingPowerProvider.Contract.GetSharedVaultsAt(&_VotingPowerProvider.CallOpts, timestamp)
+}
+
+// GetSlashingData is a free data retrieval call binding the contract method 0xf7dfb974.
+//
+// Solidity: function getSlashingData() view returns(bool requireSlasher, uint48 minVaultEpochDuration)
+func (_VotingPowerProvider *VotingPowerProviderCaller) GetSlashingData(opts *bind.CallOpts) (struct {
+	RequireSlasher        bool
+	MinVaultEpochDuration *big.Int
+}, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "getSlashingData")
+
+	outstruct := new(struct {
+		RequireSlasher        bool
+		MinVaultEpochDuration *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.RequireSlasher = *abi.ConvertType(out[0], new(bool)).(*bool)
+	outstruct.MinVaultEpochDuration = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetSlashingData is a free data retrieval call binding the contract method 0xf7dfb974.
+//
+// Solidity: function getSlashingData() view returns(bool requireSlasher, uint48 minVaultEpochDuration)
+func (_VotingPowerProvider *VotingPowerProviderSession) GetSlashingData() (struct {
+	RequireSlasher        bool
+	MinVaultEpochDuration *big.Int
+}, error) {
+	return _VotingPowerProvider.Contract.GetSlashingData(&_VotingPowerProvider.CallOpts)
+}
+
+// GetSlashingData is a free data retrieval call binding the contract method 0xf7dfb974.
+//
+// Solidity: function getSlashingData() view returns(bool requireSlasher, uint48 minVaultEpochDuration)
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) GetSlashingData() (struct {
+	RequireSlasher        bool
+	MinVaultEpochDuration *big.Int
+}, error) {
+	return _VotingPowerProvider.Contract.GetSlashingData(&_VotingPowerProvider.CallOpts)
+}
+
+// GetSlashingDataAt is a free data retrieval call binding the contract method 0x7a74c7fd.
+//
+// Solidity: function getSlashingDataAt(uint48 timestamp, bytes hint) view returns(bool requireSlasher, uint48 minVaultEpochDuration)
+func (_VotingPowerProvider *VotingPowerProviderCaller) GetSlashingDataAt(opts *bind.CallOpts, timestamp *big.Int, hint []byte) (struct {
+	RequireSlasher        bool
+	MinVaultEpochDuration *big.Int
+}, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "getSlashingDataAt", timestamp, hint)
+
+	outstruct := new(struct {
+		RequireSlasher        bool
+		MinVaultEpochDuration *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.RequireSlasher = *abi.ConvertType(out[0], new(bool)).(*bool)
+	outstruct.MinVaultEpochDuration = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetSlashingDataAt is a free data retrieval call binding the contract method 0x7a74c7fd.
+//
+// Solidity: function getSlashingDataAt(uint48 timestamp, bytes hint) view returns(bool requireSlasher, uint48 minVaultEpochDuration)
+func (_VotingPowerProvider *VotingPowerProviderSession) GetSlashingDataAt(timestamp *big.Int, hint []byte) (struct {
+	RequireSlasher        bool
+	MinVaultEpochDuration *big.Int
+}, error) {
+	return _VotingPowerProvider.Contract.GetSlashingDataAt(&_VotingPowerProvider.CallOpts, timestamp, hint)
+}
+
+// GetSlashingDataAt is a free data retrieval call binding the contract method 0x7a74c7fd.
+//
+// Solidity: function getSlashingDataAt(uint48 timestamp, bytes hint) view returns(bool requireSlasher, uint48 minVaultEpochDuration)
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) GetSlashingDataAt(timestamp *big.Int, hint []byte) (struct {
+	RequireSlasher        bool
+	MinVaultEpochDuration *big.Int
+}, error) {
+	return _VotingPowerProvider.Contract.GetSlashingDataAt(&_VotingPowerProvider.CallOpts, timestamp, hint)
+}
+
+// GetTokens is a free data retrieval call binding the contract method 0xaa6ca808.
+//
+// Solidity: function getTokens() view returns(address[])
+func (_VotingPowerProvider *VotingPowerProviderCaller) GetTokens(opts *bind.CallOpts) ([]common.Address, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "getTokens")
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetTokens is a free data retrieval call binding the contract method 0xaa6ca808.
+//
+// Solidity: function getTokens() view returns(address[])
+func (_VotingPowerProvider *VotingPowerProviderSession) GetTokens() ([]common.Address, error) {
+	return _VotingPowerProvider.Contract.GetTokens(&_VotingPowerProvider.CallOpts)
+}
+
+// GetTokens is a free data retrieval call binding the contract method 0xaa6ca808.
+//
+// Solidity: function getTokens() view returns(address[])
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) GetTokens() ([]common.Address, error) {
+	return _VotingPowerProvider.Contract.GetTokens(&_VotingPowerProvider.CallOpts)
+}
+
+// GetTokensAt is a free data retrieval call binding the contract method 0x1796df1b.
+//
+// Solidity: function getTokensAt(uint48 timestamp) view returns(address[])
+func (_VotingPowerProvider *VotingPowerProviderCaller) GetTokensAt(opts *bind.CallOpts, timestamp *big.Int) ([]common.Address, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "getTokensAt", timestamp)
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetTokensAt is a free data retrieval call binding the contract method 0x1796df1b.
+//
+// Solidity: function getTokensAt(uint48 timestamp) view returns(address[])
+func (_VotingPowerProvider *VotingPowerProviderSession) GetTokensAt(timestamp *big.Int) ([]common.Address, error) {
+	return _VotingPowerProvider.Contract.GetTokensAt(&_VotingPowerProvider.CallOpts, timestamp)
+}
+
+// GetTokensAt is a free data retrieval call binding the contract method 0x1796df1b.
+//
+// Solidity: function getTokensAt(uint48 timestamp) view returns(address[])
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) GetTokensAt(timestamp *big.Int) ([]common.Address, error) {
+	return _VotingPowerProvider.Contract.GetTokensAt(&_VotingPowerProvider.CallOpts, timestamp)
+}
+
+// GetVotingPowers is a free data retrieval call binding the contract method 0xff7cd71c.
+//
+// Solidity: function getVotingPowers(bytes[] extraData) view returns((address,(address,uint256)[])[])
+func (_VotingPowerProvider *VotingPowerProviderCaller) GetVotingPowers(opts *bind.CallOpts, extraData [][]byte) ([]IVotingPowerProviderOperatorVotingPower, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "getVotingPowers", extraData)
+
+	if err != nil {
+		return *new([]IVotingPowerProviderOperatorVotingPower), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]IVotingPowerProviderOperatorVotingPower)).(*[]IVotingPowerProviderOperatorVotingPower)
+
+	return out0, err
+
+}
+
+// GetVotingPowers is a free data retrieval call binding the contract method 0xff7cd71c.
+//
+// Solidity: function getVotingPowers(bytes[] extraData) view returns((address,(address,uint256)[])[])
+func (_VotingPowerProvider *VotingPowerProviderSession) GetVotingPowers(extraData [][]byte) ([]IVotingPowerProviderOperatorVotingPower, error) {
+	return _VotingPowerProvider.Contract.GetVotingPowers(&_VotingPowerProvider.CallOpts, extraData)
+}
+
+// GetVotingPowers is a free data retrieval call binding the contract method 0xff7cd71c.
+//
+// Solidity: function getVotingPowers(bytes[] extraData) view returns((address,(address,uint256)[])[])
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) GetVotingPowers(extraData [][]byte) ([]IVotingPowerProviderOperatorVotingPower, error) {
+	return _VotingPowerProvider.Contract.GetVotingPowers(&_VotingPowerProvider.CallOpts, extraData)
+}
+
+// GetVotingPowersAt is a free data retrieval call binding the contract method 0x77adea5f.
+//
+// Solidity: function getVotingPowersAt(bytes[] extraData, uint48 timestamp) view returns((address,(address,uint256)[])[])
+func (_VotingPowerProvider *VotingPowerProviderCaller) GetVotingPowersAt(opts *bind.CallOpts, extraData [][]byte, timestamp *big.Int) ([]IVotingPowerProviderOperatorVotingPower, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "getVotingPowersAt", extraData, timestamp)
+
+	if err != nil {
+		return *new([]IVotingPowerProviderOperatorVotingPower), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]IVotingPowerProviderOperatorVotingPower)).(*[]IVotingPowerProviderOperatorVotingPower)
+
+	return out0, err
+
+}
+
+// GetVotingPowersAt is a free data retrieval call binding the contract method 0x77adea5f.
+//
+// Solidity: function getVotingPowersAt(bytes[] extraData, uint48 timestamp) view returns((address,(address,uint256)[])[])
+func (_VotingPowerProvider *VotingPowerProviderSession) GetVotingPowersAt(extraData [][]byte, timestamp *big.Int) ([]IVotingPowerProviderOperatorVotingPower, error) {
+	return _VotingPowerProvider.Contract.GetVotingPowersAt(&_VotingPowerProvider.CallOpts, extraData, timestamp)
+}
+
+// GetVotingPowersAt is a free data retrieval call binding the contract method 0x77adea5f.
+//
+// Solidity: function getVotingPowersAt(bytes[] extraData, uint48 timestamp) view returns((address,(address,uint256)[])[])
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) GetVotingPowersAt(extraData [][]byte, timestamp *big.Int) ([]IVotingPowerProviderOperatorVotingPower, error) {
+	return _VotingPowerProvider.Contract.GetVotingPowersAt(&_VotingPowerProvider.CallOpts, extraData, timestamp)
+}
+
+// HashTypedDataV4 is a free data retrieval call binding the contract method 0x4980f288.
+//
+// Solidity: function hashTypedDataV4(bytes32 structHash) view returns(bytes32)
+func (_VotingPowerProvider *VotingPowerProviderCaller) HashTypedDataV4(opts *bind.CallOpts, structHash [32]byte) ([32]byte, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "hashTypedDataV4", structHash)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// HashTypedDataV4 is a free data retrieval call binding the contract method 0x4980f288.
+//
+// Solidity: function hashTypedDataV4(bytes32 structHash) view returns(bytes32)
+func (_VotingPowerProvider *VotingPowerProviderSession) HashTypedDataV4(structHash [32]byte) ([32]byte, error) {
+	return _VotingPowerProvider.Contract.HashTypedDataV4(&_VotingPowerProvider.CallOpts, structHash)
+}
+
+// HashTypedDataV4 is a free data retrieval call binding the contract method 0x4980f288.
+//
+// Solidity: function hashTypedDataV4(bytes32 structHash) view returns(bytes32)
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) HashTypedDataV4(structHash [32]byte) ([32]byte, error) {
+	return _VotingPowerProvider.Contract.HashTypedDataV4(&_VotingPowerProvider.CallOpts, structHash)
+}
+
+// HashTypedDataV4CrossChain is a free data retrieval call binding the contract method 0x518dcf3b.
+//
+// Solidity: function hashTypedDataV4CrossChain(bytes32 structHash) view returns(bytes32)
+func (_VotingPowerProvider *VotingPowerProviderCaller) HashTypedDataV4CrossChain(opts *bind.CallOpts, structHash [32]byte) ([32]byte, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "hashTypedDataV4CrossChain", structHash)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// HashTypedDataV4CrossChain is a free data retrieval call binding the contract method 0x518dcf3b.
+//
+// Solidity: function hashTypedDataV4CrossChain(bytes32 structHash) view returns(bytes32)
+func (_VotingPowerProvider *VotingPowerProviderSession) HashTypedDataV4CrossChain(structHash [32]byte) ([32]byte, error) {
+	return _VotingPowerProvider.Contract.HashTypedDataV4CrossChain(&_VotingPowerProvider.CallOpts, structHash)
+}
+
+// HashTypedDataV4CrossChain is a free data retrieval call binding the contract method 0x518dcf3b.
+//
+// Solidity: function hashTypedDataV4CrossChain(bytes32 structHash) view returns(bytes32)
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) HashTypedDataV4CrossChain(structHash [32]byte) ([32]byte, error) {
+	return _VotingPowerProvider.Contract.HashTypedDataV4CrossChain(&_VotingPowerProvider.CallOpts, structHash)
+}
+
+// IsOperatorRegistered is a free data retrieval call binding the contract method 0x6b1906f8.
+//
+// Solidity: function isOperatorRegistered(address operator) view returns(bool)
+func (_VotingPowerProvider *VotingPowerProviderCaller) IsOperatorRegistered(opts *bind.CallOpts, operator common.Address) (bool, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "isOperatorRegistered", operator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsOperatorRegistered is a free data retrieval call binding the contract method 0x6b1906f8.
+//
+// Solidity: function isOperatorRegistered(address operator) view returns(bool)
+func (_VotingPowerProvider *VotingPowerProviderSession) IsOperatorRegistered(operator common.Address) (bool, error) {
+	return _VotingPowerProvider.Contract.IsOperatorRegistered(&_VotingPowerProvider.CallOpts, operator)
+}
+
+// IsOperatorRegistered is a free data retrieval call binding the contract method 0x6b1906f8.
+//
+// Solidity: function isOperatorRegistered(address operator) view returns(bool)
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) IsOperatorRegistered(operator common.Address) (bool, error) {
+	return _VotingPowerProvider.Contract.IsOperatorRegistered(&_VotingPowerProvider.CallOpts, operator)
+}
+
+// IsOperatorRegisteredAt is a free data retrieval call binding the contract method 0x8a5aef51.
+//
+// Solidity: function isOperatorRegisteredAt(address operator, uint48 timestamp) view returns(bool)
+func (_VotingPowerProvider *VotingPowerProviderCaller) IsOperatorRegisteredAt(opts *bind.CallOpts, operator common.Address, timestamp *big.Int) (bool, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "isOperatorRegisteredAt", operator, timestamp)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsOperatorRegisteredAt is a free data retrieval call binding the contract method 0x8a5aef51.
+//
+// Solidity: function isOperatorRegisteredAt(address operator, uint48 timestamp) view returns(bool)
+func (_VotingPowerProvider *VotingPowerProviderSession) IsOperatorRegisteredAt(operator common.Address, timestamp *big.Int) (bool, error) {
+	return _VotingPowerProvider.Contract.IsOperatorRegisteredAt(&_VotingPowerProvider.CallOpts, operator, timestamp)
+}
+
+// IsOperatorRegisteredAt is a free data retrieval call binding the contract method 0x8a5aef51.
+//
+// Solidity: function isOperatorRegisteredAt(address operator, uint48 timestamp) view returns(bool)
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) IsOperatorRegisteredAt(operator common.Address, timestamp *big.Int) (bool, error) {
+	return _VotingPowerProvider.Contract.IsOperatorRegisteredAt(&_VotingPowerProvider.CallOpts, operator, timestamp)
+}
+
+// IsOperatorVaultRegistered is a free data retrieval call binding the contract method 0x0f6e0743.
+//
+// Solidity: function isOperatorVaultRegistered(address vault) view returns(bool)
+func (_VotingPowerProvider *VotingPowerProviderCaller) IsOperatorVaultRegistered(opts *bind.CallOpts, vault common.Address) (bool, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "isOperatorVaultRegistered", vault)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsOperatorVaultRegistered is a free data retrieval call binding the contract method 0x0f6e0743.
+//
+// Solidity: function isOperatorVaultRegistered(address vault) view returns(bool)
+func (_VotingPowerProvider *VotingPowerProviderSession) IsOperatorVaultRegistered(vault common.Address) (bool, error) {
+	return _VotingPowerProvider.Contract.IsOperatorVaultRegistered(&_VotingPowerProvider.CallOpts, vault)
+}
+
+// IsOperatorVaultRegistered is a free data retrieval call binding the contract method 0x0f6e0743.
+//
+// Solidity: function isOperatorVaultRegistered(address vault) view returns(bool)
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) IsOperatorVaultRegistered(vault common.Address) (bool, error) {
+	return _VotingPowerProvider.Contract.IsOperatorVaultRegistered(&_VotingPowerProvider.CallOpts, vault)
+}
+
+// IsOperatorVaultRegistered0 is a free data retrieval call binding the contract method 0x669fa8c7.
+//
+// Solidity: function isOperatorVaultRegistered(address operator, address vault) view returns(bool)
+func (_VotingPowerProvider *VotingPowerProviderCaller) IsOperatorVaultRegistered0(opts *bind.CallOpts, operator common.Address, vault common.Address) (bool, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "isOperatorVaultRegistered0", operator, vault)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsOperatorVaultRegistered0 is a free data retrieval call binding the contract method 0x669fa8c7.
+//
+// Solidity: function isOperatorVaultRegistered(address operator, address vault) view returns(bool)
+func (_VotingPowerProvider *VotingPowerProviderSession) IsOperatorVaultRegistered0(operator common.Address, vault common.Address) (bool, error) {
+	return _VotingPowerProvider.Contract.IsOperatorVaultRegistered0(&_VotingPowerProvider.CallOpts, operator, vault)
+}
+
+// IsOperatorVaultRegistered0 is a free data retrieval call binding the contract method 0x669fa8c7.
+//
+// Solidity: function isOperatorVaultRegistered(address operator, address vault) view returns(bool)
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) IsOperatorVaultRegistered0(operator common.Address, vault common.Address) (bool, error) {
+	return _VotingPowerProvider.Contract.IsOperatorVaultRegistered0(&_VotingPowerProvider.CallOpts, operator, vault)
+}
+
+// IsOperatorVaultRegisteredAt is a free data retrieval call binding the contract method 0xf6c011de.
+//
+// Solidity: function isOperatorVaultRegisteredAt(address vault, uint48 timestamp) view returns(bool)
+func (_VotingPowerProvider *VotingPowerProviderCaller) IsOperatorVaultRegisteredAt(opts *bind.CallOpts, vault common.Address, timestamp *big.Int) (bool, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "isOperatorVaultRegisteredAt", vault, timestamp)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsOperatorVaultRegisteredAt is a free data retrieval call binding the contract method 0xf6c011de.
+//
+// Solidity: function isOperatorVaultRegisteredAt(address vault, uint48 timestamp) view returns(bool)
+func (_VotingPowerProvider *VotingPowerProviderSession) IsOperatorVaultRegisteredAt(vault common.Address, timestamp *big.Int) (bool, error) {
+	return _VotingPowerProvider.Contract.IsOperatorVaultRegisteredAt(&_VotingPowerProvider.CallOpts, vault, timestamp)
+}
+
+// IsOperatorVaultRegisteredAt is a free data retrieval call binding the contract method 0xf6c011de.
+//
+// Solidity: function isOperatorVaultRegisteredAt(address vault, uint48 timestamp) view returns(bool)
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) IsOperatorVaultRegisteredAt(vault common.Address, timestamp *big.Int) (bool, error) {
+	return _VotingPowerProvider.Contract.IsOperatorVaultRegisteredAt(&_VotingPowerProvider.CallOpts, vault, timestamp)
+}
+
+// IsOperatorVaultRegisteredAt0 is a free data retrieval call binding the contract method 0xdae8f803.
+//
+// Solidity: function isOperatorVaultRegisteredAt(address operator, address vault, uint48 timestamp) view returns(bool)
+func (_VotingPowerProvider *VotingPowerProviderCaller) IsOperatorVaultRegisteredAt0(opts *bind.CallOpts, operator common.Address, vault common.Address, timestamp *big.Int) (bool, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "isOperatorVaultRegisteredAt0", operator, vault, timestamp)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsOperatorVaultRegisteredAt0 is a free data retrieval call binding the contract method 0xdae8f803.
+//
+// Solidity: function isOperatorVaultRegisteredAt(address operator, address vault, uint48 timestamp) view returns(bool)
+func (_VotingPowerProvider *VotingPowerProviderSession) IsOperatorVaultRegisteredAt0(operator common.Address, vault common.Address, timestamp *big.Int) (bool, error) {
+	return _VotingPowerProvider.Contract.IsOperatorVaultRegisteredAt0(&_VotingPowerProvider.CallOpts, operator, vault, timestamp)
+}
+
+// IsOperatorVaultRegisteredAt0 is a free data retrieval call binding the contract method 0xdae8f803.
+//
+// Solidity: function isOperatorVaultRegisteredAt(address operator, address vault, uint48 timestamp) view returns(bool)
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) IsOperatorVaultRegisteredAt0(operator common.Address, vault common.Address, timestamp *big.Int) (bool, error) {
+	return _VotingPowerProvider.Contract.IsOperatorVaultRegisteredAt0(&_VotingPowerProvider.CallOpts, operator, vault, timestamp)
+}
+
+// IsSharedVaultRegistered is a free data retrieval call binding the contract method 0x9a1ebee9.
+//
+// Solidity: function isSharedVaultRegistered(address vault) view returns(bool)
+func (_VotingPowerProvider *VotingPowerProviderCaller) IsSharedVaultRegistered(opts *bind.CallOpts, vault common.Address) (bool, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "isSharedVaultRegistered", vault)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsSharedVaultRegistered is a free data retrieval call binding the contract method 0x9a1ebee9.
+//
+// Solidity: function isSharedVaultRegistered(address vault) view returns(bool)
+func (_VotingPowerProvider *VotingPowerProviderSession) IsSharedVaultRegistered(vault common.Address) (bool, error) {
+	return _VotingPowerProvider.Contract.IsSharedVaultRegistered(&_VotingPowerProvider.CallOpts, vault)
+}
+
+// IsSharedVaultRegistered is a free data retrieval call binding the contract method 0x9a1ebee9.
+//
+// Solidity: function isSharedVaultRegistered(address vault) view returns(bool)
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) IsSharedVaultRegistered(vault common.Address) (bool, error) {
+	return _VotingPowerProvider.Contract.IsSharedVaultRegistered(&_VotingPowerProvider.CallOpts, vault)
+}
+
+// IsSharedVaultRegisteredAt is a free data retrieval call binding the contract method 0xe66e1ed2.
+//
+// Solidity: function isSharedVaultRegisteredAt(address vault, uint48 timestamp) view returns(bool)
+func (_VotingPowerProvider *VotingPowerProviderCaller) IsSharedVaultRegisteredAt(opts *bind.CallOpts, vault common.Address, timestamp *big.Int) (bool, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "isSharedVaultRegisteredAt", vault, timestamp)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsSharedVaultRegisteredAt is a free data retrieval call binding the contract method 0xe66e1ed2.
+//
+// Solidity: function isSharedVaultRegisteredAt(address vault, uint48 timestamp) view returns(bool)
+func (_VotingPowerProvider *VotingPowerProviderSession) IsSharedVaultRegisteredAt(vault common.Address, timestamp *big.Int) (bool, error) {
+	return _VotingPowerProvider.Contract.IsSharedVaultRegisteredAt(&_VotingPowerProvider.CallOpts, vault, timestamp)
+}
+
+// IsSharedVaultRegisteredAt is a free data retrieval call binding the contract method 0xe66e1ed2.
+//
+// Solidity: function isSharedVaultRegisteredAt(address vault, uint48 timestamp) view returns(bool)
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) IsSharedVaultRegisteredAt(vault common.Address, timestamp *big.Int) (bool, error) {
+	return _VotingPowerProvider.Contract.IsSharedVaultRegisteredAt(&_VotingPowerProvider.CallOpts, vault, timestamp)
+}
+
+// IsTokenRegistered is a free data retrieval call binding the contract method 0x26aa101f.
+//
+// Solidity: function isTokenRegistered(address token) view returns(bool)
+func (_VotingPowerProvider *VotingPowerProviderCaller) IsTokenRegistered(opts *bind.CallOpts, token common.Address) (bool, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "isTokenRegistered", token)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsTokenRegistered is a free data retrieval call binding the contract method 0x26aa101f.
+//
+// Solidity: function isTokenRegistered(address token) view returns(bool)
+func (_VotingPowerProvider *VotingPowerProviderSession) IsTokenRegistered(token common.Address) (bool, error) {
+	return _VotingPowerProvider.Contract.IsTokenRegistered(&_VotingPowerProvider.CallOpts, token)
+}
+
+// IsTokenRegistered is a free data retrieval call binding the contract method 0x26aa101f.
+//
+// Solidity: function isTokenRegistered(address token) view returns(bool)
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) IsTokenRegistered(token common.Address) (bool, error) {
+	return _VotingPowerProvider.Contract.IsTokenRegistered(&_VotingPowerProvider.CallOpts, token)
+}
+
+// IsTokenRegisteredAt is a free data retrieval call binding the contract method 0x6a496108.
+//
+// Solidity: function isTokenRegisteredAt(address token, uint48 timestamp) view returns(bool)
+func (_VotingPowerProvider *VotingPowerProviderCaller) IsTokenRegisteredAt(opts *bind.CallOpts, token common.Address, timestamp *big.Int) (bool, error) {
+	var out []interface{}
+	err := _VotingPowerProvider.contract.Call(opts, &out, "isTokenRegisteredAt", token, timestamp)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsTokenRegisteredAt is a free data retrieval call binding the contract method 0x6a496108.
+//
+// Solidity: function isTokenRegisteredAt(address token, uint48 timestamp) view returns(bool)
+func (_VotingPowerProvider *VotingPowerProviderSession) IsTokenRegisteredAt(token common.Address, timestamp *big.Int) (bool, error) {
+	return _VotingPowerProvider.Contract.IsTokenRegisteredAt(&_VotingPowerProvider.CallOpts, token, timestamp)
+}
+
+// IsTokenRegisteredAt is a free data retrieval call binding the contract method 0x6a496108.
+//
+// Solidity: function isTokenRegisteredAt(address token, uint48 timestamp) view returns(bool)
+func (_VotingPowerProvider *VotingPowerProviderCallerSession) IsTokenRegisteredAt(token common.Address, timestamp *big.Int) (bool, error) {
+	return _VotingPowerProvider.Contract.IsTokenRegisteredAt(&_VotingPowerProvider.CallOpts, token, timestamp)
+}
+
+// InvalidateOldSignatures is a paid mutator transaction binding the contract method 0x622e4dba.
+//
+// Solidity: function invalidateOldSignatures() returns()
+func (_VotingPowerProvider *VotingPowerProviderTransactor) InvalidateOldSignatures(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _VotingPowerProvider.contract.Transact(opts, "invalidateOldSignatures")
+}
+
+// InvalidateOldSignatures is a paid mutator transaction binding the contract method 0x622e4dba.
+//
+// Solidity: function invalidateOldSignatures() returns()
+func (_VotingPowerProvider *VotingPowerProviderSession) InvalidateOldSignatures() (*types.Transaction, error) {
+	return _VotingPowerProvider.Contract.InvalidateOldSignatures(&_VotingPowerProvider.TransactOpts)
+}
+
+// InvalidateOldSignatures is a paid mutator transaction binding the contract method 0x622e4dba.
+//
+// Solidity: function invalidateOldSignatures() returns()
+func (_VotingPowerProvider *VotingPowerProviderTransactorSession) InvalidateOldSignatures() (*types.Transaction, error) {
+	return _VotingPowerProvider.Contract.InvalidateOldSignatures(&_VotingPowerProvider.TransactOpts)
+}
+
+// RegisterOperator is a paid mutator transaction binding the contract method 0x2acde098.
+//
+// Solidity: function registerOperator() returns()
+func (_VotingPowerProvider *VotingPowerProviderTransactor) RegisterOperator(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _VotingPowerProvider.contract.Transact(opts, "registerOperator")
+}
+
+// RegisterOperator is a paid mutator transaction binding the contract method 0x2acde098.
+//
+// Solidity: function registerOperator() returns()
+func (_VotingPowerProvider *VotingPowerProviderSession) RegisterOperator() (*types.Transaction, error) {
+	return _VotingPowerProvider.Contract.RegisterOperator(&_VotingPowerProvider.TransactOpts)
+}
+
+// RegisterOperator is a paid mutator transaction binding the contract method 0x2acde098.
+//
+// Solidity: function registerOperator() returns()
+func (_VotingPowerProvider *VotingPowerProviderTransactorSession) RegisterOperator() (*types.Transaction, error) {
+	return _VotingPowerProvider.Contract.RegisterOperator(&_VotingPowerProvider.TransactOpts)
+}
+
+// RegisterOperatorWithSignature is a paid mutator transaction binding the contract method 0xeb5e940d.
+//
+// Solidity: function registerOperatorWithSignature(address operator, bytes signature) returns()
+func (_VotingPowerProvider *VotingPowerProviderTransactor) RegisterOperatorWithSignature(opts *bind.TransactOpts, operator common.Address, signature []byte) (*types.Transaction, error) {
+	return _VotingPowerProvider.contract.Transact(opts, "registerOperatorWithSignature", operator, signature)
+}
+
+// RegisterOperatorWithSignature is a paid mutator transaction binding the contract method 0xeb5e940d.
+//
+// Solidity: function registerOperatorWithSignature(address operator, bytes signature) returns()
+func (_VotingPowerProvider *VotingPowerProviderSession) RegisterOperatorWithSignature(operator common.Address, signature []byte) (*types.Transaction, error) {
+	return _VotingPowerProvider.Contract.RegisterOperatorWithSignature(&_VotingPowerProvider.TransactOpts, operator, signature)
+}
+
+// RegisterOperatorWithSignature is a paid mutator transaction binding the contract method 0xeb5e940d.
+//
+// Solidity: function registerOperatorWithSignature(address operator, bytes signature) returns()
+func (_VotingPowerProvider *VotingPowerProviderTransactorSession) RegisterOperatorWithSignature(operator common.Address, signature []byte) (*types.Transaction, error) {
+	return _VotingPowerProvider.Contract.RegisterOperatorWithSignature(&_VotingPowerProvider.TransactOpts, operator, signature)
+}
+
+// UnregisterOperator is a paid mutator transaction binding the contract method 0xa876b89a.
+//
+// Solidity: function unregisterOperator() returns()
+func (_VotingPowerProvider *VotingPowerProviderTransactor) UnregisterOperator(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _VotingPowerProvider.contract.Transact(opts, "unregisterOperator")
+}
+
+// UnregisterOperator is a paid mutator transaction binding the contract method 0xa876b89a.
+//
+// Solidity: function unregisterOperator() returns()
+func (_VotingPowerProvider *VotingPowerProviderSession) UnregisterOperator() (*types.Transaction, error) {
+	return _VotingPowerProvider.Contract.UnregisterOperator(&_VotingPowerProvider.TransactOpts)
+}
+
+// UnregisterOperator is a paid mutator transaction binding the contract method 0xa876b89a.
+//
+// Solidity: function unregisterOperator() returns()
+func (_VotingPowerProvider *VotingPowerProviderTransactorSession) UnregisterOperator() (*types.Transaction, error) {
+	return _VotingPowerProvider.Contract.UnregisterOperator(&_VotingPowerProvider.TransactOpts)
+}
+
+// UnregisterOperatorWithSignature is a paid mutator transaction binding the contract method 0xf96d1946.
+//
+// Solidity: function unregisterOperatorWithSignature(address operator, bytes signature) returns()
+func (_VotingPowerProvider *VotingPowerProviderTransactor) UnregisterOperatorWithSignature(opts *bind.TransactOpts, operator common.Address, signature []byte) (*types.Transaction, error) {
+	return _VotingPowerProvider.contract.Transact(opts, "unregisterOperatorWithSignature", operator, signature)
+}
+
+// UnregisterOperatorWithSignature is a paid mutator transaction binding the contract method 0xf96d1946.
+//
+// Solidity: function unregisterOperatorWithSignature(address operator, bytes signature) returns()
+func (_VotingPowerProvider *VotingPowerProviderSession) UnregisterOperatorWithSignature(operator common.Address, signature []byte) (*types.Transaction, error) {
+	return _VotingPowerProvider.Contract.UnregisterOperatorWithSignature(&_VotingPowerProvider.TransactOpts, operator, signature)
+}
+
+// UnregisterOperatorWithSignature is a paid mutator transaction binding the contract method 0xf96d1946.
+//
+// Solidity: function unregisterOperatorWithSignature(address operator, bytes signature) returns()
+func (_VotingPowerProvider *VotingPowerProviderTransactorSession) UnregisterOperatorWithSignature(operator common.Address, signature []byte) (*types.Transaction, error) {
+	return _VotingPowerProvider.Contract.UnregisterOperatorWithSignature(&_VotingPowerProvider.TransactOpts, operator, signature)
+}
+
+// VotingPowerProviderEIP712DomainChangedIterator is returned from FilterEIP712DomainChanged and is used to iterate over the raw logs and unpacked data for EIP712DomainChanged events raised by the VotingPowerProvider contract.
+type VotingPowerProviderEIP712DomainChangedIterator struct {
+	Event *VotingPowerProviderEIP712DomainChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VotingPowerProviderEIP712DomainChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VotingPowerProviderEIP712DomainChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VotingPowerProviderEIP712DomainChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VotingPowerProviderEIP712DomainChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VotingPowerProviderEIP712DomainChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VotingPowerProviderEIP712DomainChanged represents a EIP712DomainChanged event raised by the VotingPowerProvider contract.
+type VotingPowerProviderEIP712DomainChanged struct {
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterEIP712DomainChanged is a free log retrieval operation binding the contract event 0x0a6387c9ea3628b88a633bb4f3b151770f70085117a15f9bf3787cda53f13d31.
+//
+// Solidity: event EIP712DomainChanged()
+func (_VotingPowerProvider *VotingPowerProviderFilterer) FilterEIP712DomainChanged(opts *bind.FilterOpts) (*VotingPowerProviderEIP712DomainChangedIterator, error) {
+
+	logs, sub, err := _VotingPowerProvider.contract.FilterLogs(opts, "EIP712DomainChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &VotingPowerProviderEIP712DomainChangedIterator{contract: _VotingPowerProvider.contract, event: "EIP712DomainChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchEIP712DomainChanged is a free log subscription operation binding the contract event 0x0a6387c9ea3628b88a633bb4f3b151770f70085117a15f9bf3787cda53f13d31.
+//
+// Solidity: event EIP712DomainChanged()
+func (_VotingPowerProvider *VotingPowerProviderFilterer) WatchEIP712DomainChanged(opts *bind.WatchOpts, sink chan<- *VotingPowerProviderEIP712DomainChanged) (event.Subscription, error) {
+
+	logs, sub, err := _VotingPowerProvider.contract.WatchLogs(opts, "EIP712DomainChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VotingPowerProviderEIP712DomainChanged)
+				if err := _VotingPowerProvider.contract.UnpackLog(event, "EIP712DomainChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEIP712DomainChanged is a log parse operation binding the contract event 0x0a6387c9ea3628b88a633bb4f3b151770f70085117a15f9bf3787cda53f13d31.
+//
+// Solidity: event EIP712DomainChanged()
+func (_VotingPowerProvider *VotingPowerProviderFilterer) ParseEIP712DomainChanged(log types.Log) (*VotingPowerProviderEIP712DomainChanged, error) {
+	event := new(VotingPowerProviderEIP712DomainChanged)
+	if err := _VotingPowerProvider.contract.UnpackLog(event, "EIP712DomainChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VotingPowerProviderInitEIP712Iterator is returned from FilterInitEIP712 and is used to iterate over the raw logs and unpacked data for InitEIP712 events raised by the VotingPowerProvider contract.
+type VotingPowerProviderInitEIP712Iterator struct {
+	Event *VotingPowerProviderInitEIP712 // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VotingPowerProviderInitEIP712Iterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VotingPowerProviderInitEIP712)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VotingPowerProviderInitEIP712)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VotingPowerProviderInitEIP712Iterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VotingPowerProviderInitEIP712Iterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VotingPowerProviderInitEIP712 represents a InitEIP712 event raised by the VotingPowerProvider contract.
+type VotingPowerProviderInitEIP712 struct {
+	Name    string
+	Version string
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitEIP712 is a free log retrieval operation binding the contract event 0x98790bb3996c909e6f4279ffabdfe70fa6c0d49b8fa04656d6161decfc442e0a.
+//
+// Solidity: event InitEIP712(string name, string version)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) FilterInitEIP712(opts *bind.FilterOpts) (*VotingPowerProviderInitEIP712Iterator, error) {
+
+	logs, sub, err := _VotingPowerProvider.contract.FilterLogs(opts, "InitEIP712")
+	if err != nil {
+		return nil, err
+	}
+	return &VotingPowerProviderInitEIP712Iterator{contract: _VotingPowerProvider.contract, event: "InitEIP712", logs: logs, sub: sub}, nil
+}
+
+// WatchInitEIP712 is a free log subscription operation binding the contract event 0x98790bb3996c909e6f4279ffabdfe70fa6c0d49b8fa04656d6161decfc442e0a.
+//
+// Solidity: event InitEIP712(string name, string version)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) WatchInitEIP712(opts *bind.WatchOpts, sink chan<- *VotingPowerProviderInitEIP712) (event.Subscription, error) {
+
+	logs, sub, err := _VotingPowerProvider.contract.WatchLogs(opts, "InitEIP712")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VotingPowerProviderInitEIP712)
+				if err := _VotingPowerProvider.contract.UnpackLog(event, "InitEIP712", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitEIP712 is a log parse operation binding the contract event 0x98790bb3996c909e6f4279ffabdfe70fa6c0d49b8fa04656d6161decfc442e0a.
+//
+// Solidity: event InitEIP712(string name, string version)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) ParseInitEIP712(log types.Log) (*VotingPowerProviderInitEIP712, error) {
+	event := new(VotingPowerProviderInitEIP712)
+	if err := _VotingPowerProvider.contract.UnpackLog(event, "InitEIP712", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VotingPowerProviderInitSubnetworkIterator is returned from FilterInitSubnetwork and is used to iterate over the raw logs and unpacked data for InitSubnetwork events raised by the VotingPowerProvider contract.
+type VotingPowerProviderInitSubnetworkIterator struct {
+	Event *VotingPowerProviderInitSubnetwork // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VotingPowerProviderInitSubnetworkIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VotingPowerProviderInitSubnetwork)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VotingPowerProviderInitSubnetwork)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VotingPowerProviderInitSubnetworkIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VotingPowerProviderInitSubnetworkIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VotingPowerProviderInitSubnetwork represents a InitSubnetwork event raised by the VotingPowerProvider contract.
+type VotingPowerProviderInitSubnetwork struct {
+	Network      common.Address
+	SubnetworkId *big.Int
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitSubnetwork is a free log retrieval operation binding the contract event 0x469c2e982e7d76d34cf5d1e72abee29749bb9971942c180e9023cea09f5f8e83.
+//
+// Solidity: event InitSubnetwork(address network, uint96 subnetworkId)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) FilterInitSubnetwork(opts *bind.FilterOpts) (*VotingPowerProviderInitSubnetworkIterator, error) {
+
+	logs, sub, err := _VotingPowerProvider.contract.FilterLogs(opts, "InitSubnetwork")
+	if err != nil {
+		return nil, err
+	}
+	return &VotingPowerProviderInitSubnetworkIterator{contract: _VotingPowerProvider.contract, event: "InitSubnetwork", logs: logs, sub: sub}, nil
+}
+
+// WatchInitSubnetwork is a free log subscription operation binding the contract event 0x469c2e982e7d76d34cf5d1e72abee29749bb9971942c180e9023cea09f5f8e83.
+//
+// Solidity: event InitSubnetwork(address network, uint96 subnetworkId)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) WatchInitSubnetwork(opts *bind.WatchOpts, sink chan<- *VotingPowerProviderInitSubnetwork) (event.Subscription, error) {
+
+	logs, sub, err := _VotingPowerProvider.contract.WatchLogs(opts, "InitSubnetwork")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VotingPowerProviderInitSubnetwork)
+				if err := _VotingPowerProvider.contract.UnpackLog(event, "InitSubnetwork", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitSubnetwork is a log parse operation binding the contract event 0x469c2e982e7d76d34cf5d1e72abee29749bb9971942c180e9023cea09f5f8e83.
+//
+// Solidity: event InitSubnetwork(address network, uint96 subnetworkId)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) ParseInitSubnetwork(log types.Log) (*VotingPowerProviderInitSubnetwork, error) {
+	event := new(VotingPowerProviderInitSubnetwork)
+	if err := _VotingPowerProvider.contract.UnpackLog(event, "InitSubnetwork", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VotingPowerProviderRegisterOperatorIterator is returned from FilterRegisterOperator and is used to iterate over the raw logs and unpacked data for RegisterOperator events raised by the VotingPowerProvider contract.
+type VotingPowerProviderRegisterOperatorIterator struct {
+	Event *VotingPowerProviderRegisterOperator // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VotingPowerProviderRegisterOperatorIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VotingPowerProviderRegisterOperator)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VotingPowerProviderRegisterOperator)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VotingPowerProviderRegisterOperatorIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VotingPowerProviderRegisterOperatorIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VotingPowerProviderRegisterOperator represents a RegisterOperator event raised by the VotingPowerProvider contract.
+type VotingPowerProviderRegisterOperator struct {
+	Operator common.Address
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterRegisterOperator is a free log retrieval operation binding the contract event 0xdfd9e0392912bee97777ec588d2ff7ae010ea24202d153a0bff1b30aed643daa.
+//
+// Solidity: event RegisterOperator(address indexed operator)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) FilterRegisterOperator(opts *bind.FilterOpts, operator []common.Address) (*VotingPowerProviderRegisterOperatorIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _VotingPowerProvider.contract.FilterLogs(opts, "RegisterOperator", operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VotingPowerProviderRegisterOperatorIterator{contract: _VotingPowerProvider.contract, event: "RegisterOperator", logs: logs, sub: sub}, nil
+}
+
+// WatchRegisterOperator is a free log subscription operation binding the contract event 0xdfd9e0392912bee97777ec588d2ff7ae010ea24202d153a0bff1b30aed643daa.
+//
+// Solidity: event RegisterOperator(address indexed operator)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) WatchRegisterOperator(opts *bind.WatchOpts, sink chan<- *VotingPowerProviderRegisterOperator, operator []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _VotingPowerProvider.contract.WatchLogs(opts, "RegisterOperator", operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VotingPowerProviderRegisterOperator)
+				if err := _VotingPowerProvider.contract.UnpackLog(event, "RegisterOperator", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRegisterOperator is a log parse operation binding the contract event 0xdfd9e0392912bee97777ec588d2ff7ae010ea24202d153a0bff1b30aed643daa.
+//
+// Solidity: event RegisterOperator(address indexed operator)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) ParseRegisterOperator(log types.Log) (*VotingPowerProviderRegisterOperator, error) {
+	event := new(VotingPowerProviderRegisterOperator)
+	if err := _VotingPowerProvider.contract.UnpackLog(event, "RegisterOperator", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VotingPowerProviderRegisterOperatorVaultIterator is returned from FilterRegisterOperatorVault and is used to iterate over the raw logs and unpacked data for RegisterOperatorVault events raised by the VotingPowerProvider contract.
+type VotingPowerProviderRegisterOperatorVaultIterator struct {
+	Event *VotingPowerProviderRegisterOperatorVault // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VotingPowerProviderRegisterOperatorVaultIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VotingPowerProviderRegisterOperatorVault)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VotingPowerProviderRegisterOperatorVault)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VotingPowerProviderRegisterOperatorVaultIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VotingPowerProviderRegisterOperatorVaultIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VotingPowerProviderRegisterOperatorVault represents a RegisterOperatorVault event raised by the VotingPowerProvider contract.
+type VotingPowerProviderRegisterOperatorVault struct {
+	Operator common.Address
+	Vault    common.Address
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterRegisterOperatorVault is a free log retrieval operation binding the contract event 0x6db8d1ad7903329250db9b7a653d3aa009807c85daa2281a75e063808bceefdc.
+//
+// Solidity: event RegisterOperatorVault(address indexed operator, address indexed vault)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) FilterRegisterOperatorVault(opts *bind.FilterOpts, operator []common.Address, vault []common.Address) (*VotingPowerProviderRegisterOperatorVaultIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var vaultRule []interface{}
+	for _, vaultItem := range vault {
+		vaultRule = append(vaultRule, vaultItem)
+	}
+
+	logs, sub, err := _VotingPowerProvider.contract.FilterLogs(opts, "RegisterOperatorVault", operatorRule, vaultRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VotingPowerProviderRegisterOperatorVaultIterator{contract: _VotingPowerProvider.contract, event: "RegisterOperatorVault", logs: logs, sub: sub}, nil
+}
+
+// WatchRegisterOperatorVault is a free log subscription operation binding the contract event 0x6db8d1ad7903329250db9b7a653d3aa009807c85daa2281a75e063808bceefdc.
+//
+// Solidity: event RegisterOperatorVault(address indexed operator, address indexed vault)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) WatchRegisterOperatorVault(opts *bind.WatchOpts, sink chan<- *VotingPowerProviderRegisterOperatorVault, operator []common.Address, vault []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var vaultRule []interface{}
+	for _, vaultItem := range vault {
+		vaultRule = append(vaultRule, vaultItem)
+	}
+
+	logs, sub, err := _VotingPowerProvider.contract.WatchLogs(opts, "RegisterOperatorVault", operatorRule, vaultRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VotingPowerProviderRegisterOperatorVault)
+				if err := _VotingPowerProvider.contract.UnpackLog(event, "RegisterOperatorVault", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRegisterOperatorVault is a log parse operation binding the contract event 0x6db8d1ad7903329250db9b7a653d3aa009807c85daa2281a75e063808bceefdc.
+//
+// Solidity: event RegisterOperatorVault(address indexed operator, address indexed vault)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) ParseRegisterOperatorVault(log types.Log) (*VotingPowerProviderRegisterOperatorVault, error) {
+	event := new(VotingPowerProviderRegisterOperatorVault)
+	if err := _VotingPowerProvider.contract.UnpackLog(event, "RegisterOperatorVault", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VotingPowerProviderRegisterSharedVaultIterator is returned from FilterRegisterSharedVault and is used to iterate over the raw logs and unpacked data for RegisterSharedVault events raised by the VotingPowerProvider contract.
+type VotingPowerProviderRegisterSharedVaultIterator struct {
+	Event *VotingPowerProviderRegisterSharedVault // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VotingPowerProviderRegisterSharedVaultIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VotingPowerProviderRegisterSharedVault)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VotingPowerProviderRegisterSharedVault)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VotingPowerProviderRegisterSharedVaultIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VotingPowerProviderRegisterSharedVaultIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VotingPowerProviderRegisterSharedVault represents a RegisterSharedVault event raised by the VotingPowerProvider contract.
+type VotingPowerProviderRegisterSharedVault struct {
+	Vault common.Address
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterRegisterSharedVault is a free log retrieval operation binding the contract event 0x99528065e654d6d4b95447d6787148a84b7e98a95e752784e99da056b403b25c.
+//
+// Solidity: event RegisterSharedVault(address indexed vault)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) FilterRegisterSharedVault(opts *bind.FilterOpts, vault []common.Address) (*VotingPowerProviderRegisterSharedVaultIterator, error) {
+
+	var vaultRule []interface{}
+	for _, vaultItem := range vault {
+		vaultRule = append(vaultRule, vaultItem)
+	}
+
+	logs, sub, err := _VotingPowerProvider.contract.FilterLogs(opts, "RegisterSharedVault", vaultRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VotingPowerProviderRegisterSharedVaultIterator{contract: _VotingPowerProvider.contract, event: "RegisterSharedVault", logs: logs, sub: sub}, nil
+}
+
+// WatchRegisterSharedVault is a free log subscription operation binding the contract event 0x99528065e654d6d4b95447d6787148a84b7e98a95e752784e99da056b403b25c.
+//
+// Solidity: event RegisterSharedVault(address indexed vault)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) WatchRegisterSharedVault(opts *bind.WatchOpts, sink chan<- *VotingPowerProviderRegisterSharedVault, vault []common.Address) (event.Subscription, error) {
+
+	var vaultRule []interface{}
+	for _, vaultItem := range vault {
+		vaultRule = append(vaultRule, vaultItem)
+	}
+
+	logs, sub, err := _VotingPowerProvider.contract.WatchLogs(opts, "RegisterSharedVault", vaultRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VotingPowerProviderRegisterSharedVault)
+				if err := _VotingPowerProvider.contract.UnpackLog(event, "RegisterSharedVault", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRegisterSharedVault is a log parse operation binding the contract event 0x99528065e654d6d4b95447d6787148a84b7e98a95e752784e99da056b403b25c.
+//
+// Solidity: event RegisterSharedVault(address indexed vault)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) ParseRegisterSharedVault(log types.Log) (*VotingPowerProviderRegisterSharedVault, error) {
+	event := new(VotingPowerProviderRegisterSharedVault)
+	if err := _VotingPowerProvider.contract.UnpackLog(event, "RegisterSharedVault", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VotingPowerProviderRegisterTokenIterator is returned from FilterRegisterToken and is used to iterate over the raw logs and unpacked data for RegisterToken events raised by the VotingPowerProvider contract.
+type VotingPowerProviderRegisterTokenIterator struct {
+	Event *VotingPowerProviderRegisterToken // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VotingPowerProviderRegisterTokenIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VotingPowerProviderRegisterToken)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VotingPowerProviderRegisterToken)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VotingPowerProviderRegisterTokenIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VotingPowerProviderRegisterTokenIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VotingPowerProviderRegisterToken represents a RegisterToken event raised by the VotingPowerProvider contract.
+type VotingPowerProviderRegisterToken struct {
+	Token common.Address
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterRegisterToken is a free log retrieval operation binding the contract event 0xf7fe8023cb2e36bde1d59a88ac5763a8c11be6d25e6819f71bb7e23e5bf0dc16.
+//
+// Solidity: event RegisterToken(address indexed token)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) FilterRegisterToken(opts *bind.FilterOpts, token []common.Address) (*VotingPowerProviderRegisterTokenIterator, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _VotingPowerProvider.contract.FilterLogs(opts, "RegisterToken", tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VotingPowerProviderRegisterTokenIterator{contract: _VotingPowerProvider.contract, event: "RegisterToken", logs: logs, sub: sub}, nil
+}
+
+// WatchRegisterToken is a free log subscription operation binding the contract event 0xf7fe8023cb2e36bde1d59a88ac5763a8c11be6d25e6819f71bb7e23e5bf0dc16.
+//
+// Solidity: event RegisterToken(address indexed token)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) WatchRegisterToken(opts *bind.WatchOpts, sink chan<- *VotingPowerProviderRegisterToken, token []common.Address) (event.Subscription, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _VotingPowerProvider.contract.WatchLogs(opts, "RegisterToken", tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VotingPowerProviderRegisterToken)
+				if err := _VotingPowerProvider.contract.UnpackLog(event, "RegisterToken", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRegisterToken is a log parse operation binding the contract event 0xf7fe8023cb2e36bde1d59a88ac5763a8c11be6d25e6819f71bb7e23e5bf0dc16.
+//
+// Solidity: event RegisterToken(address indexed token)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) ParseRegisterToken(log types.Log) (*VotingPowerProviderRegisterToken, error) {
+	event := new(VotingPowerProviderRegisterToken)
+	if err := _VotingPowerProvider.contract.UnpackLog(event, "RegisterToken", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VotingPowerProviderSetSlashingDataIterator is returned from FilterSetSlashingData and is used to iterate over the raw logs and unpacked data for SetSlashingData events raised by the VotingPowerProvider contract.
+type VotingPowerProviderSetSlashingDataIterator struct {
+	Event *VotingPowerProviderSetSlashingData // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VotingPowerProviderSetSlashingDataIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VotingPowerProviderSetSlashingData)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VotingPowerProviderSetSlashingData)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VotingPowerProviderSetSlashingDataIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VotingPowerProviderSetSlashingDataIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VotingPowerProviderSetSlashingData represents a SetSlashingData event raised by the VotingPowerProvider contract.
+type VotingPowerProviderSetSlashingData struct {
+	RequireSlasher        bool
+	MinVaultEpochDuration *big.Int
+	Raw                   types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetSlashingData is a free log retrieval operation binding the contract event 0xfa31c1b97ed96532301949e5fd34d2e059500b0de599195245ddf8fbfbb36db0.
+//
+// Solidity: event SetSlashingData(bool requireSlasher, uint48 minVaultEpochDuration)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) FilterSetSlashingData(opts *bind.FilterOpts) (*VotingPowerProviderSetSlashingDataIterator, error) {
+
+	logs, sub, err := _VotingPowerProvider.contract.FilterLogs(opts, "SetSlashingData")
+	if err != nil {
+		return nil, err
+	}
+	return &VotingPowerProviderSetSlashingDataIterator{contract: _VotingPowerProvider.contract, event: "SetSlashingData", logs: logs, sub: sub}, nil
+}
+
+// WatchSetSlashingData is a free log subscription operation binding the contract event 0xfa31c1b97ed96532301949e5fd34d2e059500b0de599195245ddf8fbfbb36db0.
+//
+// Solidity: event SetSlashingData(bool requireSlasher, uint48 minVaultEpochDuration)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) WatchSetSlashingData(opts *bind.WatchOpts, sink chan<- *VotingPowerProviderSetSlashingData) (event.Subscription, error) {
+
+	logs, sub, err := _VotingPowerProvider.contract.WatchLogs(opts, "SetSlashingData")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VotingPowerProviderSetSlashingData)
+				if err := _VotingPowerProvider.contract.UnpackLog(event, "SetSlashingData", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetSlashingData is a log parse operation binding the contract event 0xfa31c1b97ed96532301949e5fd34d2e059500b0de599195245ddf8fbfbb36db0.
+//
+// Solidity: event SetSlashingData(bool requireSlasher, uint48 minVaultEpochDuration)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) ParseSetSlashingData(log types.Log) (*VotingPowerProviderSetSlashingData, error) {
+	event := new(VotingPowerProviderSetSlashingData)
+	if err := _VotingPowerProvider.contract.UnpackLog(event, "SetSlashingData", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VotingPowerProviderUnregisterOperatorIterator is returned from FilterUnregisterOperator and is used to iterate over the raw logs and unpacked data for UnregisterOperator events raised by the VotingPowerProvider contract.
+type VotingPowerProviderUnregisterOperatorIterator struct {
+	Event *VotingPowerProviderUnregisterOperator // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VotingPowerProviderUnregisterOperatorIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VotingPowerProviderUnregisterOperator)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VotingPowerProviderUnregisterOperator)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VotingPowerProviderUnregisterOperatorIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VotingPowerProviderUnregisterOperatorIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VotingPowerProviderUnregisterOperator represents a UnregisterOperator event raised by the VotingPowerProvider contract.
+type VotingPowerProviderUnregisterOperator struct {
+	Operator common.Address
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnregisterOperator is a free log retrieval operation binding the contract event 0xd1b48d1e49885298af5dc8adc7777836ef804b38af88eabf4e079c04ee1538a7.
+//
+// Solidity: event UnregisterOperator(address indexed operator)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) FilterUnregisterOperator(opts *bind.FilterOpts, operator []common.Address) (*VotingPowerProviderUnregisterOperatorIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _VotingPowerProvider.contract.FilterLogs(opts, "UnregisterOperator", operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VotingPowerProviderUnregisterOperatorIterator{contract: _VotingPowerProvider.contract, event: "UnregisterOperator", logs: logs, sub: sub}, nil
+}
+
+// WatchUnregisterOperator is a free log subscription operation binding the contract event 0xd1b48d1e49885298af5dc8adc7777836ef804b38af88eabf4e079c04ee1538a7.
+//
+// Solidity: event UnregisterOperator(address indexed operator)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) WatchUnregisterOperator(opts *bind.WatchOpts, sink chan<- *VotingPowerProviderUnregisterOperator, operator []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _VotingPowerProvider.contract.WatchLogs(opts, "UnregisterOperator", operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VotingPowerProviderUnregisterOperator)
+				if err := _VotingPowerProvider.contract.UnpackLog(event, "UnregisterOperator", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnregisterOperator is a log parse operation binding the contract event 0xd1b48d1e49885298af5dc8adc7777836ef804b38af88eabf4e079c04ee1538a7.
+//
+// Solidity: event UnregisterOperator(address indexed operator)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) ParseUnregisterOperator(log types.Log) (*VotingPowerProviderUnregisterOperator, error) {
+	event := new(VotingPowerProviderUnregisterOperator)
+	if err := _VotingPowerProvider.contract.UnpackLog(event, "UnregisterOperator", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VotingPowerProviderUnregisterOperatorVaultIterator is returned from FilterUnregisterOperatorVault and is used to iterate over the raw logs and unpacked data for UnregisterOperatorVault events raised by the VotingPowerProvider contract.
+type VotingPowerProviderUnregisterOperatorVaultIterator struct {
+	Event *VotingPowerProviderUnregisterOperatorVault // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VotingPowerProviderUnregisterOperatorVaultIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VotingPowerProviderUnregisterOperatorVault)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VotingPowerProviderUnregisterOperatorVault)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VotingPowerProviderUnregisterOperatorVaultIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VotingPowerProviderUnregisterOperatorVaultIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VotingPowerProviderUnregisterOperatorVault represents a UnregisterOperatorVault event raised by the VotingPowerProvider contract.
+type VotingPowerProviderUnregisterOperatorVault struct {
+	Operator common.Address
+	Vault    common.Address
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnregisterOperatorVault is a free log retrieval operation binding the contract event 0x3455b6128675eff843703027879cc9b52d6ce684ddc6077cbe0d191ad98b255e.
+//
+// Solidity: event UnregisterOperatorVault(address indexed operator, address indexed vault)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) FilterUnregisterOperatorVault(opts *bind.FilterOpts, operator []common.Address, vault []common.Address) (*VotingPowerProviderUnregisterOperatorVaultIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var vaultRule []interface{}
+	for _, vaultItem := range vault {
+		vaultRule = append(vaultRule, vaultItem)
+	}
+
+	logs, sub, err := _VotingPowerProvider.contract.FilterLogs(opts, "UnregisterOperatorVault", operatorRule, vaultRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VotingPowerProviderUnregisterOperatorVaultIterator{contract: _VotingPowerProvider.contract, event: "UnregisterOperatorVault", logs: logs, sub: sub}, nil
+}
+
+// WatchUnregisterOperatorVault is a free log subscription operation binding the contract event 0x3455b6128675eff843703027879cc9b52d6ce684ddc6077cbe0d191ad98b255e.
+//
+// Solidity: event UnregisterOperatorVault(address indexed operator, address indexed vault)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) WatchUnregisterOperatorVault(opts *bind.WatchOpts, sink chan<- *VotingPowerProviderUnregisterOperatorVault, operator []common.Address, vault []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var vaultRule []interface{}
+	for _, vaultItem := range vault {
+		vaultRule = append(vaultRule, vaultItem)
+	}
+
+	logs, sub, err := _VotingPowerProvider.contract.WatchLogs(opts, "UnregisterOperatorVault", operatorRule, vaultRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VotingPowerProviderUnregisterOperatorVault)
+				if err := _VotingPowerProvider.contract.UnpackLog(event, "UnregisterOperatorVault", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnregisterOperatorVault is a log parse operation binding the contract event 0x3455b6128675eff843703027879cc9b52d6ce684ddc6077cbe0d191ad98b255e.
+//
+// Solidity: event UnregisterOperatorVault(address indexed operator, address indexed vault)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) ParseUnregisterOperatorVault(log types.Log) (*VotingPowerProviderUnregisterOperatorVault, error) {
+	event := new(VotingPowerProviderUnregisterOperatorVault)
+	if err := _VotingPowerProvider.contract.UnpackLog(event, "UnregisterOperatorVault", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VotingPowerProviderUnregisterSharedVaultIterator is returned from FilterUnregisterSharedVault and is used to iterate over the raw logs and unpacked data for UnregisterSharedVault events raised by the VotingPowerProvider contract.
+type VotingPowerProviderUnregisterSharedVaultIterator struct {
+	Event *VotingPowerProviderUnregisterSharedVault // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VotingPowerProviderUnregisterSharedVaultIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VotingPowerProviderUnregisterSharedVault)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VotingPowerProviderUnregisterSharedVault)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VotingPowerProviderUnregisterSharedVaultIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VotingPowerProviderUnregisterSharedVaultIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VotingPowerProviderUnregisterSharedVault represents a UnregisterSharedVault event raised by the VotingPowerProvider contract.
+type VotingPowerProviderUnregisterSharedVault struct {
+	Vault common.Address
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnregisterSharedVault is a free log retrieval operation binding the contract event 0xead83f8482d0fa5de2b5c28fb39ee288392076d150db7020e10a92954aea82ee.
+//
+// Solidity: event UnregisterSharedVault(address indexed vault)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) FilterUnregisterSharedVault(opts *bind.FilterOpts, vault []common.Address) (*VotingPowerProviderUnregisterSharedVaultIterator, error) {
+
+	var vaultRule []interface{}
+	for _, vaultItem := range vault {
+		vaultRule = append(vaultRule, vaultItem)
+	}
+
+	logs, sub, err := _VotingPowerProvider.contract.FilterLogs(opts, "UnregisterSharedVault", vaultRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VotingPowerProviderUnregisterSharedVaultIterator{contract: _VotingPowerProvider.contract, event: "UnregisterSharedVault", logs: logs, sub: sub}, nil
+}
+
+// WatchUnregisterSharedVault is a free log subscription operation binding the contract event 0xead83f8482d0fa5de2b5c28fb39ee288392076d150db7020e10a92954aea82ee.
+//
+// Solidity: event UnregisterSharedVault(address indexed vault)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) WatchUnregisterSharedVault(opts *bind.WatchOpts, sink chan<- *VotingPowerProviderUnregisterSharedVault, vault []common.Address) (event.Subscription, error) {
+
+	var vaultRule []interface{}
+	for _, vaultItem := range vault {
+		vaultRule = append(vaultRule, vaultItem)
+	}
+
+	logs, sub, err := _VotingPowerProvider.contract.WatchLogs(opts, "UnregisterSharedVault", vaultRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VotingPowerProviderUnregisterSharedVault)
+				if err := _VotingPowerProvider.contract.UnpackLog(event, "UnregisterSharedVault", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnregisterSharedVault is a log parse operation binding the contract event 0xead83f8482d0fa5de2b5c28fb39ee288392076d150db7020e10a92954aea82ee.
+//
+// Solidity: event UnregisterSharedVault(address indexed vault)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) ParseUnregisterSharedVault(log types.Log) (*VotingPowerProviderUnregisterSharedVault, error) {
+	event := new(VotingPowerProviderUnregisterSharedVault)
+	if err := _VotingPowerProvider.contract.UnpackLog(event, "UnregisterSharedVault", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VotingPowerProviderUnregisterTokenIterator is returned from FilterUnregisterToken and is used to iterate over the raw logs and unpacked data for UnregisterToken events raised by the VotingPowerProvider contract.
+type VotingPowerProviderUnregisterTokenIterator struct {
+	Event *VotingPowerProviderUnregisterToken // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VotingPowerProviderUnregisterTokenIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VotingPowerProviderUnregisterToken)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VotingPowerProviderUnregisterToken)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VotingPowerProviderUnregisterTokenIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VotingPowerProviderUnregisterTokenIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VotingPowerProviderUnregisterToken represents a UnregisterToken event raised by the VotingPowerProvider contract.
+type VotingPowerProviderUnregisterToken struct {
+	Token common.Address
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnregisterToken is a free log retrieval operation binding the contract event 0xca2a890939276223a9122217752c67608466faee388aff53f077d00a186a389b.
+//
+// Solidity: event UnregisterToken(address indexed token)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) FilterUnregisterToken(opts *bind.FilterOpts, token []common.Address) (*VotingPowerProviderUnregisterTokenIterator, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _VotingPowerProvider.contract.FilterLogs(opts, "UnregisterToken", tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VotingPowerProviderUnregisterTokenIterator{contract: _VotingPowerProvider.contract, event: "UnregisterToken", logs: logs, sub: sub}, nil
+}
+
+// WatchUnregisterToken is a free log subscription operation binding the contract event 0xca2a890939276223a9122217752c67608466faee388aff53f077d00a186a389b.
+//
+// Solidity: event UnregisterToken(address indexed token)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) WatchUnregisterToken(opts *bind.WatchOpts, sink chan<- *VotingPowerProviderUnregisterToken, token []common.Address) (event.Subscription, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _VotingPowerProvider.contract.WatchLogs(opts, "UnregisterToken", tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VotingPowerProviderUnregisterToken)
+				if err := _VotingPowerProvider.contract.UnpackLog(event, "UnregisterToken", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnregisterToken is a log parse operation binding the contract event 0xca2a890939276223a9122217752c67608466faee388aff53f077d00a186a389b.
+//
+// Solidity: event UnregisterToken(address indexed token)
+func (_VotingPowerProvider *VotingPowerProviderFilterer) ParseUnregisterToken(log types.Log) (*VotingPowerProviderUnregisterToken, error) {
+	event := new(VotingPowerProviderUnregisterToken)
+	if err := _VotingPowerProvider.contract.UnpackLog(event, "UnregisterToken", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,154 @@
+// Package bls holds BN254 BLS signing keys apart from the public valset data: a Signer signs messages hashed to
+// G1, SecretKey is an in-memory key that can be zeroized, and keystore files store keys encrypted on disk.
+package bls
+
+import (
+	"crypto/rand"
+	"math/big"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/go-errors/errors"
+)
+
+// Signer signs messages with a BN254 BLS key. The message is a point of G1, usually proof.HashToG1 of a digest,
+// and the signature is sk·message.
+type Signer interface {
+	Sign(message bn254.G1Affine) (bn254.G1Affine, error)
+	PublicKeyG1() bn254.G1Affine
+	PublicKeyG2() bn254.G2Affine
+}
+
+// SecretKey is a BN254 BLS secret key held in memory. Zeroize clears it once it is no longer needed.
+type SecretKey struct {
+	mu        sync.RWMutex
+	scalar    fr.Element
+	zeroized  bool
+	publicKey bn254.G1Affine
+	keyG2     bn254.G2Affine
+}
+
+var _ Signer = (*SecretKey)(nil)
+
+// NewSecretKey returns the secret key of scalar, which must be in [1, r). The caller should clear scalar.
+func NewSecretKey(scalar *big.Int) (*SecretKey, error) {
+	if scalar.Sign() <= 0 || scalar.Cmp(fr.Modulus()) >= 0 {
+		return nil, errors.Errorf("secret key is not in [1, r)")
+	}
+	sk := &SecretKey{}
+	sk.scalar.SetBigInt(scalar)
+	sk.derivePublicKeys()
+	return sk, nil
+}
+
+// GenerateSecretKey returns a random secret key.
+func GenerateSecretKey() (*SecretKey, error) {
+	sk := &SecretKey{}
+	for sk.scalar.IsZero() {
+		if _, err := sk.scalar.SetRandom(); err != nil {
+			return nil, errors.Errorf("failed to generate a secret key: %w", err)
+		}
+	}
+	sk.derivePublicKeys()
+	return sk, nil
+}
+
+// secretKeyFromBytes returns the secret key of the 32-byte big-endian scalar b.
+func secretKeyFromBytes(b []byte) (*SecretKey, error) {
+	if len(b) != fr.Bytes {
+		return nil, errors.Errorf("secret key is %d bytes, want %d", len(b), fr.Bytes)
+	}
+	sk := &SecretKey{}
+	if err := sk.scalar.SetBytesCanonical(b); err != nil || sk.scalar.IsZero() {
+		return nil, errors.Errorf("secret key is not in [1, r)")
+	}
+	sk.derivePublicKeys()
+	return sk, nil
+}
+
+func (sk *SecretKey) derivePublicKeys() {
+	scalar := sk.bigInt()
+	defer zeroBigInt(scalar)
+	_, _, g1, g2 := bn254.Generators()
+	sk.publicKey.ScalarMultiplication(&g1, scalar)
+	sk.keyG2.ScalarMultiplication(&g2, scalar)
+}
+
+// Sign returns sk·message. It fails once the key is zeroized.
+func (sk *SecretKey) Sign(message bn254.G1Affine) (bn254.G1Affine, error) {
+	sk.mu.RLock()
+	defer sk.mu.RUnlock()
+	if sk.zeroized {
+		return bn254.G1Affine{}, errors.Errorf("secret key is zeroized")
+	}
+	if !message.IsOnCurve() || message.IsInfinity() {
+		return bn254.G1Affine{}, errors.Errorf("message is not a point of G1")
+	}
+	scalar := sk.bigInt()
+	defer zeroBigInt(scalar)
+	var signature bn254.G1Affine
+	signature.ScalarMultiplication(&message, scalar)
+	return signature, nil
+}
+
+// PublicKeyG1 returns sk·g1, the key the KeyRegistry holds.
+func (sk *SecretKey) PublicKeyG1() bn254.G1Affine {
+	return sk.publicKey
+}
+
+// PublicKeyG2 returns sk·g2, the key the circuits aggregate.
+func (sk *SecretKey) PublicKeyG2() bn254.G2Affine {
+	return sk.keyG2
+}
+
+// Zeroize overwrites the key in memory. The public keys stay available, Sign fails afterwards.
+func (sk *SecretKey) Zeroize() {
+	sk.mu.Lock()
+	defer sk.mu.Unlock()
+	sk.scalar.SetZero()
+	sk.zeroized = true
+}
+
+// bytes returns the 32-byte big-endian scalar, for the caller to zero.
+func (sk *SecretKey) bytes() ([]byte, error) {
+	sk.mu.RLock()
+	defer sk.mu.RUnlock()
+	if sk.zeroized {
+		return nil, errors.Errorf("secret key is zeroized")
+	}
+	b := sk.scalar.Bytes()
+	encoded := make([]byte, len(b))
+	copy(encoded, b[:])
+	zero(b[:])
+	return encoded, nil
+}
+
+// bigInt returns the scalar, for the caller to clear with zeroBigInt. Scalar multiplications of gnark-crypto
+// only take big.Ints.
+func (sk *SecretKey) bigInt() *big.Int {
+	return sk.scalar.BigInt(new(big.Int))
+}
+
+// zeroBigInt overwrites the words of b. Copies the runtime or math/big made meanwhile are out of reach.
+func zeroBigInt(b *big.Int) {
+	words := b.Bits()
+	for i := range words {
+		words[i] = 0
+	}
+	b.SetInt64(0)
+}
+
+func zero(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
+
+func randomBytes(n int) ([]byte, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return nil, errors.Errorf("failed to read random bytes: %w", err)
+	}
+	return b, nil
+}
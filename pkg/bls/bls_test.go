@@ -0,0 +1,124 @@
+package bls
+
+import (
+	"encoding/hex"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+)
+
+func TestSecretKey(t *testing.T) {
+	sk, err := NewSecretKey(big.NewInt(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, g1, g2 := bn254.Generators()
+	var message bn254.G1Affine
+	message.ScalarMultiplication(&g1, big.NewInt(7))
+
+	signature, err := sk.Sign(message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// e(signature, g2) = e(message, pkG2) and e(pkG1, g2) = e(g1, pkG2)
+	var negMessage, negG1 bn254.G1Affine
+	negMessage.Neg(&message)
+	negG1.Neg(&g1)
+	publicKey, publicKeyG2 := sk.PublicKeyG1(), sk.PublicKeyG2()
+	ok, err := bn254.PairingCheck([]bn254.G1Affine{signature, negMessage, publicKey, negG1}, []bn254.G2Affine{g2, publicKeyG2, g2, publicKeyG2})
+	if err != nil || !ok {
+		t.Fatalf("signature doesn't verify: %v", err)
+	}
+
+	sk.Zeroize()
+	if !sk.scalar.IsZero() {
+		t.Fatal("zeroized key is not zero")
+	}
+	if _, err := sk.Sign(message); err == nil {
+		t.Fatal("signed with a zeroized key")
+	}
+	if _, err := EncryptKey(sk, []byte("password"), LightScryptParams); err == nil {
+		t.Fatal("encrypted a zeroized key")
+	}
+	if got := sk.PublicKeyG1(); !got.Equal(&publicKey) {
+		t.Fatal("zeroizing dropped the public key")
+	}
+
+	for _, scalar := range []*big.Int{big.NewInt(0), big.NewInt(-1), fr.Modulus()} {
+		if _, err := NewSecretKey(scalar); err == nil {
+			t.Fatalf("accepted secret key %s", scalar)
+		}
+	}
+}
+
+func TestKeystore(t *testing.T) {
+	sk, err := GenerateSecretKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "key.json")
+	if err := WriteKeyFile(path, sk, []byte("password"), LightScryptParams); err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteKeyFile(path, sk, []byte("password"), LightScryptParams); err == nil {
+		t.Fatal("overwrote a keystore file")
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Fatalf("keystore file mode is %v", info.Mode().Perm())
+	}
+
+	decrypted, err := ReadKeyFile(path, []byte("password"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !decrypted.scalar.Equal(&sk.scalar) {
+		t.Fatal("decrypted another key")
+	}
+	if _, err := ReadKeyFile(path, []byte("wrong")); err == nil {
+		t.Fatal("decrypted with a wrong password")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	secret, err := sk.bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), hex.EncodeToString(secret)) {
+		t.Fatal("keystore contains the secret key in the clear")
+	}
+	publicKey, publicKeyG2, err := PublicKeys(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantKey, wantKeyG2 := sk.PublicKeyG1(), sk.PublicKeyG2()
+	if !publicKey.Equal(&wantKey) || !publicKeyG2.Equal(&wantKeyG2) {
+		t.Fatal("keystore public keys don't match")
+	}
+
+	// a keystore claiming another public key is rejected
+	other, err := GenerateSecretKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey := other.PublicKeyG1()
+	tampered := strings.Replace(string(data), hex.EncodeToString(wantKey.Marshal()), hex.EncodeToString(otherKey.Marshal()), 1)
+	if _, err := DecryptKey([]byte(tampered), []byte("password")); err == nil {
+		t.Fatal("decrypted a keystore with another public key")
+	}
+	malformed := strings.Replace(string(data), hex.EncodeToString(wantKey.Marshal()), "not hex", 1)
+	if _, err := DecryptKey([]byte(malformed), []byte("password")); err == nil || !strings.Contains(err.Error(), "invalid public key") {
+		t.Fatalf("decrypted a keystore with a malformed public key: %v", err)
+	}
+}
@@ -0,0 +1,262 @@
+package bls
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/go-errors/errors"
+	"golang.org/x/crypto/scrypt"
+)
+
+const keystoreVersion = 1
+
+// ScryptParams are the scrypt costs of a keystore file.
+type ScryptParams struct {
+	N int
+	R int
+	P int
+}
+
+var (
+	// StandardScryptParams are the costs of EIP-2335, about a second per decryption.
+	StandardScryptParams = ScryptParams{N: 1 << 18, R: 8, P: 1}
+	// LightScryptParams are for tests and throwaway keys.
+	LightScryptParams = ScryptParams{N: 1 << 12, R: 8, P: 1}
+)
+
+// keystoreJSON is a keystore file, after EIP-2335 with the BN254 public keys:
+//
+//	decryptionKey = scrypt(password, salt)
+//	checksum      = sha256(decryptionKey[16:32] || ciphertext)
+//	ciphertext    = aes-128-ctr(decryptionKey[0:16], iv, secret key)
+//
+// with the secret key as a 32-byte big-endian scalar.
+type keystoreJSON struct {
+	Version     int            `json:"version"`
+	PublicKey   string         `json:"pubkey"`
+	PublicKeyG2 string         `json:"pubkeyG2"`
+	Crypto      keystoreCrypto `json:"crypto"`
+}
+
+type keystoreCrypto struct {
+	KDF      keystoreModule[keystoreKDFParams]    `json:"kdf"`
+	Checksum keystoreModule[struct{}]             `json:"checksum"`
+	Cipher   keystoreModule[keystoreCipherParams] `json:"cipher"`
+}
+
+type keystoreModule[P any] struct {
+	Function string `json:"function"`
+	Params   P      `json:"params"`
+	Message  string `json:"message"`
+}
+
+type keystoreKDFParams struct {
+	DKLen int    `json:"dklen"`
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+	Salt  string `json:"salt"`
+}
+
+type keystoreCipherParams struct {
+	IV string `json:"iv"`
+}
+
+// EncryptKey returns the keystore file of sk, encrypted with password.
+func EncryptKey(sk *SecretKey, password []byte, params ScryptParams) ([]byte, error) {
+	secret, err := sk.bytes()
+	if err != nil {
+		return nil, err
+	}
+	defer zero(secret)
+	salt, err := randomBytes(32)
+	if err != nil {
+		return nil, err
+	}
+	iv, err := randomBytes(aes.BlockSize)
+	if err != nil {
+		return nil, err
+	}
+
+	kdfParams := keystoreKDFParams{DKLen: 32, N: params.N, R: params.R, P: params.P, Salt: hex.EncodeToString(salt)}
+	decryptionKey, err := deriveKey(password, kdfParams)
+	if err != nil {
+		return nil, err
+	}
+	defer zero(decryptionKey)
+	ciphertext, err := aes128CTR(decryptionKey[:16], iv, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, publicKeyG2 := sk.PublicKeyG1(), sk.PublicKeyG2()
+	data, err := json.MarshalIndent(keystoreJSON{
+		Version:     keystoreVersion,
+		PublicKey:   hex.EncodeToString(publicKey.Marshal()),
+		PublicKeyG2: hex.EncodeToString(publicKeyG2.Marshal()),
+		Crypto: keystoreCrypto{
+			KDF:      keystoreModule[keystoreKDFParams]{Function: "scrypt", Params: kdfParams},
+			Checksum: keystoreModule[struct{}]{Function: "sha256", Message: hex.EncodeToString(checksum(decryptionKey, ciphertext))},
+			Cipher:   keystoreModule[keystoreCipherParams]{Function: "aes-128-ctr", Params: keystoreCipherParams{IV: hex.EncodeToString(iv)}, Message: hex.EncodeToString(ciphertext)},
+		},
+	}, "", "  ")
+	if err != nil {
+		return nil, errors.Errorf("failed to encode the keystore: %w", err)
+	}
+	return data, nil
+}
+
+// DecryptKey returns the secret key of a keystore file. It fails on a wrong password, and if the key doesn't
+// match the public keys of the file.
+func DecryptKey(data, password []byte) (*SecretKey, error) {
+	var keystore keystoreJSON
+	if err := json.Unmarshal(data, &keystore); err != nil {
+		return nil, errors.Errorf("failed to decode the keystore: %w", err)
+	}
+	if keystore.Version != keystoreVersion {
+		return nil, errors.Errorf("unsupported keystore version %d", keystore.Version)
+	}
+	c := keystore.Crypto
+	if c.KDF.Function != "scrypt" || c.Checksum.Function != "sha256" || c.Cipher.Function != "aes-128-ctr" {
+		return nil, errors.Errorf("unsupported keystore functions %s, %s, %s", c.KDF.Function, c.Checksum.Function, c.Cipher.Function)
+	}
+	if c.KDF.Params.DKLen != 32 {
+		return nil, errors.Errorf("unsupported derived key length %d", c.KDF.Params.DKLen)
+	}
+	wantPublicKey, wantPublicKeyG2, err := keystore.publicKeys()
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, err := hex.DecodeString(c.Cipher.Message)
+	if err != nil {
+		return nil, errors.Errorf("invalid ciphertext: %w", err)
+	}
+	iv, err := hex.DecodeString(c.Cipher.Params.IV)
+	if err != nil || len(iv) != aes.BlockSize {
+		return nil, errors.Errorf("invalid iv")
+	}
+	wantChecksum, err := hex.DecodeString(c.Checksum.Message)
+	if err != nil {
+		return nil, errors.Errorf("invalid checksum: %w", err)
+	}
+
+	decryptionKey, err := deriveKey(password, c.KDF.Params)
+	if err != nil {
+		return nil, err
+	}
+	defer zero(decryptionKey)
+	if subtle.ConstantTimeCompare(checksum(decryptionKey, ciphertext), wantChecksum) != 1 {
+		return nil, errors.Errorf("wrong password or corrupted keystore")
+	}
+	secret, err := aes128CTR(decryptionKey[:16], iv, ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	defer zero(secret)
+	sk, err := secretKeyFromBytes(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, publicKeyG2 := sk.PublicKeyG1(), sk.PublicKeyG2()
+	if !publicKey.Equal(&wantPublicKey) || !publicKeyG2.Equal(&wantPublicKeyG2) {
+		sk.Zeroize()
+		return nil, errors.Errorf("the secret key doesn't match the public keys of the keystore")
+	}
+	return sk, nil
+}
+
+// PublicKeys returns the public keys of a keystore file without decrypting it.
+func PublicKeys(data []byte) (bn254.G1Affine, bn254.G2Affine, error) {
+	var keystore keystoreJSON
+	if err := json.Unmarshal(data, &keystore); err != nil {
+		return bn254.G1Affine{}, bn254.G2Affine{}, errors.Errorf("failed to decode the keystore: %w", err)
+	}
+	return keystore.publicKeys()
+}
+
+// publicKeys decodes the public keys of the keystore.
+func (k keystoreJSON) publicKeys() (bn254.G1Affine, bn254.G2Affine, error) {
+	var publicKey bn254.G1Affine
+	var publicKeyG2 bn254.G2Affine
+	raw, err := hex.DecodeString(k.PublicKey)
+	if err != nil {
+		return bn254.G1Affine{}, bn254.G2Affine{}, errors.Errorf("invalid public key: %w", err)
+	}
+	if err := publicKey.Unmarshal(raw); err != nil {
+		return bn254.G1Affine{}, bn254.G2Affine{}, errors.Errorf("invalid public key: %w", err)
+	}
+	if raw, err = hex.DecodeString(k.PublicKeyG2); err != nil {
+		return bn254.G1Affine{}, bn254.G2Affine{}, errors.Errorf("invalid G2 public key: %w", err)
+	}
+	if err := publicKeyG2.Unmarshal(raw); err != nil {
+		return bn254.G1Affine{}, bn254.G2Affine{}, errors.Errorf("invalid G2 public key: %w", err)
+	}
+	return publicKey, publicKeyG2, nil
+}
+
+// WriteKeyFile writes the keystore file of sk to path, readable by the owner only. It doesn't overwrite an
+// existing file.
+func WriteKeyFile(path string, sk *SecretKey, password []byte, params ScryptParams) error {
+	data, err := EncryptKey(sk, password, params)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return errors.Errorf("failed to create the keystore file: %w", err)
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return errors.Errorf("failed to write the keystore file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return errors.Errorf("failed to write the keystore file: %w", err)
+	}
+	return nil
+}
+
+// ReadKeyFile decrypts the keystore file at path.
+func ReadKeyFile(path string, password []byte) (*SecretKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Errorf("failed to read the keystore file: %w", err)
+	}
+	return DecryptKey(data, password)
+}
+
+func deriveKey(password []byte, params keystoreKDFParams) ([]byte, error) {
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil {
+		return nil, errors.Errorf("invalid salt: %w", err)
+	}
+	key, err := scrypt.Key(password, salt, params.N, params.R, params.P, params.DKLen)
+	if err != nil {
+		return nil, errors.Errorf("failed to derive the decryption key: %w", err)
+	}
+	return key, nil
+}
+
+func checksum(decryptionKey, ciphertext []byte) []byte {
+	h := sha256.New()
+	h.Write(decryptionKey[16:32])
+	h.Write(ciphertext)
+	return h.Sum(nil)
+}
+
+func aes128CTR(key, iv, in []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Errorf("failed to create the cipher: %w", err)
+	}
+	out := make([]byte, len(in))
+	cipher.NewCTR(block, iv).XORKeyStream(out, in)
+	return out, nil
+}
+
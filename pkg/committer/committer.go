@@ -0,0 +1,292 @@
+// Package committer commits the valset header of every epoch of a ValSetDriver to a Settlement: it derives the
+// valset of the next epoch, has the validators of the last committed one sign its commit, proves their quorum
+// signature for the settlement's sig verifier and submits commitValSetHeader.
+package committer
+
+import (
+	"context"
+	"log/slog"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+
+	"middleware-offchain/pkg/bindings"
+	"middleware-offchain/pkg/genesis"
+	"middleware-offchain/pkg/proof"
+)
+
+// Config of a Committer.
+type Config struct {
+	Driver     genesis.CrossChainAddress
+	Settlement common.Address
+	// PollInterval is how often Run looks for a new epoch, and how often a submitted header is checked.
+	PollInterval time.Duration
+	// CommitTimeout is how long a submitted header may take to be committed before it is submitted again.
+	CommitTimeout time.Duration
+	// MaxAttempts bounds the submissions of one header.
+	MaxAttempts int
+	// Circuit is the circuit of the prover. It selects the valset hash of the ZK extra data of the headers.
+	Circuit proof.Config
+}
+
+// DefaultConfig returns the timing of a Config, for a driver and settlement to fill in.
+func DefaultConfig() Config {
+	return Config{
+		PollInterval:  12 * time.Second,
+		CommitTimeout: 2 * time.Minute,
+		MaxAttempts:   3,
+		Circuit:       proof.DefaultConfig(),
+	}
+}
+
+// Committer commits the header of every new epoch of a driver to a settlement.
+type Committer struct {
+	cfg        Config
+	reader     genesis.ChainReader
+	backend    bind.ContractBackend
+	settlement *bindings.Settlement
+	transactor *bind.TransactOpts
+	collector  Collector
+	prover     Prover
+}
+
+// New returns a Committer reading the driver through reader and sending transactions to the settlement through
+// backend, signed by transactor. prover may be nil if the settlement has no ZK sig verifier.
+func New(cfg Config, reader genesis.ChainReader, backend bind.ContractBackend, transactor *bind.TransactOpts, collector Collector, prover Prover) (*Committer, error) {
+	if cfg.PollInterval <= 0 || cfg.CommitTimeout <= 0 || cfg.MaxAttempts <= 0 {
+		return nil, errors.Errorf("poll interval, commit timeout and max attempts must be positive")
+	}
+	settlement, err := bindings.NewSettlement(cfg.Settlement, backend)
+	if err != nil {
+		return nil, errors.Errorf("failed to bind the settlement: %w", err)
+	}
+	return &Committer{
+		cfg:        cfg,
+		reader:     reader,
+		backend:    backend,
+		settlement: settlement,
+		transactor: transactor,
+		collector:  collector,
+		prover:     prover,
+	}, nil
+}
+
+// Run commits new headers until ctx is done. Failed commits are logged and retried at the next poll.
+func (c *Committer) Run(ctx context.Context) error {
+	for {
+		committed, err := c.CommitNext(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil {
+			slog.Error("failed to commit the next header", "error", err)
+		}
+		if committed {
+			// the settlement may be more than one epoch behind
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(c.cfg.PollInterval):
+		}
+	}
+}
+
+// CommitNext commits the header of the epoch after the last committed one. It returns false without error if the
+// driver hasn't reached that epoch yet, and true once the header is committed, by this committer or another one.
+func (c *Committer) CommitNext(ctx context.Context) (bool, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	last, err := c.settlement.GetLastCommittedHeaderEpoch(opts)
+	if err != nil {
+		return false, errors.Errorf("failed to read the last committed epoch: %w", err)
+	}
+	epoch := last.Uint64() + 1
+	current, err := c.reader.CurrentEpoch(ctx, c.cfg.Driver)
+	if err != nil {
+		return false, errors.Errorf("failed to read the current epoch: %w", err)
+	}
+	if current < epoch {
+		return false, nil
+	}
+	if committed, err := c.isCommitted(ctx, epoch); err != nil || committed {
+		return committed, err
+	}
+
+	committing, committingHeader, err := c.committingValset(ctx, last.Uint64())
+	if err != nil {
+		return false, err
+	}
+	next, err := genesis.ReadValset(ctx, c.reader, c.cfg.Driver, epoch)
+	if err != nil {
+		return false, err
+	}
+	target, err := next.Genesis(c.cfg.Circuit)
+	if err != nil {
+		return false, err
+	}
+
+	request, err := c.signRequest(ctx, committingHeader, target)
+	if err != nil {
+		return false, err
+	}
+	proofBytes, err := c.prove(ctx, committing, committingHeader, request)
+	if err != nil {
+		return false, err
+	}
+	if err := c.submit(ctx, target, proofBytes); err != nil {
+		return false, err
+	}
+	slog.Info("committed the valset header", "epoch", epoch, "headerHash", target.Header.Hash())
+	return true, nil
+}
+
+// committingValset derives the valset of the last committed epoch, whose validators sign the next header, and
+// checks it is the one the settlement committed to.
+func (c *Committer) committingValset(ctx context.Context, epoch uint64) (genesis.Valset, proof.ValSetHeader, error) {
+	valset, err := genesis.ReadValset(ctx, c.reader, c.cfg.Driver, epoch)
+	if err != nil {
+		return genesis.Valset{}, proof.ValSetHeader{}, err
+	}
+	committing, err := valset.Genesis(c.cfg.Circuit)
+	if err != nil {
+		return genesis.Valset{}, proof.ValSetHeader{}, err
+	}
+	committedHash, err := c.settlement.GetValSetHeaderHashAt(&bind.CallOpts{Context: ctx}, new(big.Int).SetUint64(epoch))
+	if err != nil {
+		return genesis.Valset{}, proof.ValSetHeader{}, errors.Errorf("failed to read the header hash of epoch %d: %w", epoch, err)
+	}
+	if committing.Header.Hash() != committedHash {
+		return genesis.Valset{}, proof.ValSetHeader{}, errors.Errorf("the derived valset of epoch %d doesn't match the committed header %x", epoch, committedHash)
+	}
+	return valset, committing.Header, nil
+}
+
+// signRequest returns the request to sign the commit of target.
+func (c *Committer) signRequest(ctx context.Context, committingHeader proof.ValSetHeader, target genesis.Genesis) (SignRequest, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	domain, err := c.settlement.Eip712Domain(opts)
+	if err != nil {
+		return SignRequest{}, errors.Errorf("failed to read the EIP-712 domain: %w", err)
+	}
+	subnetwork, err := c.settlement.SUBNETWORK(opts)
+	if err != nil {
+		return SignRequest{}, errors.Errorf("failed to read the subnetwork: %w", err)
+	}
+	return SignRequest{
+		Epoch:     committingHeader.Epoch,
+		KeyTag:    committingHeader.RequiredKeyTag,
+		Header:    target.Header,
+		ExtraData: target.ExtraData,
+		Digest:    CommitDigest(Domain{Name: domain.Name, Version: domain.Version}, subnetwork, target.Header, target.ExtraData),
+	}, nil
+}
+
+// prove collects the signatures of request and proves they reach the quorum of the committing header, for the
+// sig verifier of its epoch.
+func (c *Committer) prove(ctx context.Context, committing genesis.Valset, committingHeader proof.ValSetHeader, request SignRequest) ([]byte, error) {
+	valset, err := genesis.ProofValset(committing.Validators, request.KeyTag, c.cfg.Circuit.CircuitVersion)
+	if err != nil {
+		return nil, err
+	}
+	signatures, err := c.collector.Collect(ctx, request)
+	if err != nil {
+		return nil, errors.Errorf("failed to collect signatures: %w", err)
+	}
+	message, _ := proof.HashToG1(request.Digest)
+	signed, err := aggregate(valset, message, signatures)
+	if err != nil {
+		return nil, err
+	}
+	quorumThreshold := committingHeader.QuorumThreshold
+	if signed.SignersAggVotingPower.Cmp(quorumThreshold) < 0 {
+		return nil, errors.Errorf("signers voting power %s is below the quorum threshold %s", signed.SignersAggVotingPower, quorumThreshold)
+	}
+
+	verificationType, err := c.verificationType(ctx, request.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	slog.Info("proving the quorum signature", "epoch", request.Epoch+1, "signers", len(signatures), "verificationType", verificationType)
+	return c.quorumProof(verificationType, request, quorumThreshold, signed)
+}
+
+// verificationType returns the verification type of the sig verifier of epoch.
+func (c *Committer) verificationType(ctx context.Context, epoch uint64) (uint32, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	address, err := c.settlement.GetSigVerifierAt(opts, new(big.Int).SetUint64(epoch), nil)
+	if err != nil {
+		return 0, errors.Errorf("failed to read the sig verifier of epoch %d: %w", epoch, err)
+	}
+	verifier, err := bindings.NewSigVerifierCaller(address, c.backend)
+	if err != nil {
+		return 0, err
+	}
+	verificationType, err := verifier.VERIFICATIONTYPE(opts)
+	if err != nil {
+		return 0, errors.Errorf("failed to read the verification type of %s: %w", address, err)
+	}
+	return verificationType, nil
+}
+
+// submit sends commitValSetHeader until the header is committed, resending it after CommitTimeout. A header
+// another committer commits meanwhile counts as committed.
+func (c *Committer) submit(ctx context.Context, target genesis.Genesis, proofBytes []byte) error {
+	epoch := target.Header.Epoch
+	header := bindings.NewValSetHeader(target.Header)
+	extraData := make([]bindings.ISettlementExtraData, len(target.ExtraData))
+	for i, data := range target.ExtraData {
+		extraData[i] = bindings.NewExtraData(data.Key, data.Value)
+	}
+
+	for attempt := 1; ; attempt++ {
+		opts := *c.transactor
+		opts.Context = ctx
+		tx, err := c.settlement.CommitValSetHeader(&opts, header, extraData, proofBytes)
+		if err != nil {
+			slog.Warn("failed to submit the valset header", "epoch", epoch, "attempt", attempt, "error", err)
+		} else {
+			slog.Info("submitted the valset header", "epoch", epoch, "attempt", attempt, "tx", tx.Hash())
+			committed, err := c.waitCommitted(ctx, epoch)
+			if err != nil || committed {
+				return err
+			}
+		}
+
+		committed, err := c.isCommitted(ctx, epoch)
+		if err != nil || committed {
+			return err
+		}
+		if attempt >= c.cfg.MaxAttempts {
+			return errors.Errorf("the header of epoch %d is not committed after %d attempts", epoch, attempt)
+		}
+	}
+}
+
+// waitCommitted polls the settlement until the header of epoch is committed or CommitTimeout passes.
+func (c *Committer) waitCommitted(ctx context.Context, epoch uint64) (bool, error) {
+	deadline := time.After(c.cfg.CommitTimeout)
+	for {
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		case <-deadline:
+			return false, nil
+		case <-time.After(c.cfg.PollInterval):
+		}
+		if committed, err := c.isCommitted(ctx, epoch); err != nil || committed {
+			return committed, err
+		}
+	}
+}
+
+func (c *Committer) isCommitted(ctx context.Context, epoch uint64) (bool, error) {
+	committed, err := c.settlement.IsValSetHeaderCommittedAt(&bind.CallOpts{Context: ctx}, new(big.Int).SetUint64(epoch))
+	if err != nil {
+		return false, errors.Errorf("failed to check the header of epoch %d is committed: %w", epoch, err)
+	}
+	return committed, nil
+}
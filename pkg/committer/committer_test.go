@@ -0,0 +1,637 @@
+package committer
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/go-errors/errors"
+
+	"middleware-offchain/pkg/bindings"
+	"middleware-offchain/pkg/bls"
+	"middleware-offchain/pkg/genesis"
+	"middleware-offchain/pkg/proof"
+)
+
+const blsKeyTag = 15
+
+var (
+	driver      = genesis.CrossChainAddress{ChainId: 111, Addr: common.HexToAddress("0xd0")}
+	provider    = genesis.CrossChainAddress{ChainId: 111, Addr: common.HexToAddress("0xa0")}
+	settlement  = common.HexToAddress("0x5e")
+	sigVerifier = common.HexToAddress("0x5f")
+	subnetwork  = common.HexToHash("0x5b")
+	domain      = Domain{Name: "Settlement", Version: "1"}
+)
+
+// fakeReader serves a driver whose epochs start every 100 seconds, with the voting powers of an epoch set by
+// setVotingPowers.
+type fakeReader struct {
+	mu           sync.Mutex
+	epoch        uint64
+	config       genesis.DriverConfig
+	votingPowers map[uint64][]genesis.OperatorVotingPower // by capture timestamp
+	keys         []genesis.OperatorWithKeys
+}
+
+func (r *fakeReader) CurrentEpoch(context.Context, genesis.CrossChainAddress) (uint64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.epoch, nil
+}
+
+func (r *fakeReader) EpochStart(_ context.Context, _ genesis.CrossChainAddress, epoch uint64) (uint64, error) {
+	return 1_000 + 100*epoch, nil
+}
+
+func (r *fakeReader) ConfigAt(context.Context, genesis.CrossChainAddress, uint64) (genesis.DriverConfig, error) {
+	return r.config, nil
+}
+
+func (r *fakeReader) VotingPowersAt(_ context.Context, _ genesis.CrossChainAddress, timestamp uint64) ([]genesis.OperatorVotingPower, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	votingPowers, ok := r.votingPowers[timestamp]
+	if !ok {
+		return nil, errors.Errorf("no voting powers at %d", timestamp)
+	}
+	return votingPowers, nil
+}
+
+func (r *fakeReader) KeysAt(context.Context, genesis.CrossChainAddress, uint64) ([]genesis.OperatorWithKeys, error) {
+	return r.keys, nil
+}
+
+func (r *fakeReader) setVotingPowers(epoch uint64, votingPowers ...int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	operatorVotingPowers := make([]genesis.OperatorVotingPower, len(votingPowers))
+	for i, votingPower := range votingPowers {
+		operatorVotingPowers[i] = genesis.OperatorVotingPower{
+			Operator: operator(i),
+			Vaults:   []genesis.VaultValue{{Vault: common.BytesToAddress([]byte{0xee, byte(i)}), Value: big.NewInt(votingPower)}},
+		}
+	}
+	r.votingPowers[1_000+100*epoch] = operatorVotingPowers
+}
+
+func operator(i int) common.Address {
+	return common.BytesToAddress([]byte{byte(i + 1)})
+}
+
+func privateKey(i int) *big.Int {
+	return big.NewInt(int64(1_000 + i))
+}
+
+func newFakeReader(nbOperators int, verificationType uint32) *fakeReader {
+	reader := &fakeReader{
+		epoch: 1,
+		config: genesis.DriverConfig{
+			VotingPowerProviders:    []genesis.CrossChainAddress{provider},
+			KeysProvider:            provider,
+			MaxVotingPower:          big.NewInt(1_000),
+			MinInclusionVotingPower: big.NewInt(1),
+			MaxValidatorsCount:      big.NewInt(10),
+			RequiredKeyTags:         []uint8{blsKeyTag},
+			QuorumThresholds:        []genesis.QuorumThreshold{{KeyTag: blsKeyTag, QuorumThreshold: big.NewInt(666_666_666_666_666_667)}},
+			RequiredHeaderKeyTag:    blsKeyTag,
+			VerificationType:        verificationType,
+		},
+		votingPowers: make(map[uint64][]genesis.OperatorVotingPower),
+	}
+	_, _, g1, _ := bn254.Generators()
+	for i := range nbOperators {
+		var key bn254.G1Affine
+		key.ScalarMultiplication(&g1, privateKey(i))
+		reader.keys = append(reader.keys, genesis.OperatorWithKeys{
+			Operator: operator(i),
+			Keys:     []genesis.Key{{Tag: blsKeyTag, Payload: genesis.BlsKeyPayload(key)}},
+		})
+	}
+	return reader
+}
+
+// fakeCollector signs every request with the keys of the signing operators.
+type fakeCollector struct {
+	signers  []int
+	requests []SignRequest
+}
+
+func (c *fakeCollector) Collect(ctx context.Context, request SignRequest) ([]Signature, error) {
+	c.requests = append(c.requests, request)
+	local := &LocalCollector{}
+	for _, signer := range c.signers {
+		sk, err := bls.NewSecretKey(privateKey(signer))
+		if err != nil {
+			return nil, err
+		}
+		local.Signers = append(local.Signers, sk)
+	}
+	return local.Collect(ctx, request)
+}
+
+// fakeProver returns the input hash SigVerifierBlsBn254ZK recomputes in place of A, so the fake settlement can
+// check the proof was made for the right valset, message and signers.
+type fakeProver struct {
+	inputs []proof.ProveInput
+}
+
+func (p *fakeProver) Prove(proveInput proof.ProveInput) (proof.ProofData, error) {
+	p.inputs = append(p.inputs, proveInput)
+	valset, err := proof.NormalizeValset(proof.DefaultConfig().CircuitVersion, proveInput.ValidatorData)
+	if err != nil {
+		return proof.ProofData{}, err
+	}
+	signersAggVotingPower := new(big.Int)
+	for _, validator := range valset {
+		if !validator.IsNonSigner && validator.VotingPower != nil {
+			signersAggVotingPower.Add(signersAggVotingPower, validator.VotingPower)
+		}
+	}
+	inputHash := proof.DefaultConfig().PublicInputHash(signersAggVotingPower, proof.InputContext{
+		ValsetHash: proof.HashValset(valset),
+		MessageG1:  proveInput.MessageG1,
+	})
+	return proof.ProofData{
+		Proof:                 append(inputHash.Bytes(), make([]byte, 224)...),
+		Commitments:           make([]byte, 64),
+		CommitmentPok:         make([]byte, 64),
+		SignersAggVotingPower: signersAggVotingPower,
+	}, nil
+}
+
+// fakeSettlement is a bind.ContractBackend of a Settlement and its sig verifier, which checks commitValSetHeader
+// transactions like the contracts and applies them. The first dropped transactions are lost, calling onDrop.
+type fakeSettlement struct {
+	t                *testing.T
+	mu               sync.Mutex
+	verificationType uint32
+	settlementABI    *abi.ABI
+	verifierABI      *abi.ABI
+	headers          map[uint64]proof.ValSetHeader
+	extraData        map[uint64]map[common.Hash]common.Hash
+	last             uint64
+	keys             map[[32]byte]bn254.G1Affine // by compressed key
+	dropped          int
+	onDrop           func()
+	sent             int
+}
+
+func newFakeSettlement(t *testing.T, verificationType uint32, g genesis.Genesis, keys []genesis.OperatorWithKeys) *fakeSettlement {
+	settlementABI, err := bindings.SettlementMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifierABI, err := bindings.SigVerifierMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &fakeSettlement{
+		t:                t,
+		verificationType: verificationType,
+		settlementABI:    settlementABI,
+		verifierABI:      verifierABI,
+		headers:          make(map[uint64]proof.ValSetHeader),
+		extraData:        make(map[uint64]map[common.Hash]common.Hash),
+		keys:             make(map[[32]byte]bn254.G1Affine),
+	}
+	for _, operator := range keys {
+		key, err := genesis.ParseBlsKey(operator.Keys[0].Payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s.keys[proof.CompressKey(key)] = key
+	}
+	s.commit(g.Header, g.ExtraData)
+	return s
+}
+
+func (s *fakeSettlement) commit(header proof.ValSetHeader, extraData []genesis.ExtraData) {
+	s.headers[header.Epoch] = header
+	s.extraData[header.Epoch] = make(map[common.Hash]common.Hash)
+	for _, data := range extraData {
+		s.extraData[header.Epoch][data.Key] = data.Value
+	}
+	s.last = header.Epoch
+}
+
+func (s *fakeSettlement) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{0x01}, nil
+}
+
+func (s *fakeSettlement) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	contractABI := s.settlementABI
+	if *call.To == sigVerifier {
+		contractABI = s.verifierABI
+	}
+	method, err := contractABI.MethodById(call.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	epoch := func() uint64 {
+		return args[0].(*big.Int).Uint64()
+	}
+
+	var results []any
+	switch method.Name {
+	case "VERIFICATION_TYPE":
+		results = []any{s.verificationType}
+	case "getLastCommittedHeaderEpoch":
+		results = []any{new(big.Int).SetUint64(s.last)}
+	case "isValSetHeaderCommittedAt":
+		_, ok := s.headers[epoch()]
+		results = []any{ok}
+	case "getValSetHeaderHashAt":
+		results = []any{s.headers[epoch()].Hash()}
+	case "getSigVerifierAt":
+		results = []any{sigVerifier}
+	case "SUBNETWORK":
+		results = []any{subnetwork}
+	case "eip712Domain":
+		results = []any{[1]byte{0x0f}, domain.Name, domain.Version, big.NewInt(111), settlement, [32]byte{}, []*big.Int{}}
+	default:
+		return nil, errors.Errorf("unexpected call of %s", method.Name)
+	}
+	return method.Outputs.Pack(results...)
+}
+
+func (s *fakeSettlement) SendTransaction(_ context.Context, tx *types.Transaction) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent++
+	if s.dropped > 0 {
+		s.dropped--
+		if s.onDrop != nil {
+			s.onDrop()
+		}
+		return nil
+	}
+
+	method, err := s.settlementABI.MethodById(tx.Data()[:4])
+	if err != nil {
+		return err
+	}
+	args, err := method.Inputs.Unpack(tx.Data()[4:])
+	if err != nil {
+		return err
+	}
+	var (
+		abiHeader    bindings.ISettlementValSetHeader
+		abiExtraData []bindings.ISettlementExtraData
+	)
+	abi.ConvertType(args[0], &abiHeader)
+	abi.ConvertType(args[1], &abiExtraData)
+	header, err := bindings.ProofValSetHeader(abiHeader)
+	if err != nil {
+		return err
+	}
+	extraData := make([]genesis.ExtraData, len(abiExtraData))
+	for i, data := range abiExtraData {
+		extraData[i] = genesis.ExtraData{Key: data.Key, Value: data.Value}
+	}
+
+	if method.Name != "commitValSetHeader" || header.Epoch != s.last+1 {
+		return errors.Errorf("execution reverted: Settlement_InvalidEpoch")
+	}
+	digest := s.commitDigest(args[0], args[1], header.Epoch)
+	if !s.verifyQuorumSig(digest, args[2].([]byte)) {
+		return errors.Errorf("execution reverted: Settlement_VerificationFailed")
+	}
+	s.commit(header, extraData)
+	return nil
+}
+
+// commitDigest computes the commit digest with go-ethereum's EIP-712 implementation.
+func (s *fakeSettlement) commitDigest(header, extraData any, epoch uint64) common.Hash {
+	method := s.settlementABI.Methods["commitValSetHeader"]
+	encodedHeader, err := method.Inputs[:1].Pack(header)
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	encodedExtraData, err := method.Inputs[1:2].Pack(extraData)
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	digest, _, err := apitypes.TypedDataAndHash(apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {{Name: "name", Type: "string"}, {Name: "version", Type: "string"}},
+			"ValSetHeaderCommit": {
+				{Name: "subnetwork", Type: "bytes32"},
+				{Name: "epoch", Type: "uint48"},
+				{Name: "headerHash", Type: "bytes32"},
+				{Name: "extraDataHash", Type: "bytes32"},
+			},
+		},
+		PrimaryType: "ValSetHeaderCommit",
+		Domain:      apitypes.TypedDataDomain{Name: domain.Name, Version: domain.Version},
+		Message: apitypes.TypedDataMessage{
+			"subnetwork":    subnetwork.Hex(),
+			"epoch":         math.NewHexOrDecimal256(int64(epoch)),
+			"headerHash":    crypto.Keccak256Hash(encodedHeader).Hex(),
+			"extraDataHash": crypto.Keccak256Hash(encodedExtraData).Hex(),
+		},
+	})
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	return common.BytesToHash(digest)
+}
+
+// verifyQuorumSig mirrors the verifyQuorumSig of the sig verifier for the last committed epoch.
+func (s *fakeSettlement) verifyQuorumSig(digest common.Hash, proofBytes []byte) bool {
+	header := s.headers[s.last]
+	extraData := s.extraData[s.last]
+	message, _ := proof.HashToG1(digest)
+
+	if s.verificationType == genesis.VerificationTypeBlsBn254ZK {
+		if len(proofBytes) != 416 {
+			return false
+		}
+		signersVotingPower := new(big.Int).SetBytes(proofBytes[384:])
+		if signersVotingPower.Cmp(header.QuorumThreshold) < 0 {
+			return false
+		}
+		valsetHash := extraData[genesis.ExtraDataKeyTagged(s.verificationType, header.RequiredKeyTag, proof.ValsetHashMimc.ExtraDataName())]
+		inputHash := proof.DefaultConfig().PublicInputHash(signersVotingPower, proof.InputContext{ValsetHash: valsetHash.Bytes(), MessageG1: message})
+		return bytes.Equal(proofBytes[:32], inputHash.Bytes())
+	}
+
+	// SigVerifierBlsBn254Simple
+	nbValidators := new(big.Int).SetBytes(proofBytes[192:224]).Uint64()
+	nonSignersOffset := 224 + 64*nbValidators
+	valsetHash := extraData[genesis.ExtraDataKeyTagged(s.verificationType, header.RequiredKeyTag, proof.ValsetHashKeccak256.ExtraDataName())]
+	if crypto.Keccak256Hash(proofBytes[192:nonSignersOffset]) != valsetHash {
+		return false
+	}
+	aggKey := s.decompress(extraData[genesis.ExtraDataKeyTagged(s.verificationType, header.RequiredKeyTag, genesis.AggPublicKeyG1Name)])
+	nonSignersVotingPower := new(big.Int)
+	for offset := nonSignersOffset; offset < uint64(len(proofBytes)); offset += 2 {
+		index := uint64(proofBytes[offset])<<8 | uint64(proofBytes[offset+1])
+		entry := proofBytes[224+64*index : 224+64*(index+1)]
+		key := s.keys[[32]byte(entry[:32])]
+		aggKey.Sub(&aggKey, &key)
+		nonSignersVotingPower.Add(nonSignersVotingPower, new(big.Int).SetBytes(entry[32:]))
+	}
+	if header.QuorumThreshold.Cmp(new(big.Int).Sub(header.TotalVotingPower, nonSignersVotingPower)) > 0 {
+		return false
+	}
+
+	var signature bn254.G1Affine
+	signature.X.SetBytes(proofBytes[:32])
+	signature.Y.SetBytes(proofBytes[32:64])
+	var aggKeyG2 bn254.G2Affine
+	aggKeyG2.X.A1.SetBytes(proofBytes[64:96])
+	aggKeyG2.X.A0.SetBytes(proofBytes[96:128])
+	aggKeyG2.Y.A1.SetBytes(proofBytes[128:160])
+	aggKeyG2.Y.A0.SetBytes(proofBytes[160:192])
+	_, _, g1, g2 := bn254.Generators()
+	var negMessage, negG1 bn254.G1Affine
+	negMessage.Neg(&message)
+	negG1.Neg(&g1)
+	signatureOk, _ := bn254.PairingCheck([]bn254.G1Affine{signature, negMessage}, []bn254.G2Affine{g2, aggKeyG2})
+	keyOk, _ := bn254.PairingCheck([]bn254.G1Affine{aggKey, negG1}, []bn254.G2Affine{g2, aggKeyG2})
+	return signatureOk && keyOk
+}
+
+// decompress finds the sum of registered keys a compressed aggregated key stands for.
+func (s *fakeSettlement) decompress(compressed [32]byte) bn254.G1Affine {
+	keys := make([]bn254.G1Affine, 0, len(s.keys))
+	for _, key := range s.keys {
+		keys = append(keys, key)
+	}
+	for subset := 1; subset < 1<<len(keys); subset++ {
+		var sum bn254.G1Affine
+		for i := range keys {
+			if subset&(1<<i) != 0 {
+				sum.Add(&sum, &keys[i])
+			}
+		}
+		if proof.CompressKey(sum) == compressed {
+			return sum
+		}
+	}
+	s.t.Fatalf("no sum of keys compresses to %x", compressed)
+	return bn254.G1Affine{}
+}
+
+func (s *fakeSettlement) HeaderByNumber(context.Context, *big.Int) (*types.Header, error) {
+	return &types.Header{Number: big.NewInt(1), BaseFee: big.NewInt(1)}, nil
+}
+
+func (s *fakeSettlement) PendingCodeAt(context.Context, common.Address) ([]byte, error) {
+	return []byte{0x01}, nil
+}
+
+func (s *fakeSettlement) PendingNonceAt(context.Context, common.Address) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return uint64(s.sent), nil
+}
+
+func (s *fakeSettlement) SuggestGasPrice(context.Context) (*big.Int, error) {
+	return big.NewInt(1), nil
+}
+
+func (s *fakeSettlement) SuggestGasTipCap(context.Context) (*big.Int, error) {
+	return big.NewInt(1), nil
+}
+
+func (s *fakeSettlement) EstimateGas(context.Context, ethereum.CallMsg) (uint64, error) {
+	return 1_000_000, nil
+}
+
+func (s *fakeSettlement) FilterLogs(context.Context, ethereum.FilterQuery) ([]types.Log, error) {
+	return nil, nil
+}
+
+func (s *fakeSettlement) SubscribeFilterLogs(context.Context, ethereum.FilterQuery, chan<- types.Log) (ethereum.Subscription, error) {
+	return nil, errors.Errorf("subscriptions are not supported")
+}
+
+// setup returns a committer of a settlement whose genesis is epoch 1 of reader.
+func setup(t *testing.T, reader *fakeReader, collector Collector, prover Prover) (*Committer, *fakeSettlement) {
+	g, err := genesis.Generate(context.Background(), reader, driver, proof.DefaultConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend := newFakeSettlement(t, reader.config.VerificationType, g, reader.keys)
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	transactor, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(111))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := DefaultConfig()
+	cfg.Driver = driver
+	cfg.Settlement = settlement
+	cfg.PollInterval = time.Millisecond
+	cfg.CommitTimeout = 20 * time.Millisecond
+	c, err := New(cfg, reader, backend, transactor, collector, prover)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c, backend
+}
+
+func TestCommitter(t *testing.T) {
+	for _, tc := range []struct {
+		name             string
+		verificationType uint32
+	}{
+		{"simple", genesis.VerificationTypeBlsBn254Simple},
+		{"zk", genesis.VerificationTypeBlsBn254ZK},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			reader := newFakeReader(4, tc.verificationType)
+			reader.setVotingPowers(1, 100, 200, 300, 400)
+			collector := &fakeCollector{signers: []int{1, 2, 3}}
+			prover := &fakeProver{}
+			c, backend := setup(t, reader, collector, prover)
+
+			committed, err := c.CommitNext(context.Background())
+			if err != nil || committed {
+				t.Fatalf("committed %t, %v before the next epoch", committed, err)
+			}
+
+			reader.mu.Lock()
+			reader.epoch = 2
+			reader.mu.Unlock()
+			reader.setVotingPowers(2, 100, 200, 300, 500)
+			backend.dropped = 1
+			if committed, err := c.CommitNext(context.Background()); err != nil || !committed {
+				t.Fatalf("committed %t, %v", committed, err)
+			}
+			next, err := genesis.ReadValset(context.Background(), reader, driver, 2)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, err := next.Genesis(proof.DefaultConfig())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if backend.last != 2 || backend.headers[2].Hash() != want.Header.Hash() || backend.sent != 2 {
+				t.Fatalf("last committed epoch %d after %d transactions", backend.last, backend.sent)
+			}
+
+			request := collector.requests[0]
+			if request.Epoch != 1 || request.KeyTag != blsKeyTag || request.Header.Hash() != want.Header.Hash() {
+				t.Fatalf("got sign request %+v", request)
+			}
+			if tc.verificationType == genesis.VerificationTypeBlsBn254ZK {
+				input := prover.inputs[0]
+				var nonSigners []bn254.G1Affine
+				for _, validator := range input.ValidatorData {
+					if validator.IsNonSigner {
+						nonSigners = append(nonSigners, validator.Key)
+					}
+				}
+				nonSigner, _ := genesis.ParseBlsKey(reader.keys[0].Keys[0].Payload)
+				if len(prover.inputs) != 1 || len(nonSigners) != 1 || !nonSigners[0].Equal(&nonSigner) ||
+					input.QuorumThreshold.Cmp(backend.headers[1].QuorumThreshold) != 0 || input.Epoch != 1 {
+					t.Fatalf("got prove input %+v", input)
+				}
+			}
+
+			// nothing to commit until the next epoch
+			if committed, err := c.CommitNext(context.Background()); err != nil || committed || backend.sent != 2 {
+				t.Fatalf("committed %t, %v", committed, err)
+			}
+		})
+	}
+}
+
+func TestCommitterQuorum(t *testing.T) {
+	reader := newFakeReader(4, genesis.VerificationTypeBlsBn254Simple)
+	reader.setVotingPowers(1, 100, 200, 300, 400)
+	reader.setVotingPowers(2, 100, 200, 300, 400)
+	// 200 + 400 is below 1000 * 2/3 + 1
+	c, backend := setup(t, reader, &fakeCollector{signers: []int{1, 3}}, nil)
+	reader.epoch = 2
+
+	if _, err := c.CommitNext(context.Background()); err == nil {
+		t.Fatal("committed without quorum")
+	}
+	if backend.sent != 0 {
+		t.Fatalf("sent %d transactions", backend.sent)
+	}
+}
+
+func TestCommitterRetries(t *testing.T) {
+	reader := newFakeReader(2, genesis.VerificationTypeBlsBn254Simple)
+	reader.setVotingPowers(1, 100, 200)
+	reader.setVotingPowers(2, 100, 200)
+	next, err := genesis.ReadValset(context.Background(), reader, driver, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	target, err := next.Genesis(proof.DefaultConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, backend := setup(t, reader, &fakeCollector{signers: []int{0, 1}}, nil)
+	reader.epoch = 2
+	backend.dropped = c.cfg.MaxAttempts
+	if _, err := c.CommitNext(context.Background()); err == nil {
+		t.Fatal("committed without the header being committed")
+	}
+	if backend.sent != c.cfg.MaxAttempts || backend.last != 1 {
+		t.Fatalf("sent %d transactions", backend.sent)
+	}
+
+	// another committer commits the header while the transaction of this one is lost
+	backend.dropped = 1
+	backend.onDrop = func() {
+		backend.commit(target.Header, target.ExtraData)
+	}
+	if committed, err := c.CommitNext(context.Background()); err != nil || !committed {
+		t.Fatalf("committed %t, %v", committed, err)
+	}
+	if backend.sent != c.cfg.MaxAttempts+1 || backend.last != 2 {
+		t.Fatalf("sent %d transactions", backend.sent)
+	}
+}
+
+func TestCommitDigest(t *testing.T) {
+	header := proof.ValSetHeader{
+		Version:            1,
+		RequiredKeyTag:     blsKeyTag,
+		Epoch:              2,
+		CaptureTimestamp:   1_200,
+		QuorumThreshold:    big.NewInt(467),
+		TotalVotingPower:   big.NewInt(700),
+		ValidatorsSszMRoot: common.HexToHash("0x55"),
+	}
+	extraData := []genesis.ExtraData{{Key: common.HexToHash("0x01"), Value: common.HexToHash("0x02")}}
+	backend := &fakeSettlement{t: t}
+	var err error
+	if backend.settlementABI, err = bindings.SettlementMetaData.GetAbi(); err != nil {
+		t.Fatal(err)
+	}
+	abiExtraData := []bindings.ISettlementExtraData{bindings.NewExtraData(extraData[0].Key, extraData[0].Value)}
+	want := backend.commitDigest(bindings.NewValSetHeader(header), abiExtraData, header.Epoch)
+	if got := CommitDigest(domain, subnetwork, header, extraData); got != want {
+		t.Fatalf("got digest %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,62 @@
+package committer
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"middleware-offchain/pkg/genesis"
+	"middleware-offchain/pkg/proof"
+)
+
+// crossChainDomainTypehash is the domain type of OzEIP712.hashTypedDataV4CrossChain, which leaves the chain ID and
+// the verifying contract out so every settlement of a network signs the same digest.
+var crossChainDomainTypehash = crypto.Keccak256Hash([]byte("EIP712Domain(string name,string version)"))
+
+// Domain is the EIP-712 name and version of a settlement, from its eip712Domain.
+type Domain struct {
+	Name    string
+	Version string
+}
+
+// CommitDigest returns the digest Settlement.commitValSetHeader checks the quorum signature of:
+//
+//	hashTypedDataV4CrossChain(keccak256(abi.encode(VALSET_HEADER_COMMIT_TYPEHASH, subnetwork, epoch,
+//	    keccak256(abi.encode(header)), keccak256(abi.encode(extraData)))))
+//
+// The validators sign its hash to G1. It is proof.HeaderCommit.Digest, which transition proofs check in-circuit.
+func CommitDigest(domain Domain, subnetwork common.Hash, header proof.ValSetHeader, extraData []genesis.ExtraData) common.Hash {
+	return HeaderCommit(domain, subnetwork, extraData).Digest(header)
+}
+
+// HeaderCommit returns the commit context of a header of subnetwork with extraData.
+func HeaderCommit(domain Domain, subnetwork common.Hash, extraData []genesis.ExtraData) proof.HeaderCommit {
+	return proof.HeaderCommit{
+		DomainSeparator: DomainSeparator(domain),
+		Subnetwork:      subnetwork,
+		ExtraDataHash:   ExtraDataHash(extraData),
+	}
+}
+
+// DomainSeparator returns the cross-chain EIP-712 domain separator of domain.
+func DomainSeparator(domain Domain) common.Hash {
+	return crypto.Keccak256Hash(
+		crossChainDomainTypehash.Bytes(),
+		crypto.Keccak256([]byte(domain.Name)),
+		crypto.Keccak256([]byte(domain.Version)),
+	)
+}
+
+// ExtraDataHash returns keccak256(abi.encode(extraData)), with extraData an ISettlement.ExtraData[].
+func ExtraDataHash(extraData []genesis.ExtraData) common.Hash {
+	encoded := make([]byte, 0, 64+64*len(extraData))
+	// the offset of the array, the only dynamic value
+	encoded = append(encoded, common.BigToHash(big.NewInt(32)).Bytes()...)
+	encoded = append(encoded, common.BigToHash(big.NewInt(int64(len(extraData)))).Bytes()...)
+	for _, data := range extraData {
+		encoded = append(encoded, data.Key.Bytes()...)
+		encoded = append(encoded, data.Value.Bytes()...)
+	}
+	return crypto.Keccak256Hash(encoded)
+}
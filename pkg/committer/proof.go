@@ -0,0 +1,69 @@
+package committer
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	"github.com/go-errors/errors"
+
+	"middleware-offchain/pkg/genesis"
+	"middleware-offchain/pkg/proof"
+)
+
+// Prover proves the quorum signature of a valset for SigVerifierBlsBn254ZK, e.g. a proof.ZkProver.
+type Prover interface {
+	Prove(proveInput proof.ProveInput) (proof.ProofData, error)
+}
+
+// quorumProof returns the proof the sig verifier of verificationType takes for the signed valset.
+func (c *Committer) quorumProof(verificationType uint32, request SignRequest, quorumThreshold *big.Int, signed proof.Aggregate) ([]byte, error) {
+	switch verificationType {
+	case genesis.VerificationTypeBlsBn254ZK:
+		if c.prover == nil {
+			return nil, errors.Errorf("the sig verifier takes ZK proofs, but the committer has no prover")
+		}
+		proveInput := signed.ProveInput()
+		proveInput.MessageHash = request.Digest
+		proveInput.QuorumThreshold = quorumThreshold
+		proveInput.Epoch = request.Epoch
+		proveInput.KeyTag = request.KeyTag
+		proofData, err := c.prover.Prove(proveInput)
+		if err != nil {
+			return nil, errors.Errorf("failed to prove: %w", err)
+		}
+		return proofData.MarshalSolidity()
+	case genesis.VerificationTypeBlsBn254Simple:
+		return simpleProof(signed), nil
+	default:
+		return nil, errors.Errorf("unsupported verification type %d", verificationType)
+	}
+}
+
+// simpleProof encodes the proof SigVerifierBlsBn254Simple takes:
+//
+//	signature G1 || aggregated key G2 || len(valset) || (compressed key, voting power)... || non-signer indices
+//
+// with the indices as ascending uint16s. The valset data must hash to the validatorSetHashKeccak256 of the
+// committing epoch, so signed.Valset is in the genesis.ProofValset order.
+func simpleProof(signed proof.Aggregate) []byte {
+	encoded := make([]byte, 0, 224+64*len(signed.Valset))
+	x, y := signed.Signature.X.Bytes(), signed.Signature.Y.Bytes()
+	encoded = append(encoded, x[:]...)
+	encoded = append(encoded, y[:]...)
+	encoded = append(encoded, genesis.BlsKeyG2Payload(signed.SignersAggKeyG2)...)
+
+	word := make([]byte, 32)
+	big.NewInt(int64(len(signed.Valset))).FillBytes(word)
+	encoded = append(encoded, word...)
+	var nonSigners []byte
+	for i := range signed.Valset {
+		compressed := proof.CompressKey(signed.Valset[i].Key)
+		encoded = append(encoded, compressed[:]...)
+		signed.Valset[i].VotingPower.FillBytes(word)
+		encoded = append(encoded, word...)
+		if signed.Valset[i].IsNonSigner {
+			nonSigners = binary.BigEndian.AppendUint16(nonSigners, uint16(i))
+		}
+	}
+	return append(encoded, nonSigners...)
+}
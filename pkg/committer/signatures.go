@@ -0,0 +1,77 @@
+package committer
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+
+	"middleware-offchain/pkg/bls"
+	"middleware-offchain/pkg/genesis"
+	"middleware-offchain/pkg/proof"
+)
+
+// SignRequest asks the validators of Epoch to sign the commit of the next header with their KeyTag keys.
+type SignRequest struct {
+	Epoch     uint64 // the epoch of the committing valset, the last committed one
+	KeyTag    uint8
+	Header    proof.ValSetHeader // the header to commit
+	ExtraData []genesis.ExtraData
+	Digest    common.Hash // see CommitDigest
+}
+
+// Signature is the signature of a SignRequest by one validator: its key signs proof.HashToG1(Digest). KeyG2 is
+// the same key in G2, which the KeyRegistry doesn't return.
+type Signature = proof.SignatureShare
+
+// Collector gathers the signatures of a SignRequest, e.g. from the validators' relay nodes. It may return the
+// signatures of part of the valset only, the committer checks the signers reach the quorum.
+type Collector interface {
+	Collect(ctx context.Context, request SignRequest) ([]Signature, error)
+}
+
+// LocalCollector signs requests with keys held by this process, e.g. decrypted from bls keystore files.
+type LocalCollector struct {
+	Signers []bls.Signer
+}
+
+var _ Collector = (*LocalCollector)(nil)
+
+// Collect signs request with every signer.
+func (c *LocalCollector) Collect(_ context.Context, request SignRequest) ([]Signature, error) {
+	message, _ := proof.HashToG1(request.Digest)
+	signatures := make([]Signature, len(c.Signers))
+	for i, signer := range c.Signers {
+		key := signer.PublicKeyG1()
+		signature, err := signer.Sign(message)
+		if err != nil {
+			return nil, errors.Errorf("failed to sign with key %s: %w", key.String(), err)
+		}
+		signatures[i] = Signature{Key: key, KeyG2: signer.PublicKeyG2(), Signature: signature}
+	}
+	return signatures, nil
+}
+
+// aggregate marks the validators of valset without a valid signature as non-signers and aggregates the signatures
+// of the others. Invalid signatures and signatures of keys outside valset are dropped.
+func aggregate(valset []proof.ValidatorData, message bn254.G1Affine, signatures []Signature) (proof.Aggregate, error) {
+	aggregator, err := proof.NewAggregator(valset, message)
+	if err != nil {
+		return proof.Aggregate{}, errors.Errorf("failed to aggregate the signatures: %w", err)
+	}
+	for _, signature := range signatures {
+		if err := aggregator.Add(signature); err != nil {
+			slog.Warn("dropped a signature", "error", err)
+		}
+	}
+	signed, culprits, err := aggregator.Aggregate()
+	for _, culprit := range culprits {
+		slog.Warn("dropped an invalid signature", "key", culprit.Key.String())
+	}
+	if err != nil {
+		return proof.Aggregate{}, errors.Errorf("failed to aggregate the signatures: %w", err)
+	}
+	return signed, nil
+}
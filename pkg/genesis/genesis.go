@@ -0,0 +1,295 @@
+// Package genesis derives the genesis validator set header a settlement is initialized with from the state of a
+// ValSetDriver, and writes it as the genesis_header.json MasterGenesisSetup reads.
+package genesis
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-errors/errors"
+
+	"middleware-offchain/pkg/proof"
+)
+
+// ValSetHeaderVersion is Settlement.VALIDATOR_SET_VERSION.
+const ValSetHeaderVersion = 1
+
+// Verification types of the sig verifiers.
+const (
+	VerificationTypeBlsBn254ZK     = 0
+	VerificationTypeBlsBn254Simple = 1
+)
+
+// MaxQuorumThreshold is ValSetDriver.MAX_QUORUM_THRESHOLD, the quorum threshold of 100%.
+var MaxQuorumThreshold = big.NewInt(1e18)
+
+// Names of the extra data the sig verifiers read, hashed with keccak256 into ExtraDataStorageHelper keys.
+const (
+	TotalActiveValidatorsName = "totalActiveValidators"
+	AggPublicKeyG1Name        = "aggPublicKeyG1"
+)
+
+// ExtraData mirrors ISettlement.ExtraData.
+type ExtraData struct {
+	Key   common.Hash `json:"key"`
+	Value common.Hash `json:"value"`
+}
+
+// Genesis is a header with its extra data, as passed to Settlement.setGenesis.
+type Genesis struct {
+	Header    proof.ValSetHeader
+	ExtraData []ExtraData
+}
+
+// ExtraDataKey mirrors ExtraDataStorageHelper.getKey(verificationType, nameHash).
+func ExtraDataKey(verificationType uint32, name string) common.Hash {
+	return crypto.Keccak256Hash(
+		common.LeftPadBytes(big.NewInt(int64(verificationType)).Bytes(), 32),
+		crypto.Keccak256([]byte(name)),
+	)
+}
+
+// ExtraDataKeyTagged mirrors ExtraDataStorageHelper.getKey(verificationType, keyTag, nameHash).
+func ExtraDataKeyTagged(verificationType uint32, keyTag uint8, name string) common.Hash {
+	return crypto.Keccak256Hash(
+		common.LeftPadBytes(big.NewInt(int64(verificationType)).Bytes(), 32),
+		crypto.Keccak256([]byte("keyTag.")),
+		common.LeftPadBytes([]byte{keyTag}, 32),
+		crypto.Keccak256([]byte(name)),
+	)
+}
+
+// Generate derives the genesis of the current epoch of driver: the valset captured at the epoch start, and the
+// header and extra data committing to it for the driver's verification type. proofConfig is the circuit of the
+// ZK sig verifier, whose valset hash the extra data holds.
+func Generate(ctx context.Context, reader ChainReader, driver CrossChainAddress, proofConfig proof.Config) (Genesis, error) {
+	epoch, err := reader.CurrentEpoch(ctx, driver)
+	if err != nil {
+		return Genesis{}, errors.Errorf("failed to read the current epoch: %w", err)
+	}
+	valset, err := ReadValset(ctx, reader, driver, epoch)
+	if err != nil {
+		return Genesis{}, err
+	}
+	return valset.Genesis(proofConfig)
+}
+
+// Valset is the validator set of a driver epoch, with the driver config it was derived under.
+type Valset struct {
+	Epoch            uint64
+	CaptureTimestamp uint64
+	Config           DriverConfig
+	Validators       []Validator
+}
+
+// ReadValset derives the validator set of an epoch of driver, captured at the epoch start.
+func ReadValset(ctx context.Context, reader ChainReader, driver CrossChainAddress, epoch uint64) (Valset, error) {
+	captureTimestamp, err := reader.EpochStart(ctx, driver, epoch)
+	if err != nil {
+		return Valset{}, errors.Errorf("failed to read the start of epoch %d: %w", epoch, err)
+	}
+	config, err := reader.ConfigAt(ctx, driver, captureTimestamp)
+	if err != nil {
+		return Valset{}, errors.Errorf("failed to read the driver config: %w", err)
+	}
+
+	votingPowers := make([]ProviderVotingPowers, len(config.VotingPowerProviders))
+	for i, provider := range config.VotingPowerProviders {
+		votingPowers[i].Provider = provider
+		if votingPowers[i].VotingPowers, err = reader.VotingPowersAt(ctx, provider, captureTimestamp); err != nil {
+			return Valset{}, errors.Errorf("failed to read the voting powers of %s on chain %d: %w", provider.Addr, provider.ChainId, err)
+		}
+	}
+	keys, err := reader.KeysAt(ctx, config.KeysProvider, captureTimestamp)
+	if err != nil {
+		return Valset{}, errors.Errorf("failed to read the keys: %w", err)
+	}
+
+	return Valset{
+		Epoch:            epoch,
+		CaptureTimestamp: captureTimestamp,
+		Config:           config,
+		Validators:       DeriveValidators(config, votingPowers, keys),
+	}, nil
+}
+
+// Genesis builds the header and extra data committing to the valset, see NewGenesis.
+func (v Valset) Genesis(proofConfig proof.Config) (Genesis, error) {
+	return NewGenesis(v.Config, proofConfig, v.Epoch, v.CaptureTimestamp, v.Validators)
+}
+
+// NewGenesis builds the header and extra data of a derived validator set. proofConfig selects the valset hash of
+// the ZK extra data, it is ignored for other verification types.
+func NewGenesis(config DriverConfig, proofConfig proof.Config, epoch, captureTimestamp uint64, validators []Validator) (Genesis, error) {
+	totalVotingPower := TotalVotingPower(validators)
+	if totalVotingPower.Sign() == 0 {
+		return Genesis{}, errors.Errorf("no active validators")
+	}
+	quorumThreshold, err := headerQuorumThreshold(config, totalVotingPower)
+	if err != nil {
+		return Genesis{}, err
+	}
+	extraData, err := newExtraData(config, proofConfig, validators)
+	if err != nil {
+		return Genesis{}, err
+	}
+	return Genesis{
+		Header: proof.ValSetHeader{
+			Version:            ValSetHeaderVersion,
+			RequiredKeyTag:     config.RequiredHeaderKeyTag,
+			Epoch:              epoch,
+			CaptureTimestamp:   captureTimestamp,
+			QuorumThreshold:    quorumThreshold,
+			TotalVotingPower:   totalVotingPower,
+			ValidatorsSszMRoot: ValidatorSetRoot(validators),
+		},
+		ExtraData: extraData,
+	}, nil
+}
+
+// headerQuorumThreshold returns the voting power needed to commit the next header: the share the driver sets
+// for the required header key tag, rounded down, plus one.
+func headerQuorumThreshold(config DriverConfig, totalVotingPower *big.Int) (*big.Int, error) {
+	threshold, ok := config.QuorumThreshold(config.RequiredHeaderKeyTag)
+	if !ok {
+		return nil, errors.Errorf("no quorum threshold for the required header key tag %d", config.RequiredHeaderKeyTag)
+	}
+	quorumThreshold := new(big.Int).Mul(totalVotingPower, threshold)
+	quorumThreshold.Div(quorumThreshold, MaxQuorumThreshold)
+	return quorumThreshold.Add(quorumThreshold, big.NewInt(1)), nil
+}
+
+// newExtraData returns the extra data the sig verifier of the driver's verification type reads, for every
+// required BLS BN254 key tag:
+//
+//   - SigVerifierBlsBn254ZK: the number of validators in the ProofValset of the key tags and the valset hash of
+//     proofConfig's circuit, e.g. validatorSetHashMimc, of every key tag
+//   - SigVerifierBlsBn254Simple: the keccak256 valset commitment and the compressed aggregated key of every key tag
+//
+// The ZK verifier selects its tier from the number of validators like the prover does from the length of the
+// ProofValset, so every key tag must have the same number of validators.
+func newExtraData(config DriverConfig, proofConfig proof.Config, validators []Validator) ([]ExtraData, error) {
+	switch config.VerificationType {
+	case VerificationTypeBlsBn254ZK, VerificationTypeBlsBn254Simple:
+	default:
+		return nil, errors.Errorf("unsupported verification type %d", config.VerificationType)
+	}
+
+	var extraData []ExtraData
+	nbActive := -1
+	for _, tag := range config.RequiredKeyTags {
+		if tag>>4 != KeyTypeBlsBn254 {
+			continue
+		}
+		valset, err := ProofValset(validators, tag, proofConfig.CircuitVersion)
+		if err != nil {
+			return nil, err
+		}
+		if config.VerificationType == VerificationTypeBlsBn254ZK {
+			if nbActive >= 0 && nbActive != len(valset) {
+				return nil, errors.Errorf("key tag %d has %d validators, other key tags %d", tag, len(valset), nbActive)
+			}
+			nbActive = len(valset)
+			valsetHash := proofConfig.ValsetHash()
+			extraData = append(extraData, ExtraData{
+				Key:   ExtraDataKeyTagged(config.VerificationType, tag, valsetHash.ExtraDataName()),
+				Value: common.BytesToHash(proof.HashValsetWith(valsetHash, valset)),
+			})
+			continue
+		}
+
+		var aggKey bn254.G1Affine
+		for i := range valset {
+			aggKey.Add(&aggKey, &valset[i].Key)
+		}
+		extraData = append(extraData,
+			ExtraData{
+				Key:   ExtraDataKeyTagged(config.VerificationType, tag, proof.ValsetHashKeccak256.ExtraDataName()),
+				Value: common.BytesToHash(proof.HashValsetWith(proof.ValsetHashKeccak256, valset)),
+			},
+			ExtraData{
+				Key:   ExtraDataKeyTagged(config.VerificationType, tag, AggPublicKeyG1Name),
+				Value: proof.CompressKey(aggKey),
+			},
+		)
+	}
+	if config.VerificationType == VerificationTypeBlsBn254ZK {
+		extraData = append([]ExtraData{{
+			Key:   ExtraDataKey(config.VerificationType, TotalActiveValidatorsName),
+			Value: common.BigToHash(big.NewInt(int64(max(nbActive, 0)))),
+		}}, extraData...)
+	}
+	return extraData, nil
+}
+
+// EncodeFile returns g as the indented genesis_header.json.
+func EncodeFile(g Genesis) ([]byte, error) {
+	data, err := json.MarshalIndent(g, "", "  ")
+	if err != nil {
+		return nil, errors.Errorf("failed to encode genesis: %w", err)
+	}
+	return append(data, '\n'), nil
+}
+
+// genesisJSON is the layout of genesis_header.json, with the header fields in the order of the existing files.
+type genesisJSON struct {
+	Header    headerJSON  `json:"header"`
+	ExtraData []ExtraData `json:"extraData"`
+}
+
+type headerJSON struct {
+	Version            uint8       `json:"version"`
+	ValidatorsSszMRoot common.Hash `json:"validatorsSszMRoot"`
+	Epoch              uint64      `json:"epoch"`
+	RequiredKeyTag     uint8       `json:"requiredKeyTag"`
+	CaptureTimestamp   uint64      `json:"captureTimestamp"`
+	QuorumThreshold    *big.Int    `json:"quorumThreshold"`
+	TotalVotingPower   *big.Int    `json:"totalVotingPower"`
+}
+
+// MarshalJSON encodes g as genesis_header.json. Big numbers are JSON numbers, as forge's parseJson reads them.
+func (g Genesis) MarshalJSON() ([]byte, error) {
+	extraData := g.ExtraData
+	if extraData == nil {
+		extraData = []ExtraData{}
+	}
+	return json.Marshal(genesisJSON{
+		Header: headerJSON{
+			Version:            g.Header.Version,
+			ValidatorsSszMRoot: g.Header.ValidatorsSszMRoot,
+			Epoch:              g.Header.Epoch,
+			RequiredKeyTag:     g.Header.RequiredKeyTag,
+			CaptureTimestamp:   g.Header.CaptureTimestamp,
+			QuorumThreshold:    g.Header.QuorumThreshold,
+			TotalVotingPower:   g.Header.TotalVotingPower,
+		},
+		ExtraData: extraData,
+	})
+}
+
+func (g *Genesis) UnmarshalJSON(data []byte) error {
+	var decoded genesisJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	if decoded.Header.QuorumThreshold == nil || decoded.Header.TotalVotingPower == nil {
+		return errors.Errorf("genesis header misses the quorum threshold or the total voting power")
+	}
+	*g = Genesis{
+		Header: proof.ValSetHeader{
+			Version:            decoded.Header.Version,
+			RequiredKeyTag:     decoded.Header.RequiredKeyTag,
+			Epoch:              decoded.Header.Epoch,
+			CaptureTimestamp:   decoded.Header.CaptureTimestamp,
+			QuorumThreshold:    decoded.Header.QuorumThreshold,
+			TotalVotingPower:   decoded.Header.TotalVotingPower,
+			ValidatorsSszMRoot: decoded.Header.ValidatorsSszMRoot,
+		},
+		ExtraData: decoded.ExtraData,
+	}
+	return nil
+}
@@ -0,0 +1,333 @@
+package genesis
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-errors/errors"
+
+	"middleware-offchain/pkg/proof"
+)
+
+const (
+	blsKeyTag   = 15 // KEY_TYPE_BLS_BN254.getKeyTag(15), the key tag of the test setups
+	ecdsaKeyTag = 16
+)
+
+var (
+	driver    = CrossChainAddress{ChainId: 111, Addr: common.HexToAddress("0xd0")}
+	providerA = CrossChainAddress{ChainId: 111, Addr: common.HexToAddress("0xa0")}
+	providerB = CrossChainAddress{ChainId: 222, Addr: common.HexToAddress("0xb0")}
+	operators = []common.Address{
+		common.HexToAddress("0x04"),
+		common.HexToAddress("0x03"),
+		common.HexToAddress("0x02"),
+		common.HexToAddress("0x01"),
+	}
+)
+
+// fakeReader serves a fixed chain state, captured at start.
+type fakeReader struct {
+	epoch, start uint64
+	config       DriverConfig
+	votingPowers map[CrossChainAddress][]OperatorVotingPower
+	keys         []OperatorWithKeys
+}
+
+func (r *fakeReader) CurrentEpoch(_ context.Context, d CrossChainAddress) (uint64, error) {
+	if d != driver {
+		return 0, errors.Errorf("unknown driver %v", d)
+	}
+	return r.epoch, nil
+}
+
+func (r *fakeReader) EpochStart(_ context.Context, _ CrossChainAddress, epoch uint64) (uint64, error) {
+	if epoch != r.epoch {
+		return 0, errors.Errorf("unexpected epoch %d", epoch)
+	}
+	return r.start, nil
+}
+
+func (r *fakeReader) ConfigAt(_ context.Context, _ CrossChainAddress, timestamp uint64) (DriverConfig, error) {
+	return r.config, r.checkTimestamp(timestamp)
+}
+
+func (r *fakeReader) VotingPowersAt(_ context.Context, provider CrossChainAddress, timestamp uint64) ([]OperatorVotingPower, error) {
+	return r.votingPowers[provider], r.checkTimestamp(timestamp)
+}
+
+func (r *fakeReader) KeysAt(_ context.Context, keysProvider CrossChainAddress, timestamp uint64) ([]OperatorWithKeys, error) {
+	if keysProvider != r.config.KeysProvider {
+		return nil, errors.Errorf("unknown keys provider %v", keysProvider)
+	}
+	return r.keys, r.checkTimestamp(timestamp)
+}
+
+func (r *fakeReader) checkTimestamp(timestamp uint64) error {
+	if timestamp != r.start {
+		return errors.Errorf("read at %d, not at the capture timestamp %d", timestamp, r.start)
+	}
+	return nil
+}
+
+func blsKey(privateKey int64) bn254.G1Affine {
+	_, _, g1, _ := bn254.Generators()
+	var key bn254.G1Affine
+	key.ScalarMultiplication(&g1, big.NewInt(privateKey))
+	return key
+}
+
+// newFakeReader returns four operators:
+//
+//   - operators[0] with 300 on chain 111 and 200 on chain 222, capped to 400
+//   - operators[1] with 300
+//   - operators[2] with 250 and no keys, inactive
+//   - operators[3] with 50, below the minimum inclusion voting power
+func newFakeReader(verificationType uint32) *fakeReader {
+	vault := func(b byte, value int64) VaultValue {
+		return VaultValue{Vault: common.BytesToAddress([]byte{0xee, b}), Value: big.NewInt(value)}
+	}
+	keys := func(privateKey int64) []Key {
+		return []Key{
+			{Tag: blsKeyTag, Payload: BlsKeyPayload(blsKey(privateKey))},
+			{Tag: ecdsaKeyTag, Payload: common.LeftPadBytes(operators[0].Bytes(), 32)},
+		}
+	}
+	return &fakeReader{
+		epoch: 3,
+		start: 1_750_000_000,
+		config: DriverConfig{
+			VotingPowerProviders:    []CrossChainAddress{providerA, providerB},
+			KeysProvider:            providerA,
+			MaxVotingPower:          big.NewInt(400),
+			MinInclusionVotingPower: big.NewInt(100),
+			MaxValidatorsCount:      big.NewInt(10),
+			RequiredKeyTags:         []uint8{blsKeyTag, ecdsaKeyTag},
+			QuorumThresholds:        []QuorumThreshold{{KeyTag: blsKeyTag, QuorumThreshold: big.NewInt(666_666_666_666_666_667)}},
+			RequiredHeaderKeyTag:    blsKeyTag,
+			VerificationType:        verificationType,
+		},
+		votingPowers: map[CrossChainAddress][]OperatorVotingPower{
+			providerA: {
+				{Operator: operators[3], Vaults: []VaultValue{vault(4, 50)}},
+				{Operator: operators[2], Vaults: []VaultValue{vault(3, 250)}},
+				{Operator: operators[1], Vaults: []VaultValue{vault(2, 300)}},
+				{Operator: operators[0], Vaults: []VaultValue{vault(1, 300)}},
+			},
+			providerB: {
+				{Operator: operators[0], Vaults: []VaultValue{vault(1, 200)}},
+			},
+		},
+		keys: []OperatorWithKeys{
+			{Operator: operators[0], Keys: keys(11)},
+			{Operator: operators[1], Keys: keys(12)},
+			{Operator: operators[3], Keys: keys(14)},
+		},
+	}
+}
+
+func TestDeriveValidators(t *testing.T) {
+	reader := newFakeReader(VerificationTypeBlsBn254ZK)
+	votingPowers := []ProviderVotingPowers{
+		{Provider: providerA, VotingPowers: reader.votingPowers[providerA]},
+		{Provider: providerB, VotingPowers: reader.votingPowers[providerB]},
+	}
+	validators := DeriveValidators(reader.config, votingPowers, reader.keys)
+
+	want := []struct {
+		operator    common.Address
+		votingPower int64
+		isActive    bool
+		nbVaults    int
+	}{
+		{operators[3], 50, false, 1},
+		{operators[2], 250, false, 1},
+		{operators[1], 300, true, 1},
+		{operators[0], 400, true, 2},
+	}
+	if len(validators) != len(want) {
+		t.Fatalf("got %d validators, want %d", len(validators), len(want))
+	}
+	for i, w := range want {
+		v := validators[i]
+		if v.Operator != w.operator || v.VotingPower.Int64() != w.votingPower || v.IsActive != w.isActive || len(v.Vaults) != w.nbVaults {
+			t.Errorf("validator %d: got %s with %s, active %t, %d vaults", i, v.Operator, v.VotingPower, v.IsActive, len(v.Vaults))
+		}
+	}
+	if vaults := validators[3].Vaults; vaults[0].ChainID != 111 || vaults[1].ChainID != 222 || vaults[0].VotingPower.Int64() != 300 {
+		t.Fatalf("got vaults %+v", vaults)
+	}
+
+	reader.config.MaxValidatorsCount = big.NewInt(1)
+	validators = DeriveValidators(reader.config, votingPowers, reader.keys)
+	if !validators[3].IsActive || validators[2].IsActive {
+		t.Fatal("MaxValidatorsCount doesn't keep the validator with the most voting power only")
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	zkValset := []proof.ValidatorData{
+		{Key: blsKey(11), VotingPower: big.NewInt(400)},
+		{Key: blsKey(12), VotingPower: big.NewInt(300)},
+	}
+	proof.SortValset(proof.CircuitVersionV1, zkValset)
+
+	reader := newFakeReader(VerificationTypeBlsBn254ZK)
+	genesis, err := Generate(context.Background(), reader, driver, proof.DefaultConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	header := genesis.Header
+	if header.Version != 1 || header.RequiredKeyTag != blsKeyTag || header.Epoch != 3 || header.CaptureTimestamp != 1_750_000_000 {
+		t.Fatalf("got header %+v", header)
+	}
+	// 700 * 2/3 rounded down, plus one
+	if header.TotalVotingPower.Int64() != 700 || header.QuorumThreshold.Int64() != 467 {
+		t.Fatalf("got total voting power %s and quorum threshold %s", header.TotalVotingPower, header.QuorumThreshold)
+	}
+	if header.ValidatorsSszMRoot == (common.Hash{}) {
+		t.Fatal("no validators root")
+	}
+
+	// keccak256(abi.encode(uint32(0), keccak256("totalActiveValidators"))) and
+	// keccak256(abi.encode(uint32(0), KEY_TAG_PREFIX_HASH, uint8(15), keccak256("validatorSetHashMimc"))), the keys
+	// SigVerifierBlsBn254ZK.t.sol sets. The committed genesis files predate these keys.
+	want := []ExtraData{
+		{
+			Key:   common.HexToHash("0x87a67850cbb7616e7e927c1dcc669f1e70010471a73855d240f5837529ae1430"),
+			Value: common.BigToHash(big.NewInt(2)),
+		},
+		{
+			Key:   common.HexToHash("0xb0c1a00f3df95dfb6c3349ced94af36d2fa8d1bfcf24101290ee1eff0634e4ce"),
+			Value: common.BytesToHash(proof.HashValset(zkValset)),
+		},
+	}
+	if len(genesis.ExtraData) != len(want) || genesis.ExtraData[0] != want[0] || genesis.ExtraData[1] != want[1] {
+		t.Fatalf("got extra data %v, want %v", genesis.ExtraData, want)
+	}
+
+	// a V5 circuit hashes the valset with Poseidon2, under its own key,
+	v5, err := Generate(context.Background(), reader, driver, proof.Config{CircuitVersion: proof.CircuitVersionV5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// and sorts it in its own order
+	v5Valset := append([]proof.ValidatorData(nil), zkValset...)
+	proof.SortValset(proof.CircuitVersionV5, v5Valset)
+	want[1] = ExtraData{
+		Key:   ExtraDataKeyTagged(VerificationTypeBlsBn254ZK, blsKeyTag, "validatorSetHashPoseidon2"),
+		Value: common.BytesToHash(proof.HashValsetWith(proof.ValsetHashPoseidon2, v5Valset)),
+	}
+	if len(v5.ExtraData) != len(want) || v5.ExtraData[0] != want[0] || v5.ExtraData[1] != want[1] {
+		t.Fatalf("got V5 extra data %v, want %v", v5.ExtraData, want)
+	}
+
+	// a compressed-key circuit commits to keccak256(n || key_0 || votingPower_0 || ...) of the 2 validators, not
+	// of the valset padded to the tier, as TestCircuitCompressedKeysActivePrefix checks the circuit does
+	compressed, err := Generate(context.Background(), reader, driver, proof.Config{CircuitVersion: proof.CircuitVersionV3, CompressedKeys: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	preimage := common.BigToHash(big.NewInt(int64(len(zkValset)))).Bytes()
+	for _, validator := range zkValset {
+		key := proof.CompressKey(validator.Key)
+		preimage = append(append(preimage, key[:]...), common.BigToHash(validator.VotingPower).Bytes()...)
+	}
+	want[1] = ExtraData{
+		Key:   ExtraDataKeyTagged(VerificationTypeBlsBn254ZK, blsKeyTag, "validatorSetHashKeccak256"),
+		Value: crypto.Keccak256Hash(preimage),
+	}
+	if len(compressed.ExtraData) != len(want) || compressed.ExtraData[1] != want[1] {
+		t.Fatalf("got compressed-key extra data %v, want %v", compressed.ExtraData, want)
+	}
+
+	simple, err := Generate(context.Background(), newFakeReader(VerificationTypeBlsBn254Simple), driver, proof.DefaultConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if simple.Header.Hash() != header.Hash() {
+		t.Fatal("the verification type changes the header")
+	}
+	aggKey := blsKey(11 + 12)
+	want = []ExtraData{
+		{
+			Key:   ExtraDataKeyTagged(VerificationTypeBlsBn254Simple, blsKeyTag, "validatorSetHashKeccak256"),
+			Value: common.BytesToHash(proof.HashValsetWith(proof.ValsetHashKeccak256, zkValset)),
+		},
+		{
+			Key:   ExtraDataKeyTagged(VerificationTypeBlsBn254Simple, blsKeyTag, AggPublicKeyG1Name),
+			Value: proof.CompressKey(aggKey),
+		},
+	}
+	if len(simple.ExtraData) != len(want) || simple.ExtraData[0] != want[0] || simple.ExtraData[1] != want[1] {
+		t.Fatalf("got extra data %v, want %v", simple.ExtraData, want)
+	}
+
+	reader.config.QuorumThresholds = nil
+	if _, err := Generate(context.Background(), reader, driver, proof.DefaultConfig()); err == nil {
+		t.Fatal("generated a genesis without a quorum threshold for the header key tag")
+	}
+}
+
+// TestGenerateNonBlsValidator counts the validators of the ZK extra data like the prover selects its tier, from
+// the holders of the BLS key: operators[2] is active with an ECDSA key only.
+func TestGenerateNonBlsValidator(t *testing.T) {
+	reader := newFakeReader(VerificationTypeBlsBn254ZK)
+	reader.keys = append(reader.keys, OperatorWithKeys{
+		Operator: operators[2],
+		Keys:     []Key{{Tag: ecdsaKeyTag, Payload: common.LeftPadBytes(operators[2].Bytes(), 32)}},
+	})
+	valset, err := ReadValset(context.Background(), reader, driver, reader.epoch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !valset.Validators[1].IsActive {
+		t.Fatal("the validator with an ECDSA key only is inactive")
+	}
+	proofValset, err := ProofValset(valset.Validators, blsKeyTag, proof.CircuitVersionV1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(proofValset) != 2 {
+		t.Fatalf("got %d validators with a BLS key, want 2", len(proofValset))
+	}
+	genesis, err := valset.Genesis(proof.DefaultConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	totalActive := genesis.ExtraData[0]
+	if totalActive.Key != ExtraDataKey(VerificationTypeBlsBn254ZK, TotalActiveValidatorsName) || totalActive.Value != common.BigToHash(big.NewInt(2)) {
+		t.Fatalf("got %v, want 2 total active validators", totalActive)
+	}
+}
+
+// TestGenesisJSON checks genesis files are written in the layout of the committed ones.
+func TestGenesisJSON(t *testing.T) {
+	for _, path := range []string{"../../test/data/genesis_header.json", "../../script/test/data/genesis_header.json"} {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var genesis Genesis
+		if err := json.Unmarshal(data, &genesis); err != nil {
+			t.Fatal(err)
+		}
+		if genesis.Header.RequiredKeyTag != blsKeyTag || len(genesis.ExtraData) != 2 {
+			t.Fatalf("%s decoded to %+v", path, genesis)
+		}
+		written, err := EncodeFile(genesis)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(written, data) {
+			t.Fatalf("%s written as\n%s", path, written)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package genesis
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/go-errors/errors"
+
+	"middleware-offchain/pkg/bindings"
+)
+
+// The structs of the contracts Generate reads, see the bindings package.
+type (
+	CrossChainAddress = bindings.IValSetDriverCrossChainAddress
+	// QuorumThreshold is a share of MaxQuorumThreshold.
+	QuorumThreshold     = bindings.IValSetDriverQuorumThreshold
+	DriverConfig        = bindings.IValSetDriverConfig
+	VaultValue          = bindings.IVotingPowerProviderVaultValue
+	OperatorVotingPower = bindings.IVotingPowerProviderOperatorVotingPower
+	// Key payloads are abi.encode(G1Point) for BLS BN254 keys and abi.encode(address) for ECDSA ones.
+	Key              = bindings.IKeyRegistryKey
+	OperatorWithKeys = bindings.IKeyRegistryOperatorWithKeys
+)
+
+// ChainReader reads the contract state a valset is derived from. Every contract is addressed with its chain, so
+// one reader can serve voting power providers on several chains.
+type ChainReader interface {
+	// CurrentEpoch returns the current epoch of the driver.
+	CurrentEpoch(ctx context.Context, driver CrossChainAddress) (uint64, error)
+	// EpochStart returns the start timestamp of an epoch of the driver.
+	EpochStart(ctx context.Context, driver CrossChainAddress, epoch uint64) (uint64, error)
+	// ConfigAt returns the driver config at a timestamp.
+	ConfigAt(ctx context.Context, driver CrossChainAddress, timestamp uint64) (DriverConfig, error)
+	// VotingPowersAt returns the vault voting powers of every operator of a voting power provider at a timestamp.
+	VotingPowersAt(ctx context.Context, provider CrossChainAddress, timestamp uint64) ([]OperatorVotingPower, error)
+	// KeysAt returns the keys of every operator of a keys provider at a timestamp.
+	KeysAt(ctx context.Context, keysProvider CrossChainAddress, timestamp uint64) ([]OperatorWithKeys, error)
+}
+
+// ContractReader is a ChainReader calling the contracts through one bind.ContractCaller per chain, e.g. an
+// ethclient.Client.
+type ContractReader struct {
+	callers map[uint64]bind.ContractCaller
+}
+
+// NewContractReader returns a ContractReader calling the contracts of every chain ID in callers.
+func NewContractReader(callers map[uint64]bind.ContractCaller) *ContractReader {
+	return &ContractReader{callers: callers}
+}
+
+func (r *ContractReader) CurrentEpoch(ctx context.Context, driver CrossChainAddress) (uint64, error) {
+	caller, err := r.driver(driver)
+	if err != nil {
+		return 0, err
+	}
+	epoch, err := caller.GetCurrentEpoch(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return 0, errors.Errorf("failed to read the current epoch of %s: %w", driver.Addr, err)
+	}
+	return epoch.Uint64(), nil
+}
+
+func (r *ContractReader) EpochStart(ctx context.Context, driver CrossChainAddress, epoch uint64) (uint64, error) {
+	caller, err := r.driver(driver)
+	if err != nil {
+		return 0, err
+	}
+	start, err := caller.GetEpochStart(&bind.CallOpts{Context: ctx}, new(big.Int).SetUint64(epoch))
+	if err != nil {
+		return 0, errors.Errorf("failed to read the start of epoch %d of %s: %w", epoch, driver.Addr, err)
+	}
+	return start.Uint64(), nil
+}
+
+func (r *ContractReader) ConfigAt(ctx context.Context, driver CrossChainAddress, timestamp uint64) (DriverConfig, error) {
+	caller, err := r.driver(driver)
+	if err != nil {
+		return DriverConfig{}, err
+	}
+	config, err := caller.GetConfigAt(&bind.CallOpts{Context: ctx}, new(big.Int).SetUint64(timestamp))
+	if err != nil {
+		return DriverConfig{}, errors.Errorf("failed to read the config of %s: %w", driver.Addr, err)
+	}
+	return config, nil
+}
+
+func (r *ContractReader) VotingPowersAt(ctx context.Context, provider CrossChainAddress, timestamp uint64) ([]OperatorVotingPower, error) {
+	chainCaller, err := r.caller(provider)
+	if err != nil {
+		return nil, err
+	}
+	caller, err := bindings.NewVotingPowerProviderCaller(provider.Addr, chainCaller)
+	if err != nil {
+		return nil, err
+	}
+	votingPowers, err := caller.GetVotingPowersAt(&bind.CallOpts{Context: ctx}, [][]byte{}, new(big.Int).SetUint64(timestamp))
+	if err != nil {
+		return nil, errors.Errorf("failed to read the voting powers of %s: %w", provider.Addr, err)
+	}
+	return votingPowers, nil
+}
+
+func (r *ContractReader) KeysAt(ctx context.Context, keysProvider CrossChainAddress, timestamp uint64) ([]OperatorWithKeys, error) {
+	chainCaller, err := r.caller(keysProvider)
+	if err != nil {
+		return nil, err
+	}
+	caller, err := bindings.NewKeyRegistryCaller(keysProvider.Addr, chainCaller)
+	if err != nil {
+		return nil, err
+	}
+	// GetKeysAt0 is the getKeysAt(uint48) overload, the keys of all operators
+	keys, err := caller.GetKeysAt0(&bind.CallOpts{Context: ctx}, new(big.Int).SetUint64(timestamp))
+	if err != nil {
+		return nil, errors.Errorf("failed to read the keys of %s: %w", keysProvider.Addr, err)
+	}
+	return keys, nil
+}
+
+func (r *ContractReader) driver(driver CrossChainAddress) (*bindings.ValSetDriverCaller, error) {
+	caller, err := r.caller(driver)
+	if err != nil {
+		return nil, err
+	}
+	return bindings.NewValSetDriverCaller(driver.Addr, caller)
+}
+
+func (r *ContractReader) caller(contract CrossChainAddress) (bind.ContractCaller, error) {
+	caller, ok := r.callers[contract.ChainId]
+	if !ok {
+		return nil, errors.Errorf("no caller for chain %d", contract.ChainId)
+	}
+	return caller, nil
+}
@@ -0,0 +1,124 @@
+package genesis
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+
+	"middleware-offchain/pkg/bindings"
+	"middleware-offchain/pkg/proof"
+)
+
+// readerMethod finds the method of a selector in the ABIs of the contracts ContractReader calls.
+func readerMethod(selector []byte) (*abi.Method, error) {
+	for _, metaData := range []*bind.MetaData{bindings.ValSetDriverMetaData, bindings.VotingPowerProviderMetaData, bindings.KeyRegistryMetaData} {
+		parsed, err := metaData.GetAbi()
+		if err != nil {
+			return nil, err
+		}
+		if method, err := parsed.MethodById(selector); err == nil {
+			return method, nil
+		}
+	}
+	return nil, errors.Errorf("unknown selector %x", selector)
+}
+
+// fakeCaller answers contract calls from a fakeReader, ABI-encoded like the contracts would.
+type fakeCaller struct {
+	reader *fakeReader
+	chain  uint64
+}
+
+func (c *fakeCaller) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{0x01}, nil
+}
+
+func (c *fakeCaller) CallContract(ctx context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	method, err := readerMethod(call.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	contract := CrossChainAddress{ChainId: c.chain, Addr: *call.To}
+	timestamp := func() uint64 {
+		return args[len(args)-1].(*big.Int).Uint64()
+	}
+
+	var result any
+	switch method.RawName {
+	case "getCurrentEpoch":
+		epoch, err := c.reader.CurrentEpoch(ctx, contract)
+		if err != nil {
+			return nil, err
+		}
+		result = new(big.Int).SetUint64(epoch)
+	case "getEpochStart":
+		start, err := c.reader.EpochStart(ctx, contract, timestamp())
+		if err != nil {
+			return nil, err
+		}
+		result = new(big.Int).SetUint64(start)
+	case "getConfigAt":
+		if result, err = c.reader.ConfigAt(ctx, contract, timestamp()); err != nil {
+			return nil, err
+		}
+	case "getVotingPowersAt":
+		if result, err = c.reader.VotingPowersAt(ctx, contract, timestamp()); err != nil {
+			return nil, err
+		}
+	case "getKeysAt":
+		if result, err = c.reader.KeysAt(ctx, contract, timestamp()); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.Errorf("unexpected call of %s", method.Name)
+	}
+	return method.Outputs.Pack(result)
+}
+
+// TestContractReader generates a genesis through ABI-encoded calls and checks it matches the one read directly.
+func TestContractReader(t *testing.T) {
+	state := newFakeReader(VerificationTypeBlsBn254ZK)
+	state.config.NumAggregators = big.NewInt(1)
+	state.config.NumCommitters = big.NewInt(1)
+	reader := NewContractReader(map[uint64]bind.ContractCaller{
+		111: &fakeCaller{reader: state, chain: 111},
+		222: &fakeCaller{reader: state, chain: 222},
+	})
+
+	config, err := reader.ConfigAt(context.Background(), driver, state.start)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(config.VotingPowerProviders) != 2 || config.VotingPowerProviders[1] != providerB || config.KeysProvider != providerA ||
+		config.QuorumThresholds[0].QuorumThreshold.Cmp(state.config.QuorumThresholds[0].QuorumThreshold) != 0 ||
+		!bytes.Equal(config.RequiredKeyTags, state.config.RequiredKeyTags) || config.RequiredHeaderKeyTag != blsKeyTag {
+		t.Fatalf("decoded config %+v", config)
+	}
+
+	got, err := Generate(context.Background(), reader, driver, proof.DefaultConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := Generate(context.Background(), state, driver, proof.DefaultConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Header.Hash() != want.Header.Hash() || len(got.ExtraData) != len(want.ExtraData) || got.ExtraData[1] != want.ExtraData[1] {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+
+	if _, err := Generate(context.Background(), reader, CrossChainAddress{ChainId: 333, Addr: driver.Addr}, proof.DefaultConfig()); err == nil {
+		t.Fatal("read a driver on a chain without caller")
+	}
+}
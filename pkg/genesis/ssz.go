@@ -0,0 +1,120 @@
+package genesis
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Tree heights of ValSetVerifier, the SSZ limits of the lists and the padded field counts of the containers.
+const (
+	validatorsListTreeHeight = 20
+	validatorTreeHeight      = 3
+	keyListTreeHeight        = 7
+	vaultListTreeHeight      = 10
+	keyTreeHeight            = 1
+	vaultTreeHeight          = 2
+)
+
+// zeroHashes[i] is the root of a tree of height i with zero leaves.
+var zeroHashes = func() []common.Hash {
+	hashes := make([]common.Hash, validatorsListTreeHeight+1)
+	for i := 1; i < len(hashes); i++ {
+		hashes[i] = hashPair(hashes[i-1], hashes[i-1])
+	}
+	return hashes
+}()
+
+func hashPair(left, right common.Hash) common.Hash {
+	return sha256.Sum256(append(left.Bytes(), right.Bytes()...))
+}
+
+// merkleize returns the root of a tree of the given height over leaves, padded with zero leaves.
+func merkleize(leaves []common.Hash, height int) common.Hash {
+	if len(leaves) == 0 {
+		return zeroHashes[height]
+	}
+	layer := leaves
+	for depth := range height {
+		if len(layer)%2 == 1 {
+			layer = append(layer, zeroHashes[depth])
+		}
+		next := make([]common.Hash, len(layer)/2)
+		for i := range next {
+			next[i] = hashPair(layer[2*i], layer[2*i+1])
+		}
+		layer = next
+	}
+	return layer[0]
+}
+
+// mixInLength returns the root of an SSZ list: its elements root mixed with the little-endian length.
+func mixInLength(root common.Hash, length int) common.Hash {
+	var lengthLeaf common.Hash
+	binary.LittleEndian.PutUint64(lengthLeaf[:], uint64(length))
+	return hashPair(root, lengthLeaf)
+}
+
+// leftAligned returns b as a leaf, padded with zeros on the right.
+func leftAligned(b []byte) common.Hash {
+	var leaf common.Hash
+	copy(leaf[:], b)
+	return leaf
+}
+
+// votingPowerLeaf encodes a voting power the way ValSetVerifier expects: its big-endian bytes without leading
+// zeros, left aligned. 100_000 is 0x0186a0 followed by zeros.
+func votingPowerLeaf(votingPower *big.Int) common.Hash {
+	return leftAligned(votingPower.Bytes())
+}
+
+// ValidatorSetRoot returns the SSZ root of ValSetVerifier.ValidatorSet over validators, the header's
+// validatorsSszMRoot. The set has the validators list as its only field, so its root is the list root.
+func ValidatorSetRoot(validators []Validator) common.Hash {
+	roots := make([]common.Hash, len(validators))
+	for i := range validators {
+		roots[i] = validators[i].root()
+	}
+	return mixInLength(merkleize(roots, validatorsListTreeHeight), len(validators))
+}
+
+func (v *Validator) root() common.Hash {
+	keyRoots := make([]common.Hash, len(v.Keys))
+	for i, key := range v.Keys {
+		keyRoots[i] = keyRoot(key.Tag, crypto.Keccak256Hash(key.Payload))
+	}
+	return v.rootWithKeys(keyRoots)
+}
+
+func keyRoot(tag uint8, payloadHash common.Hash) common.Hash {
+	return merkleize([]common.Hash{leftAligned([]byte{tag}), payloadHash}, keyTreeHeight)
+}
+
+// rootWithKeys returns the validator root for the roots of its keys.
+func (v *Validator) rootWithKeys(keyRoots []common.Hash) common.Hash {
+	vaultRoots := make([]common.Hash, len(v.Vaults))
+	for i, vault := range v.Vaults {
+		var chainID [8]byte
+		binary.LittleEndian.PutUint64(chainID[:], vault.ChainID)
+		vaultRoots[i] = merkleize([]common.Hash{
+			leftAligned(chainID[:]),
+			leftAligned(vault.Vault.Bytes()),
+			votingPowerLeaf(vault.VotingPower),
+		}, vaultTreeHeight)
+	}
+
+	var isActive byte
+	if v.IsActive {
+		isActive = 1
+	}
+	return merkleize([]common.Hash{
+		leftAligned(v.Operator.Bytes()),
+		votingPowerLeaf(v.VotingPower),
+		leftAligned([]byte{isActive}),
+		mixInLength(merkleize(keyRoots, keyListTreeHeight), len(keyRoots)),
+		mixInLength(merkleize(vaultRoots, vaultListTreeHeight), len(vaultRoots)),
+	}, validatorTreeHeight)
+}
@@ -0,0 +1,59 @@
+package genesis
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// TestValidatorRoot checks the SSZ encoding against the validator of ValSetVerifier.t.sol, which gives the hash
+// of its key payload only.
+func TestValidatorRoot(t *testing.T) {
+	validator := Validator{
+		Operator:    common.HexToAddress("0x12f69d5c9ac2f14265fbb1196324efb3b63a8170"),
+		VotingPower: big.NewInt(100_000),
+		IsActive:    true,
+		Vaults: []Vault{{
+			ChainID:     31337,
+			Vault:       common.HexToAddress("0x1a05591693D4C70e5980dEAa1AD9A73b43F95670"),
+			VotingPower: big.NewInt(100_000),
+		}},
+	}
+	key := keyRoot(15, common.HexToHash("0x07f1063c1c69798bd34c3cb06174d886b142b7840035b516d8f40c73a3eed745"))
+	if want := common.HexToHash("0x2550233714c0e0c9aca9668d020ed3333079256bfff7dc78cbd85eeaa213a0ca"); key != want {
+		t.Fatalf("got key root %s, want %s", key, want)
+	}
+	if got, want := validator.rootWithKeys([]common.Hash{key}), common.HexToHash("0xc25d22a1fb9429b489654db1907bbe717d85c462ad2a9b17e59e53c3faac19fa"); got != want {
+		t.Fatalf("got validator root %s, want %s", got, want)
+	}
+
+	validator.Keys = []Key{{Tag: 15, Payload: []byte("payload")}}
+	if validator.root() != validator.rootWithKeys([]common.Hash{keyRoot(15, crypto.Keccak256Hash([]byte("payload")))}) {
+		t.Fatal("key payloads are not hashed into the validator root")
+	}
+}
+
+func TestValidatorSetRoot(t *testing.T) {
+	if got, want := ValidatorSetRoot(nil), mixInLength(zeroHashes[validatorsListTreeHeight], 0); got != want {
+		t.Fatalf("got empty set root %s, want %s", got, want)
+	}
+
+	validators := []Validator{
+		{Operator: common.HexToAddress("0x01"), VotingPower: big.NewInt(1), IsActive: true},
+		{Operator: common.HexToAddress("0x02"), VotingPower: big.NewInt(2)},
+		{Operator: common.HexToAddress("0x03"), VotingPower: big.NewInt(3)},
+	}
+	// three validators fill the leftmost subtree of height 2, the rest of the tree is zero
+	root := hashPair(
+		hashPair(validators[0].root(), validators[1].root()),
+		hashPair(validators[2].root(), zeroHashes[0]),
+	)
+	for height := 2; height < validatorsListTreeHeight; height++ {
+		root = hashPair(root, zeroHashes[height])
+	}
+	if got, want := ValidatorSetRoot(validators), mixInLength(root, 3); got != want {
+		t.Fatalf("got set root %s, want %s", got, want)
+	}
+}
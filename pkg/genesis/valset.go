@@ -0,0 +1,211 @@
+package genesis
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+
+	"middleware-offchain/pkg/proof"
+)
+
+// Key types of KeyTags: the type is the high nibble of a key tag.
+const (
+	KeyTypeBlsBn254       = 0
+	KeyTypeEcdsaSecp256k1 = 1
+)
+
+// Validator mirrors ValSetVerifier.Validator. Keys keep their payloads; the SSZ tree holds their keccak256.
+type Validator struct {
+	Operator    common.Address
+	VotingPower *big.Int
+	IsActive    bool
+	Keys        []Key
+	Vaults      []Vault
+}
+
+// Vault mirrors ValSetVerifier.Vault.
+type Vault struct {
+	ChainID     uint64
+	Vault       common.Address
+	VotingPower *big.Int
+}
+
+// ProviderVotingPowers are the voting powers a voting power provider reported.
+type ProviderVotingPowers struct {
+	Provider     CrossChainAddress
+	VotingPowers []OperatorVotingPower
+}
+
+// DeriveValidators builds the validator set from the voting powers of every provider and the keys of the keys
+// provider:
+//
+//   - every operator with voting power is a validator, its voting power the sum of its vaults on every chain
+//   - going down by voting power, ties by operator address, validators with at least
+//     MinInclusionVotingPower and a key become active until MaxValidatorsCount are
+//   - the voting power of active validators is capped to MaxVotingPower
+//
+// Zero limits are unset. Validators are ordered by operator address, their keys by tag and their vaults by chain
+// and address.
+func DeriveValidators(config DriverConfig, votingPowers []ProviderVotingPowers, keys []OperatorWithKeys) []Validator {
+	byOperator := make(map[common.Address]*Validator)
+	var validators []*Validator
+	for _, provider := range votingPowers {
+		for _, operator := range provider.VotingPowers {
+			validator, ok := byOperator[operator.Operator]
+			if !ok {
+				validator = &Validator{Operator: operator.Operator, VotingPower: new(big.Int)}
+				byOperator[operator.Operator] = validator
+				validators = append(validators, validator)
+			}
+			for _, vault := range operator.Vaults {
+				validator.VotingPower.Add(validator.VotingPower, vault.Value)
+				validator.Vaults = append(validator.Vaults, Vault{
+					ChainID:     provider.Provider.ChainId,
+					Vault:       vault.Vault,
+					VotingPower: new(big.Int).Set(vault.Value),
+				})
+			}
+		}
+	}
+	for _, operator := range keys {
+		if validator, ok := byOperator[operator.Operator]; ok {
+			validator.Keys = append(validator.Keys, operator.Keys...)
+		}
+	}
+
+	sort.SliceStable(validators, func(i, j int) bool {
+		if c := validators[i].VotingPower.Cmp(validators[j].VotingPower); c != 0 {
+			return c > 0
+		}
+		return bytes.Compare(validators[i].Operator.Bytes(), validators[j].Operator.Bytes()) < 0
+	})
+	var nbActive int64
+	for _, validator := range validators {
+		if isSet(config.MinInclusionVotingPower) && validator.VotingPower.Cmp(config.MinInclusionVotingPower) < 0 {
+			break
+		}
+		if isSet(config.MaxValidatorsCount) && nbActive >= config.MaxValidatorsCount.Int64() {
+			break
+		}
+		if len(validator.Keys) == 0 {
+			continue
+		}
+		validator.IsActive = true
+		nbActive++
+		if isSet(config.MaxVotingPower) && validator.VotingPower.Cmp(config.MaxVotingPower) > 0 {
+			validator.VotingPower.Set(config.MaxVotingPower)
+		}
+	}
+
+	result := make([]Validator, len(validators))
+	for i, validator := range validators {
+		sort.SliceStable(validator.Keys, func(i, j int) bool {
+			return validator.Keys[i].Tag < validator.Keys[j].Tag
+		})
+		sort.SliceStable(validator.Vaults, func(i, j int) bool {
+			if validator.Vaults[i].ChainID != validator.Vaults[j].ChainID {
+				return validator.Vaults[i].ChainID < validator.Vaults[j].ChainID
+			}
+			return bytes.Compare(validator.Vaults[i].Vault.Bytes(), validator.Vaults[j].Vault.Bytes()) < 0
+		})
+		result[i] = *validator
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return bytes.Compare(result[i].Operator.Bytes(), result[j].Operator.Bytes()) < 0
+	})
+	return result
+}
+
+func isSet(limit *big.Int) bool {
+	return limit != nil && limit.Sign() != 0
+}
+
+// Key returns the payload of the validator's key with tag.
+func (v *Validator) Key(tag uint8) ([]byte, bool) {
+	for _, key := range v.Keys {
+		if key.Tag == tag {
+			return key.Payload, true
+		}
+	}
+	return nil, false
+}
+
+// TotalVotingPower returns the voting power of the active validators.
+func TotalVotingPower(validators []Validator) *big.Int {
+	total := new(big.Int)
+	for i := range validators {
+		if validators[i].IsActive {
+			total.Add(total, validators[i].VotingPower)
+		}
+	}
+	return total
+}
+
+// ProofValset returns the active validators with a BLS BN254 key with tag as the valset the circuits hash and
+// prove over, sorted by key in the order of version, see proof.SortValset.
+func ProofValset(validators []Validator, tag uint8, version proof.CircuitVersion) ([]proof.ValidatorData, error) {
+	if tag>>4 != KeyTypeBlsBn254 {
+		return nil, errors.Errorf("key tag %d is not a BLS BN254 key tag", tag)
+	}
+	var valset []proof.ValidatorData
+	for i := range validators {
+		if !validators[i].IsActive {
+			continue
+		}
+		payload, ok := validators[i].Key(tag)
+		if !ok {
+			continue
+		}
+		key, err := ParseBlsKey(payload)
+		if err != nil {
+			return nil, errors.Errorf("invalid key of %s: %w", validators[i].Operator, err)
+		}
+		valset = append(valset, proof.ValidatorData{
+			Key:         key,
+			VotingPower: new(big.Int).Set(validators[i].VotingPower),
+		})
+	}
+	proof.SortValset(version, valset)
+	return valset, nil
+}
+
+// ParseBlsKey decodes a KeyRegistry BLS BN254 payload, abi.encode(G1Point), and checks the point is a valid,
+// non-zero public key.
+func ParseBlsKey(payload []byte) (bn254.G1Affine, error) {
+	var key bn254.G1Affine
+	if len(payload) != 64 {
+		return key, errors.Errorf("BLS key payload has %d bytes, want 64", len(payload))
+	}
+	if err := key.X.SetBytesCanonical(payload[:32]); err != nil {
+		return key, errors.Errorf("invalid X: %w", err)
+	}
+	if err := key.Y.SetBytesCanonical(payload[32:]); err != nil {
+		return key, errors.Errorf("invalid Y: %w", err)
+	}
+	if key.IsInfinity() || !key.IsOnCurve() || !key.IsInSubGroup() {
+		return key, errors.Errorf("key is not a point of G1")
+	}
+	return key, nil
+}
+
+// BlsKeyPayload encodes key as a KeyRegistry BLS BN254 payload.
+func BlsKeyPayload(key bn254.G1Affine) []byte {
+	x, y := key.X.Bytes(), key.Y.Bytes()
+	return append(x[:], y[:]...)
+}
+
+// BlsKeyG2Payload encodes a G2 key as abi.encode(BN254.G2Point), which puts the imaginary part of each coordinate
+// first: X.A1, X.A0, Y.A1, Y.A0.
+func BlsKeyG2Payload(key bn254.G2Affine) []byte {
+	payload := make([]byte, 0, 128)
+	for _, e := range []fp.Element{key.X.A1, key.X.A0, key.Y.A1, key.Y.A0} {
+		b := e.Bytes()
+		payload = append(payload, b[:]...)
+	}
+	return payload
+}
@@ -0,0 +1,144 @@
+// Package keyregistry builds the arguments of KeyRegistry.setKey: an operator proves it owns a key by signing
+// the EIP-712 KeyOwnership message with it. BLS BN254 and ECDSA secp256k1 keys are supported, and Verify checks
+// a registration offline the way the registry does.
+package keyregistry
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-errors/errors"
+
+	"middleware-offchain/pkg/bindings"
+	"middleware-offchain/pkg/bls"
+	"middleware-offchain/pkg/genesis"
+	"middleware-offchain/pkg/proof"
+)
+
+var (
+	domainTypehash       = crypto.Keccak256Hash([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"))
+	keyOwnershipTypehash = crypto.Keccak256Hash([]byte("KeyOwnership(address operator,bytes key)"))
+	typedDataPrefix      = []byte("\x19\x01")
+)
+
+// Domain is the EIP-712 domain of a KeyRegistry, from its eip712Domain.
+type Domain struct {
+	Name              string
+	Version           string
+	ChainID           *big.Int
+	VerifyingContract common.Address
+}
+
+// ReadDomain reads the EIP-712 domain of a registry.
+func ReadDomain(opts *bind.CallOpts, registry *bindings.KeyRegistryCaller) (Domain, error) {
+	domain, err := registry.Eip712Domain(opts)
+	if err != nil {
+		return Domain{}, errors.Errorf("failed to read the EIP-712 domain: %w", err)
+	}
+	return Domain{Name: domain.Name, Version: domain.Version, ChainID: domain.ChainId, VerifyingContract: domain.VerifyingContract}, nil
+}
+
+// OwnershipDigest returns the digest the key of a registration signs:
+//
+//	hashTypedDataV4(keccak256(abi.encode(KEY_OWNERSHIP_TYPEHASH, operator, keccak256(key))))
+func OwnershipDigest(domain Domain, operator common.Address, key []byte) common.Hash {
+	structHash := crypto.Keccak256Hash(
+		keyOwnershipTypehash.Bytes(),
+		common.BytesToHash(operator.Bytes()).Bytes(),
+		crypto.Keccak256(key),
+	)
+	domainSeparator := crypto.Keccak256Hash(
+		domainTypehash.Bytes(),
+		crypto.Keccak256([]byte(domain.Name)),
+		crypto.Keccak256([]byte(domain.Version)),
+		common.BigToHash(domain.ChainID).Bytes(),
+		common.BytesToHash(domain.VerifyingContract.Bytes()).Bytes(),
+	)
+	return crypto.Keccak256Hash(typedDataPrefix, domainSeparator.Bytes(), structHash.Bytes())
+}
+
+// Registration is the arguments of KeyRegistry.setKey, sent by Operator.
+type Registration struct {
+	Operator  common.Address
+	Tag       uint8
+	Key       []byte
+	Signature []byte
+	ExtraData []byte
+}
+
+// NewBlsRegistration returns the registration of the key of signer under tag, a BLS BN254 key tag:
+//
+//   - key is abi.encode(G1Point) of the public key
+//   - signature is abi.encode(G1Point) of the signature of hashToG1(digest)
+//   - extraData is abi.encode(G2Point) of the public key in G2
+func NewBlsRegistration(signer bls.Signer, domain Domain, operator common.Address, tag uint8) (Registration, error) {
+	if tag>>4 != genesis.KeyTypeBlsBn254 {
+		return Registration{}, errors.Errorf("key tag %d is not a BLS BN254 tag", tag)
+	}
+	key := genesis.BlsKeyPayload(signer.PublicKeyG1())
+	message, _ := proof.HashToG1(OwnershipDigest(domain, operator, key))
+	signature, err := signer.Sign(message)
+	if err != nil {
+		return Registration{}, errors.Errorf("failed to sign the key ownership: %w", err)
+	}
+	return Registration{
+		Operator:  operator,
+		Tag:       tag,
+		Key:       key,
+		Signature: genesis.BlsKeyPayload(signature),
+		ExtraData: genesis.BlsKeyG2Payload(signer.PublicKeyG2()),
+	}, nil
+}
+
+// EcdsaSigner signs digests with a secp256k1 key, e.g. a local key or a remote signer.
+type EcdsaSigner interface {
+	Address() common.Address
+	// SignDigest returns the 65-byte signature r || s || v of digest, with v 0 or 1 as crypto.Sign returns it,
+	// or 27 or 28.
+	SignDigest(digest common.Hash) ([]byte, error)
+}
+
+// NewEcdsaRegistration returns the registration of the key of signer under tag, a secp256k1 key tag. key is
+// abi.encode(address), signature is r || s || v with v 27 or 28, and extraData is empty.
+func NewEcdsaRegistration(signer EcdsaSigner, domain Domain, operator common.Address, tag uint8) (Registration, error) {
+	if tag>>4 != genesis.KeyTypeEcdsaSecp256k1 {
+		return Registration{}, errors.Errorf("key tag %d is not an ECDSA secp256k1 tag", tag)
+	}
+	key := common.BytesToHash(signer.Address().Bytes()).Bytes()
+	signature, err := signer.SignDigest(OwnershipDigest(domain, operator, key))
+	if err != nil {
+		return Registration{}, errors.Errorf("failed to sign the key ownership: %w", err)
+	}
+	if len(signature) != 65 {
+		return Registration{}, errors.Errorf("signature has %d bytes, want 65", len(signature))
+	}
+	signature = common.CopyBytes(signature)
+	if signature[64] < 27 {
+		signature[64] += 27
+	}
+	return Registration{Operator: operator, Tag: tag, Key: key, Signature: signature}, nil
+}
+
+// PrivateKeySigner is an EcdsaSigner of a key held in memory.
+type PrivateKeySigner struct {
+	Key *ecdsa.PrivateKey
+}
+
+var _ EcdsaSigner = PrivateKeySigner{}
+
+// Address returns the address of the key.
+func (s PrivateKeySigner) Address() common.Address {
+	return crypto.PubkeyToAddress(s.Key.PublicKey)
+}
+
+// SignDigest signs digest with the key.
+func (s PrivateKeySigner) SignDigest(digest common.Hash) ([]byte, error) {
+	signature, err := crypto.Sign(digest.Bytes(), s.Key)
+	if err != nil {
+		return nil, errors.Errorf("failed to sign: %w", err)
+	}
+	return signature, nil
+}
@@ -0,0 +1,173 @@
+package keyregistry
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"middleware-offchain/pkg/bls"
+	"middleware-offchain/pkg/proof"
+)
+
+const (
+	blsKeyTag   = 15 // type 0, identifier 15
+	ecdsaKeyTag = 16 // type 1, identifier 0
+)
+
+var (
+	domain   = Domain{Name: "KeyRegistry", Version: "1", ChainID: big.NewInt(111), VerifyingContract: common.HexToAddress("0x4e")}
+	operator = common.HexToAddress("0x0a")
+)
+
+func TestOwnershipDigest(t *testing.T) {
+	key := common.FromHex("0x1234")
+	digest, _, err := apitypes.TypedDataAndHash(apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"KeyOwnership": {{Name: "operator", Type: "address"}, {Name: "key", Type: "bytes"}},
+		},
+		PrimaryType: "KeyOwnership",
+		Domain: apitypes.TypedDataDomain{
+			Name:              domain.Name,
+			Version:           domain.Version,
+			ChainId:           (*math.HexOrDecimal256)(domain.ChainID),
+			VerifyingContract: domain.VerifyingContract.Hex(),
+		},
+		Message: apitypes.TypedDataMessage{"operator": operator.Hex(), "key": hexutil.Encode(key)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := OwnershipDigest(domain, operator, key); got != common.BytesToHash(digest) {
+		t.Fatalf("got digest %s, want %x", got, digest)
+	}
+}
+
+func TestBlsRegistration(t *testing.T) {
+	sk, err := bls.NewSecretKey(big.NewInt(1_000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg, err := NewBlsRegistration(sk, domain, operator, blsKeyTag)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reg.Key) != 64 || len(reg.Signature) != 64 || len(reg.ExtraData) != 128 {
+		t.Fatalf("got %d-byte key, %d-byte signature and %d-byte extra data", len(reg.Key), len(reg.Signature), len(reg.ExtraData))
+	}
+	if err := Verify(reg, domain); err != nil {
+		t.Fatal(err)
+	}
+
+	// the registration proves possession of the key
+	pop, err := reg.PossessionProof(domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	validator := proof.ValidatorData{Key: sk.PublicKeyG1(), VotingPower: big.NewInt(1)}
+	report := proof.ValidateKeys(proof.ProveInput{ValidatorData: []proof.ValidatorData{validator}, SignersAggKeyG2: sk.PublicKeyG2()}, proof.KeyValidationOptions{
+		ProofsOfPossession: map[bn254.G1Affine]proof.PossessionProof{validator.Key: pop},
+		RequirePossession:  true,
+	})
+	if err := report.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewBlsRegistration(sk, domain, operator, ecdsaKeyTag); err == nil {
+		t.Fatal("registered a BLS key under an ECDSA tag")
+	}
+	other, err := bls.NewSecretKey(big.NewInt(1_001))
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherReg, err := NewBlsRegistration(other, domain, operator, blsKeyTag)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the key tag isn't signed, but the domain is
+	anyTag := reg
+	anyTag.Tag = blsKeyTag - 1
+	if err := Verify(anyTag, domain); err != nil {
+		t.Fatal(err)
+	}
+	otherDomain := domain
+	otherDomain.ChainID = big.NewInt(112)
+	if err := Verify(reg, otherDomain); err == nil {
+		t.Fatal("verified under another domain")
+	}
+
+	for name, tamper := range map[string]func(reg *Registration){
+		"other operator": func(reg *Registration) { reg.Operator = common.HexToAddress("0x0b") },
+		"other G2 key":   func(reg *Registration) { reg.ExtraData = otherReg.ExtraData },
+		"other signer":   func(reg *Registration) { reg.Signature = otherReg.Signature },
+		"zero key":       func(reg *Registration) { reg.Key = make([]byte, 64) },
+		"key off curve":  func(reg *Registration) { reg.Key[63] ^= 1 },
+		"short key":      func(reg *Registration) { reg.Key = reg.Key[:32] },
+		"short G2 key":   func(reg *Registration) { reg.ExtraData = reg.ExtraData[:64] },
+	} {
+		t.Run(name, func(t *testing.T) {
+			tampered := Registration{
+				Operator:  reg.Operator,
+				Tag:       reg.Tag,
+				Key:       common.CopyBytes(reg.Key),
+				Signature: common.CopyBytes(reg.Signature),
+				ExtraData: common.CopyBytes(reg.ExtraData),
+			}
+			tamper(&tampered)
+			if err := Verify(tampered, domain); err == nil {
+				t.Fatal("verified a tampered registration")
+			}
+		})
+	}
+}
+
+func TestEcdsaRegistration(t *testing.T) {
+	key, err := crypto.ToECDSA(common.LeftPadBytes(big.NewInt(1_000).Bytes(), 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer := PrivateKeySigner{Key: key}
+	reg, err := NewEcdsaRegistration(signer, domain, operator, ecdsaKeyTag)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if common.BytesToAddress(reg.Key) != signer.Address() || len(reg.Key) != 32 || len(reg.ExtraData) != 0 {
+		t.Fatal("key is not abi.encode(address)")
+	}
+	if v := reg.Signature[64]; v != 27 && v != 28 {
+		t.Fatalf("got v %d", v)
+	}
+	if err := Verify(reg, domain); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewEcdsaRegistration(signer, domain, operator, blsKeyTag); err == nil {
+		t.Fatal("registered an ECDSA key under a BLS tag")
+	}
+	other := reg
+	other.Operator = common.HexToAddress("0x0b")
+	if err := Verify(other, domain); err == nil {
+		t.Fatal("verified the signature for another operator")
+	}
+
+	// the malleated signature (r, n - s, v ^ 1) recovers the same key, but OpenZeppelin rejects high s
+	malleated := common.CopyBytes(reg.Signature)
+	s := new(big.Int).Sub(crypto.S256().Params().N, new(big.Int).SetBytes(malleated[32:64]))
+	s.FillBytes(malleated[32:64])
+	malleated[64] = 27 + 28 - malleated[64]
+	if err := Verify(Registration{Operator: operator, Tag: ecdsaKeyTag, Key: reg.Key, Signature: malleated}, domain); err == nil {
+		t.Fatal("verified a high-s signature")
+	}
+}
@@ -0,0 +1,169 @@
+package keyregistry
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-errors/errors"
+
+	"middleware-offchain/pkg/genesis"
+	"middleware-offchain/pkg/proof"
+)
+
+// secp256k1HalfN is the highest s OpenZeppelin's ECDSA accepts, which rejects malleable signatures.
+var secp256k1HalfN = new(big.Int).Rsh(crypto.S256().Params().N, 1)
+
+// Verify checks reg the way KeyRegistry._setKey does before storing the key: _verifyKey of the signature of
+// OwnershipDigest by the key. It returns an error where setKey would revert.
+func Verify(reg Registration, domain Domain) error {
+	digest := OwnershipDigest(domain, reg.Operator, reg.Key)
+	switch reg.Tag >> 4 {
+	case genesis.KeyTypeBlsBn254:
+		return verifyBls(reg, digest)
+	case genesis.KeyTypeEcdsaSecp256k1:
+		return verifyEcdsa(reg, digest)
+	default:
+		return errors.Errorf("invalid key type %d", reg.Tag>>4)
+	}
+}
+
+// verifyBls mirrors SigBlsBn254.verify, which checks the signature and binds the G2 key to the key with
+//
+//	e(signature + alpha·key, -g2) · e(hashToG1(digest) + alpha·g1, keyG2) = 1
+//
+// where alpha = keccak256(signature || key || keyG2 || hashToG1(digest)) mod r.
+func verifyBls(reg Registration, digest common.Hash) error {
+	// KeyBlsBn254.fromBytes only takes the canonical encoding of a point on the curve
+	if len(reg.Key) != 64 {
+		return errors.Errorf("BLS key has %d bytes, want 64", len(reg.Key))
+	}
+	key, err := parseG1(reg.Key)
+	if err != nil {
+		return errors.Errorf("invalid BLS key: %w", err)
+	}
+	if key.IsInfinity() {
+		return errors.Errorf("invalid key signature: the key is zero")
+	}
+	if len(reg.Signature) < 64 {
+		return errors.Errorf("signature has %d bytes, want 64", len(reg.Signature))
+	}
+	signature, err := parseG1(reg.Signature[:64])
+	if err != nil {
+		return errors.Errorf("invalid signature: %w", err)
+	}
+	if len(reg.ExtraData) < 128 {
+		return errors.Errorf("extra data has %d bytes, want a 128-byte G2 key", len(reg.ExtraData))
+	}
+	keyG2, err := parseG2(reg.ExtraData[:128])
+	if err != nil {
+		return errors.Errorf("invalid G2 key: %w", err)
+	}
+
+	message, _ := proof.HashToG1(digest)
+	messageX, messageY := message.X.Bytes(), message.Y.Bytes()
+	alphaHash := crypto.Keccak256(reg.Signature[:64], reg.Key, reg.ExtraData[:128], messageX[:], messageY[:])
+	alpha := new(big.Int).Mod(new(big.Int).SetBytes(alphaHash), fr.Modulus())
+
+	_, _, g1, g2 := bn254.Generators()
+	var lhs, rhs, term bn254.G1Affine
+	term.ScalarMultiplication(&key, alpha)
+	lhs.Add(&signature, &term)
+	term.ScalarMultiplication(&g1, alpha)
+	rhs.Add(&message, &term)
+	var negG2 bn254.G2Affine
+	negG2.Neg(&g2)
+	ok, err := bn254.PairingCheck([]bn254.G1Affine{lhs, rhs}, []bn254.G2Affine{negG2, keyG2})
+	if err != nil {
+		return errors.Errorf("failed to check the pairing: %w", err)
+	}
+	if !ok {
+		return errors.Errorf("invalid key signature")
+	}
+	return nil
+}
+
+// verifyEcdsa mirrors SigEcdsaSecp256k1.verify, which recovers the signer with OpenZeppelin's ECDSA.tryRecover.
+func verifyEcdsa(reg Registration, digest common.Hash) error {
+	// KeyEcdsaSecp256k1.fromBytes only takes abi.encode(address)
+	if len(reg.Key) != 32 || !bytes.Equal(reg.Key[:12], make([]byte, 12)) {
+		return errors.Errorf("ECDSA key is not an encoded address")
+	}
+	address := common.BytesToAddress(reg.Key)
+	if address == (common.Address{}) {
+		return errors.Errorf("invalid key signature: the key is zero")
+	}
+	if len(reg.Signature) != 65 {
+		return errors.Errorf("invalid key signature: signature has %d bytes, want 65", len(reg.Signature))
+	}
+	if new(big.Int).SetBytes(reg.Signature[32:64]).Cmp(secp256k1HalfN) > 0 {
+		return errors.Errorf("invalid key signature: s is in the upper half of the order")
+	}
+	v := reg.Signature[64]
+	if v != 27 && v != 28 {
+		return errors.Errorf("invalid key signature: v is %d, want 27 or 28", v)
+	}
+	signature := common.CopyBytes(reg.Signature)
+	signature[64] -= 27
+	publicKey, err := crypto.SigToPub(digest.Bytes(), signature)
+	if err != nil {
+		return errors.Errorf("invalid key signature: %w", err)
+	}
+	if crypto.PubkeyToAddress(*publicKey) != address {
+		return errors.Errorf("invalid key signature: signed by %s", crypto.PubkeyToAddress(*publicKey))
+	}
+	return nil
+}
+
+// parseG1 decodes abi.encode(G1Point), with (0, 0) the point at infinity, as the precompiles do.
+func parseG1(payload []byte) (bn254.G1Affine, error) {
+	var point bn254.G1Affine
+	if err := point.X.SetBytesCanonical(payload[:32]); err != nil {
+		return point, errors.Errorf("invalid X: %w", err)
+	}
+	if err := point.Y.SetBytesCanonical(payload[32:64]); err != nil {
+		return point, errors.Errorf("invalid Y: %w", err)
+	}
+	if !point.IsOnCurve() {
+		return point, errors.Errorf("point is not on the curve")
+	}
+	return point, nil
+}
+
+// parseG2 decodes abi.encode(G2Point), see genesis.BlsKeyG2Payload, and checks the point is in G2 as the pairing
+// precompile does.
+func parseG2(payload []byte) (bn254.G2Affine, error) {
+	var point bn254.G2Affine
+	for i, e := range []*fp.Element{&point.X.A1, &point.X.A0, &point.Y.A1, &point.Y.A0} {
+		if err := e.SetBytesCanonical(payload[32*i : 32*(i+1)]); err != nil {
+			return point, errors.Errorf("invalid coordinate %d: %w", i, err)
+		}
+	}
+	if !point.IsOnCurve() || !point.IsInSubGroup() {
+		return point, errors.Errorf("point is not in G2")
+	}
+	return point, nil
+}
+
+// PossessionProof returns the ownership signature of a BLS registration as a proof of possession of its key for
+// proof.ValidateKeys. The ownership message commits to the key, so the signature proves the operator holds it.
+func (reg Registration) PossessionProof(domain Domain) (proof.PossessionProof, error) {
+	if reg.Tag>>4 != genesis.KeyTypeBlsBn254 {
+		return proof.PossessionProof{}, errors.Errorf("key tag %d is not a BLS BN254 tag", reg.Tag)
+	}
+	if err := Verify(reg, domain); err != nil {
+		return proof.PossessionProof{}, err
+	}
+	// Verify checked the lengths and points
+	signature, _ := parseG1(reg.Signature[:64])
+	keyG2, _ := parseG2(reg.ExtraData[:128])
+	return proof.PossessionProof{
+		MessageHash: OwnershipDigest(domain, reg.Operator, reg.Key),
+		Signature:   signature,
+		KeyG2:       keyG2,
+	}, nil
+}
@@ -0,0 +1,250 @@
+package proof
+
+import (
+	"crypto/rand"
+	"io"
+	"math/big"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/go-errors/errors"
+)
+
+// SignatureShare is the signature of a message by one validator.
+type SignatureShare struct {
+	Key       bn254.G1Affine // identifies the validator in the valset
+	KeyG2     bn254.G2Affine // the same key in G2, the circuits aggregate it
+	Signature bn254.G1Affine
+}
+
+// Aggregate is the aggregated signature of the valid shares of an Aggregator.
+type Aggregate struct {
+	// Valset is the valset of the Aggregator, with IsNonSigner set for the validators without a valid share and
+	// KeyG2 set for the others. Fillers are left as they are.
+	Valset                []ValidatorData
+	MessageG1             bn254.G1Affine
+	Signature             bn254.G1Affine
+	SignersAggKeyG1       bn254.G1Affine
+	SignersAggKeyG2       bn254.G2Affine
+	SignersAggVotingPower *big.Int
+}
+
+// ProveInput returns the ProveInput of the aggregate. The verification parameters and the message hash of
+// hash-to-G1 circuits are left to the caller.
+func (a Aggregate) ProveInput() ProveInput {
+	return ProveInput{
+		ValidatorData:   a.Valset,
+		MessageG1:       a.MessageG1,
+		Signature:       a.Signature,
+		SignersAggKeyG2: a.SignersAggKeyG2,
+	}
+}
+
+// Aggregator aggregates the signature shares of the validators of a valset for one message. Shares are only
+// checked to be well-formed when added. Aggregate verifies them all at once with a randomized batch check, and
+// isolates the invalid ones by bisection if it fails.
+type Aggregator struct {
+	mu       sync.Mutex
+	valset   []ValidatorData
+	message  bn254.G1Affine
+	index    map[bn254.G1Affine]int
+	pending  map[int]SignatureShare
+	verified map[int]SignatureShare
+}
+
+// NewAggregator returns an Aggregator of signatures of messageG1 by the validators of valset. Shares are matched to
+// validators by key, so it rejects a valset with a key twice.
+func NewAggregator(valset []ValidatorData, messageG1 bn254.G1Affine) (*Aggregator, error) {
+	index := make(map[bn254.G1Affine]int, len(valset))
+	for i := range valset {
+		if valset[i].Key.IsInfinity() {
+			continue
+		}
+		if j, ok := index[valset[i].Key]; ok {
+			return nil, errors.Errorf("validators %d and %d have the same key", j, i)
+		}
+		index[valset[i].Key] = i
+	}
+	return &Aggregator{
+		valset:   valset,
+		message:  messageG1,
+		index:    index,
+		pending:  make(map[int]SignatureShare),
+		verified: make(map[int]SignatureShare),
+	}, nil
+}
+
+// Add records a share. It rejects shares of keys outside the valset, of validators who already have one, and
+// malformed points. A validator whose share turns out invalid can add another one.
+func (a *Aggregator) Add(share SignatureShare) error {
+	i, ok := a.index[share.Key]
+	if !ok {
+		return errors.Errorf("key %s is not in the valset", share.Key.String())
+	}
+	if !share.Signature.IsOnCurve() || share.Signature.IsInfinity() {
+		return errors.Errorf("signature of validator %d is not a point of G1", i)
+	}
+	if !share.KeyG2.IsOnCurve() || !share.KeyG2.IsInSubGroup() || share.KeyG2.IsInfinity() {
+		return errors.Errorf("G2 key of validator %d is not a point of G2", i)
+	}
+	if keyG2 := a.valset[i].KeyG2; !keyG2.IsInfinity() && !keyG2.Equal(&share.KeyG2) {
+		return errors.Errorf("G2 key of validator %d doesn't match the valset", i)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if _, ok := a.pending[i]; ok {
+		return errors.Errorf("validator %d already has a share", i)
+	}
+	if _, ok := a.verified[i]; ok {
+		return errors.Errorf("validator %d already has a share", i)
+	}
+	a.pending[i] = share
+	return nil
+}
+
+// Verify verifies the pending shares, keeps the valid ones and drops and returns the invalid ones.
+func (a *Aggregator) Verify() ([]SignatureShare, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	indices := make([]int, 0, len(a.pending))
+	for i := range a.valset {
+		if _, ok := a.pending[i]; ok {
+			indices = append(indices, i)
+		}
+	}
+	valid, invalid, err := a.isolate(indices)
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range valid {
+		a.verified[i] = a.pending[i]
+	}
+	culprits := make([]SignatureShare, len(invalid))
+	for j, i := range invalid {
+		culprits[j] = a.pending[i]
+	}
+	clear(a.pending)
+	return culprits, nil
+}
+
+// Aggregate verifies the pending shares and aggregates the valid ones. It also returns the invalid shares, which
+// are dropped.
+func (a *Aggregator) Aggregate() (Aggregate, []SignatureShare, error) {
+	culprits, err := a.Verify()
+	if err != nil {
+		return Aggregate{}, nil, err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if len(a.verified) == 0 {
+		return Aggregate{}, culprits, errors.Errorf("no valid share")
+	}
+	aggregate := Aggregate{
+		Valset:                make([]ValidatorData, len(a.valset)),
+		MessageG1:             a.message,
+		SignersAggVotingPower: new(big.Int),
+	}
+	for i := range a.valset {
+		aggregate.Valset[i] = a.valset[i]
+		if a.valset[i].Key.IsInfinity() {
+			continue
+		}
+		share, ok := a.verified[i]
+		aggregate.Valset[i].IsNonSigner = !ok
+		if !ok {
+			continue
+		}
+		aggregate.Valset[i].KeyG2 = share.KeyG2
+		aggregate.Signature.Add(&aggregate.Signature, &share.Signature)
+		aggregate.SignersAggKeyG1.Add(&aggregate.SignersAggKeyG1, &share.Key)
+		aggregate.SignersAggKeyG2.Add(&aggregate.SignersAggKeyG2, &share.KeyG2)
+		aggregate.SignersAggVotingPower.Add(aggregate.SignersAggVotingPower, a.valset[i].VotingPower)
+	}
+	return aggregate, culprits, nil
+}
+
+// isolate splits the pending shares of indices into the valid and the invalid ones, bisecting failed batches.
+func (a *Aggregator) isolate(indices []int) (valid, invalid []int, err error) {
+	if len(indices) == 0 {
+		return nil, nil, nil
+	}
+	ok, err := a.batchVerify(indices)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ok {
+		return indices, nil, nil
+	}
+	if len(indices) == 1 {
+		return nil, indices, nil
+	}
+	mid := len(indices) / 2
+	validLeft, invalidLeft, err := a.isolate(indices[:mid])
+	if err != nil {
+		return nil, nil, err
+	}
+	validRight, invalidRight, err := a.isolate(indices[mid:])
+	if err != nil {
+		return nil, nil, err
+	}
+	return append(validLeft, validRight...), append(invalidLeft, invalidRight...), nil
+}
+
+// batchVerify checks every share of indices satisfies
+//
+//	e(signature, g2) = e(message, keyG2) and e(key, g2) = e(g1, keyG2)
+//
+// the second binding the G2 key to the validator's key, with random 128-bit weights r and s per share:
+//
+//	e(Σ r·signature + s·key, -g2) · Π e(r·message + s·g1, keyG2) = 1
+//
+// An invalid share passes with probability about 2^-128.
+func (a *Aggregator) batchVerify(indices []int) (bool, error) {
+	_, _, g1, g2 := bn254.Generators()
+	var negG2 bn254.G2Affine
+	negG2.Neg(&g2)
+
+	p := make([]bn254.G1Affine, 1, len(indices)+1)
+	q := make([]bn254.G2Affine, 1, len(indices)+1)
+	q[0] = negG2
+	var lhs, term bn254.G1Affine
+	for _, i := range indices {
+		share := a.pending[i]
+		r, err := randomWeight()
+		if err != nil {
+			return false, err
+		}
+		s, err := randomWeight()
+		if err != nil {
+			return false, err
+		}
+
+		term.ScalarMultiplication(&share.Signature, r)
+		lhs.Add(&lhs, &term)
+		term.ScalarMultiplication(&share.Key, s)
+		lhs.Add(&lhs, &term)
+
+		var rhs bn254.G1Affine
+		rhs.ScalarMultiplication(&a.message, r)
+		term.ScalarMultiplication(&g1, s)
+		rhs.Add(&rhs, &term)
+		p = append(p, rhs)
+		q = append(q, share.KeyG2)
+	}
+	p[0] = lhs
+	return bn254.PairingCheck(p, q)
+}
+
+// weightSource supplies the random batch weights, swapped out by tests.
+var weightSource io.Reader = rand.Reader
+
+func randomWeight() (*big.Int, error) {
+	var buf [16]byte
+	if _, err := io.ReadFull(weightSource, buf[:]); err != nil {
+		return nil, errors.Errorf("failed to draw a batch weight: %w", err)
+	}
+	return new(big.Int).SetBytes(buf[:]), nil
+}
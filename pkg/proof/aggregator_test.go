@@ -0,0 +1,160 @@
+package proof
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+)
+
+func signShare(message bn254.G1Affine, validator ValidatorData) SignatureShare {
+	signer := testSigner(validator.Key)
+	signature, err := signer.Sign(message)
+	if err != nil {
+		panic(err)
+	}
+	return SignatureShare{Key: signer.PublicKeyG1(), KeyG2: signer.PublicKeyG2(), Signature: signature}
+}
+
+func TestAggregator(t *testing.T) {
+	valset := genValset(6, nil)
+	message := testMessageG1(t)
+	// like the KeyRegistry's, the valset has no G2 keys, the shares bring them
+	withoutKeysG2 := make([]ValidatorData, len(valset))
+	for i := range valset {
+		withoutKeysG2[i] = valset[i]
+		withoutKeysG2[i].KeyG2 = bn254.G2Affine{}
+	}
+	aggregator, err := NewAggregator(withoutKeysG2, message)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// validator 3 signed another point, validator 4 sent validator 5's G2 key and signature
+	var otherMessage bn254.G1Affine
+	otherMessage.Double(&message)
+	badSignature := signShare(otherMessage, valset[3])
+	badKey := signShare(message, valset[5])
+	badKey.Key = valset[4].Key
+	for _, share := range []SignatureShare{signShare(message, valset[0]), signShare(message, valset[2]), badSignature, badKey} {
+		if err := aggregator.Add(share); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	aggregate, culprits, err := aggregator.Aggregate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(culprits) != 2 || !culprits[0].Key.Equal(&valset[3].Key) || !culprits[1].Key.Equal(&valset[4].Key) {
+		t.Fatalf("got %d culprits", len(culprits))
+	}
+	for i, validator := range aggregate.Valset {
+		if signer := i == 0 || i == 2; validator.IsNonSigner == signer {
+			t.Fatalf("validator %d is a non-signer: %t", i, validator.IsNonSigner)
+		}
+	}
+	if aggregate.SignersAggVotingPower.Int64() != 200 {
+		t.Fatalf("got signers voting power %s", aggregate.SignersAggVotingPower)
+	}
+
+	// the aggregate matches the signature of the signers with their secret keys
+	signature, aggKeyG2, aggKeyG1 := getAggSignature(message, &aggregate.Valset)
+	if !aggregate.Signature.Equal(signature) || !aggregate.SignersAggKeyG2.Equal(aggKeyG2) || !aggregate.SignersAggKeyG1.Equal(aggKeyG1) {
+		t.Fatal("the aggregate doesn't match the signers' signatures")
+	}
+
+	// validator 3 resubmits a valid share
+	if err := aggregator.Add(signShare(message, valset[3])); err != nil {
+		t.Fatal(err)
+	}
+	if err := aggregator.Add(signShare(message, valset[0])); err == nil {
+		t.Fatal("added a second share of a validator")
+	}
+	aggregate, culprits, err = aggregator.Aggregate()
+	if err != nil || len(culprits) != 0 || aggregate.Valset[3].IsNonSigner {
+		t.Fatalf("got %d culprits, %v", len(culprits), err)
+	}
+}
+
+func TestAggregatorProveInput(t *testing.T) {
+	valset := padValset(genValset(3, nil), testCircuitSize)
+	message := testMessageG1(t)
+	aggregator, err := NewAggregator(valset, message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, i := range []int{0, 2} {
+		if err := aggregator.Add(signShare(message, valset[i])); err != nil {
+			t.Fatal(err)
+		}
+	}
+	aggregate, _, err := aggregator.Aggregate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// fillers are non-signers
+	proveInput := aggregate.ProveInput()
+	proveInput.QuorumThreshold = big.NewInt(100)
+	assignment := &Circuit{Version: CircuitVersionV2}
+	setCircuitData(assignment, proveInput)
+	assertSolved(t, newCircuit(Config{CircuitVersion: CircuitVersionV2}, testCircuitSize), assignment)
+}
+
+func TestAggregatorRejects(t *testing.T) {
+	valset := genValset(2, nil)
+	message := testMessageG1(t)
+	aggregator, err := NewAggregator(valset, message)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unknown := signShare(message, genValset(3, nil)[2])
+	if err := aggregator.Add(unknown); err == nil {
+		t.Fatal("added a share of a key outside the valset")
+	}
+	offCurve := signShare(message, valset[0])
+	offCurve.Signature.Y.SetOne()
+	if err := aggregator.Add(offCurve); err == nil {
+		t.Fatal("added a signature off the curve")
+	}
+	mismatching := signShare(message, valset[0])
+	mismatching.KeyG2 = valset[1].KeyG2
+	if err := aggregator.Add(mismatching); err == nil {
+		t.Fatal("added a share with another G2 key than the valset's")
+	}
+	if _, _, err := aggregator.Aggregate(); err == nil {
+		t.Fatal("aggregated no share")
+	}
+
+	// a duplicate key could only ever sign for one of its validators
+	duplicate := append(genValset(2, nil), valset[0])
+	if _, err := NewAggregator(duplicate, message); err == nil {
+		t.Fatal("accepted a valset with a duplicate key")
+	}
+}
+
+func BenchmarkAggregatorVerify(b *testing.B) {
+	valset := genValset(100, nil)
+	_, _, g1, _ := bn254.Generators()
+	shares := make([]SignatureShare, len(valset))
+	for i := range valset {
+		shares[i] = signShare(g1, valset[i])
+	}
+	b.ResetTimer()
+	for range b.N {
+		aggregator, err := NewAggregator(valset, g1)
+		if err != nil {
+			b.Fatal(err)
+		}
+		for _, share := range shares {
+			if err := aggregator.Add(share); err != nil {
+				b.Fatal(err)
+			}
+		}
+		if culprits, err := aggregator.Verify(); err != nil || len(culprits) != 0 {
+			b.Fatalf("got %d culprits, %v", len(culprits), err)
+		}
+	}
+}
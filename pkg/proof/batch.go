@@ -0,0 +1,556 @@
+package proof
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"os"
+	"slices"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark/backend"
+	"github.com/consensys/gnark/backend/groth16"
+	"github.com/consensys/gnark/backend/solidity"
+	"github.com/consensys/gnark/constraint"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-errors/errors"
+)
+
+// BatchValidatorCircuit is a validator of a BatchCircuit. Its signer flags are per signer set.
+type BatchValidatorCircuit struct {
+	Key         sw_bn254.G1Affine
+	VotingPower frontend.Variable
+}
+
+// BatchSignerSetCircuit is a set of validators of a BatchCircuit that signed one or more of its messages.
+type BatchSignerSetCircuit struct {
+	SignersAggKeyG2 sw_bn254.G2Affine
+	IsNonSigner     []frontend.Variable // per validator
+}
+
+// BatchMessageCircuit is one of the messages a BatchCircuit proves an aggregated signature over.
+type BatchMessageCircuit struct {
+	Message   sw_bn254.G1Affine // virtually public
+	SignerSet frontend.Variable // index of the signer set that signed it
+}
+
+// BatchCircuit proves aggregated signatures over several messages of the same valset, each by one of its signer
+// sets. InputHash is
+//
+//	keccak(valsetHash || signersVotingPower_0 || message_0 || ... || signersVotingPower_k-1 || message_k-1)
+//
+// with the top three bits cleared, signersVotingPower_m being the voting power of the signer set of message m.
+// The valset is hashed and checked once, and every signer set aggregates its keys once for all the messages it
+// signed. The signatures of all messages are checked as one BLS aggregate signature in a multi pairing check with
+// a Miller loop per signer set, so a further message only costs its input hash bytes, its curve check and an
+// addition per signer set. Version selects the valset hash and must be CircuitVersionV3 or later, whose
+// constraints apply.
+type BatchCircuit struct {
+	Version CircuitVersion `gnark:"-"`
+
+	InputHash     frontend.Variable       `gnark:",public"`
+	AggSignature  sw_bn254.G1Affine       `gnark:",private"` // sum of the aggregated signatures of all messages
+	Messages      []BatchMessageCircuit   `gnark:",private"`
+	SignerSets    []BatchSignerSetCircuit `gnark:",private"`
+	ValidatorData []BatchValidatorCircuit `gnark:",private"`
+}
+
+// newBatchCircuit returns a placeholder for nbMessages messages signed by nbSignerSets subsets of nbValidators
+// validators.
+func newBatchCircuit(version CircuitVersion, nbValidators, nbMessages, nbSignerSets int) *BatchCircuit {
+	circuit := &BatchCircuit{
+		Version:       version,
+		Messages:      make([]BatchMessageCircuit, nbMessages),
+		SignerSets:    make([]BatchSignerSetCircuit, nbSignerSets),
+		ValidatorData: make([]BatchValidatorCircuit, nbValidators),
+	}
+	for j := range circuit.SignerSets {
+		circuit.SignerSets[j].IsNonSigner = make([]frontend.Variable, nbValidators)
+	}
+	return circuit
+}
+
+// Define declares the circuit's constraints
+func (circuit *BatchCircuit) Define(api frontend.API) error {
+	if circuit.Version < CircuitVersionV3 {
+		return errors.Errorf("batch proofs require circuit version %d or later", CircuitVersionV3)
+	}
+	apis, err := newCircuitApis(api, true)
+	if err != nil {
+		return err
+	}
+	apis.valsetHash = circuit.Version.ValsetHash()
+	if apis.valsetHash == ValsetHashPoseidon2 {
+		apis.poseidon2 = newPoseidon2Hasher(api)
+	}
+
+	// --------------------------------------- Prove ValSet consistency ---------------------------------------
+	acc := valsetAccumulator{valsetHash: 0, prevIsFiller: 0}
+	validators := make([]ValidatorDataCircuit, len(circuit.ValidatorData))
+	isFiller := make([]frontend.Variable, len(circuit.ValidatorData))
+	for i := range circuit.ValidatorData {
+		validators[i] = ValidatorDataCircuit{
+			Key:         circuit.ValidatorData[i].Key,
+			VotingPower: circuit.ValidatorData[i].VotingPower,
+			IsNonSigner: 0,
+		}
+		isFiller[i] = apis.absorb(&acc, &validators[i], circuit.Version)
+	}
+
+	// --------------------------------------- Aggregate signer sets ---------------------------------------
+	signersAggKeys := make([]*sw_bn254.G1Affine, len(circuit.SignerSets))
+	signersAggVotingPowers := make([]frontend.Variable, len(circuit.SignerSets))
+	for j := range circuit.SignerSets {
+		signerSet := &circuit.SignerSets[j]
+		signers := valsetAccumulator{
+			signersAggVotingPower: 0,
+			signersAggKey: &sw_bn254.G1Affine{
+				X: emulated.ValueOf[emulated.BN254Fp](0),
+				Y: emulated.ValueOf[emulated.BN254Fp](0),
+			},
+			signersAggKeyIsZero: 1,
+		}
+		for i := range validators {
+			api.AssertIsBoolean(signerSet.IsNonSigner[i])
+			if err := apis.addSigner(&signers, &validators[i], circuit.Version, isFiller[i], signerSet.IsNonSigner[i]); err != nil {
+				return err
+			}
+		}
+		signersAggKeys[j] = signers.signersAggKey
+		signersAggVotingPowers[j] = signers.signersAggVotingPower
+	}
+
+	// isSignedBy[m][j] tells whether message m was signed by signer set j, exactly one of them is set
+	isSignedBy := make([][]frontend.Variable, len(circuit.Messages))
+	for m := range circuit.Messages {
+		isSignedBy[m] = make([]frontend.Variable, len(circuit.SignerSets))
+		nbSignerSets := frontend.Variable(0)
+		for j := range circuit.SignerSets {
+			isSignedBy[m][j] = api.IsZero(api.Sub(circuit.Messages[m].SignerSet, j))
+			nbSignerSets = api.Add(nbSignerSets, isSignedBy[m][j])
+		}
+		api.AssertIsEqual(nbSignerSets, 1)
+	}
+
+	// --------------------------------------- Prove Input consistency ---------------------------------------
+	if err := apis.writeWords(apis.keccak256, word{acc.valsetHash, 256}); err != nil {
+		return err
+	}
+	for m := range circuit.Messages {
+		signersAggVotingPower := frontend.Variable(0)
+		for j := range circuit.SignerSets {
+			signersAggVotingPower = api.Add(signersAggVotingPower, api.Mul(isSignedBy[m][j], signersAggVotingPowers[j]))
+		}
+		if err := apis.writeWords(apis.keccak256, word{signersAggVotingPower, signersVotingPowerBits}); err != nil {
+			return err
+		}
+		apis.keccak256.Write(keyToBytes(apis.u64, &circuit.Messages[m].Message))
+	}
+	apis.assertMaskedInputHash(true, apis.keccak256.Sum(), circuit.InputHash)
+
+	// --------------------------------------- Verify Signatures ---------------------------------------
+	// the messages of every signer set add up, so that a further message costs no scalar multiplication
+	infinity := &sw_bn254.G1Affine{
+		X: emulated.ValueOf[emulated.BN254Fp](0),
+		Y: emulated.ValueOf[emulated.BN254Fp](0),
+	}
+	messageSums := make([]*sw_bn254.G1Affine, len(circuit.SignerSets))
+	for j := range messageSums {
+		messageSums[j] = infinity
+	}
+	for m := range circuit.Messages {
+		message := &circuit.Messages[m].Message
+		apis.pairing.AssertIsOnG1(message)
+		for j := range circuit.SignerSets {
+			messageSums[j] = apis.curve.AddUnified(messageSums[j], apis.curve.Select(isSignedBy[m][j], message, infinity))
+		}
+	}
+	apis.pairing.AssertIsOnG1(&circuit.AggSignature)
+
+	apis.mimc.Reset()
+	hashAffineG1(apis.mimc, &circuit.AggSignature)
+	for j := range circuit.SignerSets {
+		apis.pairing.AssertIsOnG2(&circuit.SignerSets[j].SignersAggKeyG2)
+		hashAffineG1(apis.mimc, messageSums[j])
+		hashAffineG1(apis.mimc, signersAggKeys[j])
+		hashAffineG2(apis.mimc, &circuit.SignerSets[j].SignersAggKeyG2)
+	}
+	gamma, err := digestToScalar(api, apis.fieldFr, apis.mimc.Sum())
+	if err != nil {
+		return err
+	}
+
+	// the aggregated signature has to satisfy e(aggSignature, -G2) * prod_j e(messageSum_j, keyG2_j) = 1, the BLS
+	// aggregate check, and the keys of signer set j have to match, e(keyG1_j, -G2) * e(G1, keyG2_j) = 1.
+	// Weighted with 1 and gamma^(1+j), all of them fold into a pairing with -G2 and one with the G2 key of every
+	// signer set:
+	// e(aggSignature + sum_j gamma^(1+j) * keyG1_j, -G2) * prod_j e(messageSum_j + gamma^(1+j) * G1, keyG2_j) = 1
+	_, _, g1Gen, g2Gen := bn254.Generators()
+	g1GenAffine := sw_bn254.NewG1Affine(g1Gen)
+	negG2GenAffine := sw_bn254.NewG2AffineFixed(*g2Gen.Neg(&g2Gen))
+
+	// the G1 side of the -G2 pairing in Horner form
+	sigSum := signersAggKeys[len(signersAggKeys)-1]
+	for j := len(signersAggKeys) - 2; j >= 0; j-- {
+		sigSum = apis.curve.AddUnified(signersAggKeys[j], apis.curve.ScalarMul(sigSum, gamma))
+	}
+	sigSum = apis.curve.AddUnified(&circuit.AggSignature, apis.curve.ScalarMul(sigSum, gamma))
+
+	keyTerms := make([]*sw_bn254.G1Affine, len(circuit.SignerSets))
+	keysG2 := make([]*sw_bn254.G2Affine, len(circuit.SignerSets))
+	power := gamma
+	for j := range circuit.SignerSets {
+		if j > 0 {
+			power = apis.fieldFr.Mul(power, gamma)
+		}
+		keyTerms[j] = apis.curve.AddUnified(messageSums[j], apis.curve.ScalarMul(&g1GenAffine, power))
+		keysG2[j] = &circuit.SignerSets[j].SignersAggKeyG2
+	}
+
+	// PairingCheck of gnark v0.12 hints the residue witness from the first two pairs only, so the multi Miller
+	// loop output is checked directly
+	millerLoop, err := apis.pairing.MillerLoop(
+		append([]*sw_bn254.G1Affine{sigSum}, keyTerms...),
+		append([]*sw_bn254.G2Affine{&negG2GenAffine}, keysG2...),
+	)
+	if err != nil {
+		return err
+	}
+	apis.pairing.AssertFinalExponentiationIsOne(millerLoop)
+	return nil
+}
+
+// BatchInputHash computes the public input of a BatchCircuit.
+func BatchInputHash(valsetHash []byte, signersAggVotingPowers []*big.Int, messages []bn254.G1Affine) *big.Int {
+	preimage := bytes.Clone(valsetHash)
+	for m := range messages {
+		messageBytes := messages[m].RawBytes()
+		preimage = append(preimage, common.BigToHash(signersAggVotingPowers[m]).Bytes()...)
+		preimage = append(preimage, messageBytes[:]...)
+	}
+	return maskInputHash(crypto.Keccak256(preimage))
+}
+
+// batchInput is a normalized batch with the distinct signer sets of its messages in valset order.
+type batchInput struct {
+	valset                 []ValidatorData
+	valsetHash             []byte
+	messages               []bn254.G1Affine
+	aggSignatures          []bn254.G1Affine
+	signerSets             []int    // signer set of every message
+	isNonSigner            [][]bool // per signer set
+	signersAggKeysG2       []bn254.G2Affine
+	signersAggVotingPowers []*big.Int // per message
+}
+
+// newBatchInput normalizes valset as version does and aligns the signer sets with it. signerSets[m][i] reports
+// whether valset[i] signed messages[m]. Messages with the same signers share a signer set, and the sets are
+// padded to nbSignerSets with copies of the first one. It runs the checks of prepareWitness, with the keys
+// validated against every signer set.
+func newBatchInput(version CircuitVersion, valset []ValidatorData, messages, aggSignatures []bn254.G1Affine, signerSets [][]bool, nbSignerSets int) (batchInput, error) {
+	if len(aggSignatures) != len(messages) || len(signerSets) != len(messages) {
+		return batchInput{}, errors.Errorf("got %d messages, %d signatures and %d signer sets", len(messages), len(aggSignatures), len(signerSets))
+	}
+	// every version hashes voting powers as 32-byte words
+	for i := range valset {
+		if !isWord(valset[i].VotingPower) {
+			return batchInput{}, errors.Errorf("voting power of validator %d is not a uint256", i)
+		}
+		if valset[i].VotingPower.BitLen() > MaxVotingPowerBits {
+			return batchInput{}, errors.Errorf("voting power of validator %d exceeds %d bits", i, MaxVotingPowerBits)
+		}
+	}
+	normalizedValset, err := NormalizeValset(version, valset)
+	if err != nil {
+		return batchInput{}, err
+	}
+	position := make(map[[bn254.SizeOfG1AffineUncompressed]byte]int, len(valset))
+	for i := range valset {
+		position[normalizedValset[i].Key.RawBytes()] = i
+	}
+
+	in := batchInput{
+		valset:                 normalizedValset,
+		valsetHash:             HashValsetWith(version.ValsetHash(), normalizedValset),
+		messages:               messages,
+		aggSignatures:          aggSignatures,
+		signerSets:             make([]int, len(messages)),
+		signersAggVotingPowers: make([]*big.Int, len(messages)),
+	}
+	signers := slices.Clone(valset)
+	for m := range messages {
+		if len(signerSets[m]) != len(valset) {
+			return batchInput{}, errors.Errorf("signer set %d has %d entries, valset has %d validators", m, len(signerSets[m]), len(valset))
+		}
+		isNonSigner := make([]bool, len(normalizedValset))
+		for i := range valset {
+			isNonSigner[position[valset[i].Key.RawBytes()]] = !signerSets[m][i]
+		}
+		if j := slices.IndexFunc(in.isNonSigner, func(flags []bool) bool { return slices.Equal(flags, isNonSigner) }); j >= 0 {
+			in.signerSets[m] = j
+			in.signersAggVotingPowers[m] = in.signersAggVotingPowers[slices.Index(in.signerSets, j)]
+			continue
+		}
+		if len(in.isNonSigner) == nbSignerSets {
+			return batchInput{}, errors.Errorf("messages have more than %d distinct signer sets", nbSignerSets)
+		}
+
+		var signersAggKeyG2 bn254.G2Affine
+		signersAggVotingPower := new(big.Int)
+		for i := range valset {
+			signers[i].IsNonSigner = !signerSets[m][i]
+			if signerSets[m][i] {
+				signersAggKeyG2.Add(&signersAggKeyG2, &valset[i].KeyG2)
+				signersAggVotingPower.Add(signersAggVotingPower, valset[i].VotingPower)
+			}
+		}
+		if signersAggVotingPower.Sign() == 0 {
+			return batchInput{}, errors.Errorf("message %d has no signers", m)
+		}
+		// the circuit trusts the keys, a bad one would only fail the proof
+		if err := ValidateKeys(ProveInput{ValidatorData: signers, SignersAggKeyG2: signersAggKeyG2}, KeyValidationOptions{}).Err(); err != nil {
+			return batchInput{}, errors.Errorf("message %d: %w", m, err)
+		}
+		in.signerSets[m] = len(in.isNonSigner)
+		in.signersAggVotingPowers[m] = signersAggVotingPower
+		in.isNonSigner = append(in.isNonSigner, isNonSigner)
+		in.signersAggKeysG2 = append(in.signersAggKeysG2, signersAggKeyG2)
+	}
+	for len(in.isNonSigner) < nbSignerSets {
+		in.isNonSigner = append(in.isNonSigner, in.isNonSigner[0])
+		in.signersAggKeysG2 = append(in.signersAggKeysG2, in.signersAggKeysG2[0])
+	}
+	return in, nil
+}
+
+func setBatchCircuitData(circuit *BatchCircuit, in batchInput) {
+	circuit.ValidatorData = make([]BatchValidatorCircuit, len(in.valset))
+	for i := range in.valset {
+		circuit.ValidatorData[i] = BatchValidatorCircuit{
+			Key:         sw_bn254.NewG1Affine(in.valset[i].Key),
+			VotingPower: in.valset[i].VotingPower,
+		}
+	}
+
+	circuit.SignerSets = make([]BatchSignerSetCircuit, len(in.isNonSigner))
+	for j := range in.isNonSigner {
+		circuit.SignerSets[j] = BatchSignerSetCircuit{
+			SignersAggKeyG2: sw_bn254.NewG2Affine(in.signersAggKeysG2[j]),
+			IsNonSigner:     make([]frontend.Variable, len(in.valset)),
+		}
+		for i := range in.valset {
+			circuit.SignerSets[j].IsNonSigner[i] = 0
+			if in.isNonSigner[j][i] {
+				circuit.SignerSets[j].IsNonSigner[i] = 1
+			}
+		}
+	}
+
+	var aggSignature bn254.G1Affine
+	circuit.Messages = make([]BatchMessageCircuit, len(in.messages))
+	for m := range in.messages {
+		aggSignature.Add(&aggSignature, &in.aggSignatures[m])
+		circuit.Messages[m] = BatchMessageCircuit{
+			Message:   sw_bn254.NewG1Affine(in.messages[m]),
+			SignerSet: in.signerSets[m],
+		}
+	}
+	circuit.AggSignature = sw_bn254.NewG1Affine(aggSignature)
+
+	circuit.InputHash = BatchInputHash(in.valsetHash, in.signersAggVotingPowers, in.messages)
+}
+
+// BatchProofData is a batch proof in the ProofData layout, followed by the signers' voting power of every message.
+type BatchProofData struct {
+	Proof                  []byte
+	Commitments            []byte
+	CommitmentPok          []byte
+	SignersAggVotingPowers []*big.Int
+}
+
+func (p BatchProofData) Marshal() []byte {
+	var result bytes.Buffer
+
+	result.Write(p.Proof)
+	result.Write(p.Commitments)
+	result.Write(p.CommitmentPok)
+	for _, votingPower := range p.SignersAggVotingPowers {
+		result.Write(common.BigToHash(votingPower).Bytes())
+	}
+
+	return result.Bytes()
+}
+
+// BatchProver proves nbMessages aggregated signatures over one valset, by at most nbSignerSets distinct signer
+// sets, with a BatchCircuit per tier.
+type BatchProver struct {
+	version      CircuitVersion
+	nbMessages   int
+	nbSignerSets int
+	cs           map[Tier]constraint.ConstraintSystem
+	pk           map[Tier]groth16.ProvingKey
+	vk           map[Tier]groth16.VerifyingKey
+}
+
+// batchDir returns the directory holding the batch artifacts of version.
+func batchDir(version CircuitVersion) string {
+	return artifactsDir(version) + "/batch"
+}
+
+// NewBatchProver loads or generates the batch artifacts for nbMessages messages by at most nbSignerSets distinct
+// signer sets of every tier in MaxValidators.
+// cfg selects the circuit version, CircuitVersionV3 or later, with the Groth16 backend; the other modes have no
+// batch circuit.
+func NewBatchProver(cfg Config, nbMessages, nbSignerSets int) (*BatchProver, error) {
+	if nbMessages < 1 {
+		return nil, errors.Errorf("invalid number of messages %d", nbMessages)
+	}
+	if nbSignerSets < 1 || nbSignerSets > nbMessages {
+		return nil, errors.Errorf("invalid number of signer sets %d for %d messages", nbSignerSets, nbMessages)
+	}
+	if cfg.CircuitVersion < CircuitVersionV3 {
+		return nil, errors.Errorf("batch proofs require circuit version %d or later", CircuitVersionV3)
+	}
+	if cfg.Backend != BackendGroth16 || cfg.SplitInputHash || cfg.HashToG1 || cfg.SignerBitmap || cfg.CompressedKeys {
+		return nil, errors.Errorf("batch proofs support the %s backend without circuit modes only", BackendGroth16)
+	}
+	p := BatchProver{
+		version:      cfg.CircuitVersion,
+		nbMessages:   nbMessages,
+		nbSignerSets: nbSignerSets,
+		cs:           make(map[Tier]constraint.ConstraintSystem),
+		pk:           make(map[Tier]groth16.ProvingKey),
+		vk:           make(map[Tier]groth16.VerifyingKey),
+	}
+
+	slog.Warn("Batch ZK prover initialization started (might take a while)", "version", cfg.CircuitVersion, "messages", nbMessages, "signerSets", nbSignerSets)
+	for _, size := range MaxValidators {
+		tier := Tier(size)
+		cs, pk, vk, err := loadOrInitBatch(cfg.CircuitVersion, tier, nbMessages, nbSignerSets)
+		if err != nil {
+			return nil, err
+		}
+		p.cs[tier] = cs
+		p.pk[tier] = pk
+		p.vk[tier] = vk
+	}
+	slog.Info("Batch ZK prover initialization is done")
+
+	return &p, nil
+}
+
+// ProveBatch generates one proof for the aggregated signatures aggSignatures over messages. signerSets[m][i]
+// reports whether valset[i] signed messages[m]; the IsNonSigner flags of valset are ignored. The messages may have
+// at most the prover's number of distinct signer sets.
+func (p *BatchProver) ProveBatch(valset []ValidatorData, messages, aggSignatures []bn254.G1Affine, signerSets [][]bool) (BatchProofData, error) {
+	if len(messages) != p.nbMessages {
+		return BatchProofData{}, errors.Errorf("got %d messages, prover batches %d", len(messages), p.nbMessages)
+	}
+	tier, err := SelectTier(len(valset))
+	if err != nil {
+		return BatchProofData{}, err
+	}
+	cs, ok := p.cs[tier]
+	if !ok {
+		return BatchProofData{}, errors.Errorf("failed to load cs, vk, pk for tier: %d", tier)
+	}
+
+	in, err := newBatchInput(p.version, valset, messages, aggSignatures, signerSets, p.nbSignerSets)
+	if err != nil {
+		return BatchProofData{}, err
+	}
+
+	assignment := BatchCircuit{Version: p.version}
+	setBatchCircuitData(&assignment, in)
+
+	witness, err := frontend.NewWitness(&assignment, ecc.BN254.ScalarField())
+	if err != nil {
+		return BatchProofData{}, errors.Errorf("failed to create witness: %w", err)
+	}
+	publicWitness, err := witness.Public()
+	if err != nil {
+		return BatchProofData{}, errors.Errorf("failed to get public witness: %w", err)
+	}
+
+	proof, err := groth16.Prove(cs, p.pk[tier], witness, backend.WithProverHashToFieldFunction(sha256.New()))
+	if err != nil {
+		return BatchProofData{}, errors.Errorf("failed to prove: %w", err)
+	}
+	err = groth16.Verify(proof, p.vk[tier], publicWitness, backend.WithVerifierHashToFieldFunction(sha256.New()))
+	if err != nil {
+		return BatchProofData{}, err
+	}
+
+	proofData, err := groth16ProofData(proof, nil)
+	if err != nil {
+		return BatchProofData{}, err
+	}
+	return BatchProofData{
+		Proof:                  proofData.Proof,
+		Commitments:            proofData.Commitments,
+		CommitmentPok:          proofData.CommitmentPok,
+		SignersAggVotingPowers: in.signersAggVotingPowers,
+	}, nil
+}
+
+// Verify checks proofBytes against publicInputHash using the tier selected for totalActiveValidators.
+func (p *BatchProver) Verify(totalActiveValidators int, publicInputHash common.Hash, proofBytes []byte) (bool, error) {
+	tier, err := SelectTier(totalActiveValidators)
+	if err != nil {
+		return false, err
+	}
+	vk, ok := p.vk[tier]
+	if !ok {
+		return false, errors.Errorf("failed to find verification key for tier %d", tier)
+	}
+
+	publicWitness, err := publicInputWitness(publicInputHash, false)
+	if err != nil {
+		return false, err
+	}
+	if err := verifyGroth16(vk, proofBytes, publicWitness); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func loadOrInitBatch(version CircuitVersion, tier Tier, nbMessages, nbSignerSets int) (constraint.ConstraintSystem, groth16.ProvingKey, groth16.VerifyingKey, error) {
+	dir := batchDir(version)
+	suffix := fmt.Sprintf("%dx%dx%d", tier, nbMessages, nbSignerSets)
+	if exists(r1csPathTmp(dir, suffix)) && exists(pkPathTmp(dir, suffix)) && exists(vkPathTmp(dir, suffix)) && exists(solPathTmp(dir, suffix)) {
+		return readGroth16(dir, suffix)
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, nil, nil, err
+	}
+	cs, err := frontend.Compile(bn254.ID.ScalarField(), r1cs.NewBuilder, newBatchCircuit(version, int(tier), nbMessages, nbSignerSets))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	pk, vk, err := groth16.Setup(cs)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if err := writeGroth16(dir, suffix, cs, pk, vk); err != nil {
+		return nil, nil, nil, err
+	}
+
+	f, err := os.Create(solPathTmp(dir, suffix))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	defer f.Close()
+	if err := vk.ExportSolidity(f, solidity.WithHashToFieldFunction(sha256.New())); err != nil {
+		return nil, nil, nil, err
+	}
+	return cs, pk, vk, nil
+}
@@ -0,0 +1,232 @@
+package proof
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/consensys/gnark/test"
+)
+
+const testBatchTier = 10
+
+// testBatchSigners returns the signer sets of a batch of two messages over valset, a permutation of
+// genValset(3, nil): the first message is signed by validators 0 and 2 of genValset(3, nil), the second one by all.
+func testBatchSigners(valset []ValidatorData) [][]bool {
+	keys := genValset(3, nil)
+	signerSets := [][]bool{make([]bool, len(valset)), make([]bool, len(valset))}
+	for i := range valset {
+		signerSets[0][i] = !valset[i].Key.Equal(&keys[1].Key)
+		signerSets[1][i] = true
+	}
+	return signerSets
+}
+
+// testBatchSignatures signs messages with the signers of signerSets.
+func testBatchSignatures(valset []ValidatorData, messages []bn254.G1Affine, signerSets [][]bool) []bn254.G1Affine {
+	aggSignatures := make([]bn254.G1Affine, len(messages))
+	for m := range messages {
+		signers := slices.Clone(valset)
+		for i := range signers {
+			signers[i].IsNonSigner = !signerSets[m][i]
+		}
+		aggSignature, _, _ := getAggSignature(messages[m], &signers)
+		aggSignatures[m] = *aggSignature
+	}
+	return aggSignatures
+}
+
+func testBatchMessages(t *testing.T) []bn254.G1Affine {
+	t.Helper()
+	message, _ := HashToG1(testMessageHash)
+	return []bn254.G1Affine{testMessageG1(t), message}
+}
+
+// newTestBatchInput returns the batch of testBatchSigners over valset, with its two signer sets.
+func newTestBatchInput(t *testing.T, version CircuitVersion, valset []ValidatorData) batchInput {
+	t.Helper()
+	messages := testBatchMessages(t)
+	signerSets := testBatchSigners(valset)
+	in, err := newBatchInput(version, valset, messages, testBatchSignatures(valset, messages, signerSets), signerSets, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return in
+}
+
+func TestBatchCircuitValid(t *testing.T) {
+	in := newTestBatchInput(t, CircuitVersionV3, genValset(3, nil))
+	if in.signersAggVotingPowers[0].Cmp(big.NewInt(200)) != 0 || in.signersAggVotingPowers[1].Cmp(big.NewInt(300)) != 0 {
+		t.Fatalf("unexpected signers voting powers %v", in.signersAggVotingPowers)
+	}
+
+	assignment := &BatchCircuit{Version: CircuitVersionV3}
+	setBatchCircuitData(assignment, in)
+	if err := test.IsSolved(newBatchCircuit(CircuitVersionV3, testBatchTier, 2, 2), assignment, ecc.BN254.ScalarField()); err != nil {
+		t.Fatalf("expected circuit to be solved: %v", err)
+	}
+}
+
+func TestBatchCircuitValidV5(t *testing.T) {
+	valset := genValset(3, nil)
+	in := newTestBatchInput(t, CircuitVersionV5, valset)
+	normalized, err := NormalizeValset(CircuitVersionV5, valset)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(in.valsetHash, HashValsetWith(ValsetHashPoseidon2, normalized)) {
+		t.Fatal("batch input doesn't hash the valset with Poseidon2")
+	}
+
+	assignment := &BatchCircuit{Version: CircuitVersionV5}
+	setBatchCircuitData(assignment, in)
+	if err := test.IsSolved(newBatchCircuit(CircuitVersionV5, testBatchTier, 2, 2), assignment, ecc.BN254.ScalarField()); err != nil {
+		t.Fatalf("expected circuit to be solved: %v", err)
+	}
+}
+
+// TestBatchCircuitWrongSignature signs the first message with the signature of the second one. The circuit only
+// sees the sum of the signatures, so swapping them would still prove both messages signed.
+func TestBatchCircuitWrongSignature(t *testing.T) {
+	in := newTestBatchInput(t, CircuitVersionV3, genValset(3, nil))
+	in.aggSignatures[0] = in.aggSignatures[1]
+
+	assignment := &BatchCircuit{Version: CircuitVersionV3}
+	setBatchCircuitData(assignment, in)
+	if err := test.IsSolved(newBatchCircuit(CircuitVersionV3, testBatchTier, 2, 2), assignment, ecc.BN254.ScalarField()); err == nil {
+		t.Fatal("expected circuit not to be solved")
+	}
+}
+
+func TestBatchCircuitWrongSigners(t *testing.T) {
+	in := newTestBatchInput(t, CircuitVersionV3, genValset(3, nil))
+	// the flags claim all validators are the signer set of the first message, signed by two of them
+	for i := range in.isNonSigner[0] {
+		in.isNonSigner[0][i] = false
+	}
+
+	assignment := &BatchCircuit{Version: CircuitVersionV3}
+	setBatchCircuitData(assignment, in)
+	if err := test.IsSolved(newBatchCircuit(CircuitVersionV3, testBatchTier, 2, 2), assignment, ecc.BN254.ScalarField()); err == nil {
+		t.Fatal("expected circuit not to be solved")
+	}
+}
+
+func TestBatchCircuitWrongVotingPower(t *testing.T) {
+	in := newTestBatchInput(t, CircuitVersionV3, genValset(3, nil))
+	in.signersAggVotingPowers[0] = big.NewInt(300)
+
+	assignment := &BatchCircuit{Version: CircuitVersionV3}
+	setBatchCircuitData(assignment, in)
+	if err := test.IsSolved(newBatchCircuit(CircuitVersionV3, testBatchTier, 2, 2), assignment, ecc.BN254.ScalarField()); err == nil {
+		t.Fatal("expected circuit not to be solved")
+	}
+}
+
+func TestBatchCircuitWrongSignerSet(t *testing.T) {
+	in := newTestBatchInput(t, CircuitVersionV3, genValset(3, nil))
+	// the first message claims the signer set of the second one, and its voting power
+	in.signerSets[0] = 1
+	in.signersAggVotingPowers[0] = in.signersAggVotingPowers[1]
+
+	assignment := &BatchCircuit{Version: CircuitVersionV3}
+	setBatchCircuitData(assignment, in)
+	if err := test.IsSolved(newBatchCircuit(CircuitVersionV3, testBatchTier, 2, 2), assignment, ecc.BN254.ScalarField()); err == nil {
+		t.Fatal("expected circuit not to be solved")
+	}
+}
+
+// TestBatchCircuitSharedSigners proves messages signed by the same validators with a single signer set, and with
+// the unused second set of a larger circuit padded.
+func TestBatchCircuitSharedSigners(t *testing.T) {
+	valset := genValset(3, nil)
+	messages := testBatchMessages(t)
+	signerSets := testBatchSigners(valset)
+	signerSets[0] = signerSets[1]
+	aggSignatures := testBatchSignatures(valset, messages, signerSets)
+
+	for _, nbSignerSets := range []int{1, 2} {
+		in, err := newBatchInput(CircuitVersionV3, valset, messages, aggSignatures, signerSets, nbSignerSets)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if in.signerSets[0] != 0 || in.signerSets[1] != 0 || len(in.isNonSigner) != nbSignerSets {
+			t.Fatalf("expected both messages in the first of %d signer sets, got %v", nbSignerSets, in.signerSets)
+		}
+		assignment := &BatchCircuit{Version: CircuitVersionV3}
+		setBatchCircuitData(assignment, in)
+		if err := test.IsSolved(newBatchCircuit(CircuitVersionV3, testBatchTier, 2, nbSignerSets), assignment, ecc.BN254.ScalarField()); err != nil {
+			t.Fatalf("expected circuit with %d signer sets to be solved: %v", nbSignerSets, err)
+		}
+	}
+
+	if _, err := newBatchInput(CircuitVersionV3, valset, messages, testBatchSignatures(valset, messages, testBatchSigners(valset)), testBatchSigners(valset), 1); err == nil {
+		t.Fatal("expected two distinct signer sets not to fit one")
+	}
+}
+
+func TestBatchInputValsetOrder(t *testing.T) {
+	valset := genValset(3, nil)
+	reversed := slices.Clone(valset)
+	slices.Reverse(reversed)
+
+	var circuits [2]BatchCircuit
+	setBatchCircuitData(&circuits[0], newTestBatchInput(t, CircuitVersionV3, valset))
+	setBatchCircuitData(&circuits[1], newTestBatchInput(t, CircuitVersionV3, reversed))
+	if circuits[0].InputHash.(*big.Int).Cmp(circuits[1].InputHash.(*big.Int)) != 0 {
+		t.Fatal("input hash depends on the valset order")
+	}
+}
+
+func TestBatchInputInvalid(t *testing.T) {
+	messages := testBatchMessages(t)
+	for _, tc := range []struct {
+		name   string
+		modify func(valset []ValidatorData, signerSets [][]bool)
+	}{
+		{"nil voting power", func(valset []ValidatorData, _ [][]bool) { valset[1].VotingPower = nil }},
+		{"negative voting power", func(valset []ValidatorData, _ [][]bool) { valset[1].VotingPower = big.NewInt(-1) }},
+		{"no signers", func(_ []ValidatorData, signerSets [][]bool) { clear(signerSets[1]) }},
+		{"short signer set", func(_ []ValidatorData, signerSets [][]bool) { signerSets[1] = signerSets[1][:2] }},
+		{"filler key", func(valset []ValidatorData, _ [][]bool) { valset[1].Key.SetInfinity() }},
+		{"mismatched G2 key", func(valset []ValidatorData, _ [][]bool) { valset[1].KeyG2 = valset[0].KeyG2 }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			valset := genValset(3, nil)
+			signerSets := testBatchSigners(valset)
+			aggSignatures := testBatchSignatures(valset, messages, signerSets)
+			tc.modify(valset, signerSets)
+			if _, err := newBatchInput(CircuitVersionV3, valset, messages, aggSignatures, signerSets, 2); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+// BenchmarkCompileBatch reports the number of R1CS constraints of batches on the two smallest tiers, named
+// <tier>x<messages>x<signer sets>, e.g.
+//
+//	go test -run '^$' -bench CompileBatch -benchtime 1x ./pkg/proof
+func BenchmarkCompileBatch(b *testing.B) {
+	for _, tc := range []struct{ tier, nbMessages, nbSignerSets int }{
+		{10, 1, 1}, {10, 2, 1}, {10, 4, 1}, {10, 8, 1}, {10, 2, 2}, {10, 4, 2}, {10, 8, 2},
+		{100, 1, 1}, {100, 2, 1}, {100, 4, 1}, {100, 8, 1}, {100, 2, 2}, {100, 4, 2},
+	} {
+		b.Run(fmt.Sprintf("%dx%dx%d", tc.tier, tc.nbMessages, tc.nbSignerSets), func(b *testing.B) {
+			var nbConstraints int
+			for range b.N {
+				cs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, newBatchCircuit(CircuitVersionV3, tc.tier, tc.nbMessages, tc.nbSignerSets))
+				if err != nil {
+					b.Fatal(err)
+				}
+				nbConstraints = cs.GetNbConstraints()
+			}
+			b.ReportMetric(float64(nbConstraints), "constraints")
+		})
+	}
+}
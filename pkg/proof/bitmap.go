@@ -0,0 +1,68 @@
+package proof
+
+import (
+	"github.com/consensys/gnark/frontend"
+	gnarkSha3 "github.com/consensys/gnark/std/hash/sha3"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// SignerBitmap returns the signer bitmap of valset in the order NormalizeValset sorts it into for version and the
+// valset hash commits to. Bit i, (bitmap[i/8] >> (i%8)) & 1, is set if the i-th validator signed. The bitmap
+// covers the whole tier, so filler entries add zero bits up to ceil(tier/8) bytes.
+func SignerBitmap(version CircuitVersion, valset []ValidatorData) ([]byte, error) {
+	normalizedValset, err := NormalizeValset(version, valset)
+	if err != nil {
+		return nil, err
+	}
+	return signerBitmap(normalizedValset), nil
+}
+
+// signerBitmap returns the signer bitmap of a normalized valset.
+func signerBitmap(valset []ValidatorData) []byte {
+	bitmap := make([]byte, (len(valset)+7)/8)
+	for i := range valset {
+		if !valset[i].Key.IsInfinity() && !valset[i].IsNonSigner {
+			bitmap[i/8] |= 1 << (i % 8)
+		}
+	}
+	return bitmap
+}
+
+// SignerBitmapHash returns keccak256(bitmap), the commitment signer-bitmap circuits bind into the input hash.
+func SignerBitmapHash(bitmap []byte) common.Hash {
+	return crypto.Keccak256Hash(bitmap)
+}
+
+// HasSigned reports whether bit index of a signer bitmap is set.
+func HasSigned(bitmap []byte, index int) bool {
+	if index < 0 || index/8 >= len(bitmap) {
+		return false
+	}
+	return bitmap[index/8]>>(index%8)&1 == 1
+}
+
+// signerBitmapHash packs the signer flags of validators into the bitmap layout of SignerBitmap and hashes it.
+// A validator signed if it is neither a filler nor a non-signer, so the bits match the aggregated key.
+func (a *circuitApis) signerBitmapHash(validators []ValidatorDataCircuit, isFiller []frontend.Variable) ([]uints.U8, error) {
+	api := a.api
+	bitmap := make([]uints.U8, (len(validators)+7)/8)
+	for b := range bitmap {
+		packed := frontend.Variable(0)
+		for j := 7; j >= 0; j-- {
+			packed = api.Mul(packed, 2)
+			if i := 8*b + j; i < len(validators) {
+				packed = api.Add(packed, api.Mul(api.Sub(1, isFiller[i]), api.Sub(1, validators[i].IsNonSigner)))
+			}
+		}
+		bitmap[b] = a.u64.ByteValueOf(packed)
+	}
+
+	keccak256, err := gnarkSha3.NewLegacyKeccak256(api)
+	if err != nil {
+		return nil, err
+	}
+	keccak256.Write(bitmap)
+	return keccak256.Sum(), nil
+}
@@ -0,0 +1,44 @@
+package proof
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSignerBitmap(t *testing.T) {
+	valset := genValset(9, []int{4})
+	bitmap, err := SignerBitmap(CircuitVersionV5, valset)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	normalizedValset, err := NormalizeValset(CircuitVersionV5, valset)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bitmap) != (len(normalizedValset)+7)/8 {
+		t.Fatalf("expected %d bytes, got %d", (len(normalizedValset)+7)/8, len(bitmap))
+	}
+	for i := range normalizedValset {
+		signed := !normalizedValset[i].Key.IsInfinity() && !normalizedValset[i].IsNonSigner
+		if HasSigned(bitmap, i) != signed {
+			t.Fatalf("validator %d: expected signed %v", i, signed)
+		}
+	}
+	if HasSigned(bitmap, len(bitmap)*8) {
+		t.Fatal("expected no signer beyond the bitmap")
+	}
+
+	// from V5 on the bitmap follows the key order, not the caller's
+	reversed := make([]ValidatorData, len(valset))
+	for i := range valset {
+		reversed[len(valset)-1-i] = valset[i]
+	}
+	reversedBitmap, err := SignerBitmap(CircuitVersionV5, reversed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(bitmap, reversedBitmap) {
+		t.Fatalf("expected bitmap %x for the reversed valset, got %x", bitmap, reversedBitmap)
+	}
+}
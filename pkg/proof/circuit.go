@@ -1,6 +1,7 @@
 package proof
 
 import (
+	"bytes"
 	"encoding/hex"
 	"log/slog"
 	"math/big"
@@ -9,22 +10,113 @@ import (
 	"github.com/consensys/gnark/frontend"
 	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
 	"github.com/consensys/gnark/std/algebra/emulated/sw_emulated"
+	"github.com/consensys/gnark/std/hash"
 	"github.com/consensys/gnark/std/hash/mimc"
 	gnarkSha3 "github.com/consensys/gnark/std/hash/sha3"
 	"github.com/consensys/gnark/std/math/bits"
 	"github.com/consensys/gnark/std/math/emulated"
 	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/std/rangecheck"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-errors/errors"
 )
 
+// MaxVotingPowerBits bounds every validator's voting power in hardened circuits.
+// 2^20 validators (the ValSetVerifier limit) of this size still sum below the scalar field modulus.
+const MaxVotingPowerBits = 232
+
+// CircuitVersion selects the constraint set compiled into Circuit. Every version is a separate
+// artifact generation, so keys and verifiers of an older version are never regenerated by a newer one.
+type CircuitVersion int
+
+const (
+	// CircuitVersionV1 is the original circuit behind the committed script/test/data/zk/Verifier_N.sol files.
+	CircuitVersionV1 CircuitVersion = iota + 1
+	// CircuitVersionV2 additionally constrains signer flags to booleans, voting powers to MaxVotingPowerBits,
+	// filler entries to the tail of the valset and all keys to their curve groups.
+	CircuitVersionV2
+	// CircuitVersionV3 keeps the V2 constraints and lowers the constraint count: the Fiat-Shamir challenge is
+	// lifted from the MiMC digest through a hint, bytes are decomposed with lookups, the lines of the fixed
+	// G2 generator are precomputed, a single-block input hash runs keccak on bits instead of lookup tables and
+	// the valset loop uses cheaper filler, curve and addition checks. See README.md for the constraint counts per
+	// tier.
+	CircuitVersionV3
+	// CircuitVersionV4 keeps the V3 constraints, binds the quorum threshold, epoch and key tag into the input hash
+	// and asserts that the signers' voting power reaches the quorum threshold.
+	CircuitVersionV4
+	// CircuitVersionV5 keeps the V4 constraints and hashes the valset with Poseidon2 instead of MiMC, see
+	// ValsetHashFunction.
+	CircuitVersionV5
+)
+
+// signersVotingPowerBits bounds the signers' voting power sum of hardened circuits, see MaxVotingPowerBits.
+const signersVotingPowerBits = MaxVotingPowerBits + 20
+
 // Circuit defines a pre-image knowledge proof
 type Circuit struct {
-	InputHash             frontend.Variable      `gnark:",public"`  // 254 bits
+	Version        CircuitVersion `gnark:"-"`
+	SplitInputHash bool           `gnark:"-"`
+	HashToG1       bool           `gnark:"-"`
+	SignerBitmap   bool           `gnark:"-"`
+	CompressedKeys bool           `gnark:"-"`
+
+	InputHash             frontend.Variable      `gnark:",public"`  // 253 bits, or the high 128 bits if split
+	InputHashLo           *InputHashLoCircuit    `gnark:",public"`  // split input hash only, the low 128 bits
 	SignersAggVotingPower frontend.Variable      `gnark:",private"` // 254 bits, virtually public
 	Message               sw_bn254.G1Affine      `gnark:",private"` // virtually public
 	Signature             sw_bn254.G1Affine      `gnark:",private"`
 	SignersAggKeyG2       sw_bn254.G2Affine      `gnark:",private"`
 	ValidatorData         []ValidatorDataCircuit `gnark:",private"`
+	// compressed-key circuits only, replaces ValidatorData
+	CompressedValidatorData []CompressedValidatorCircuit `gnark:",private"`
+	Quorum                  *QuorumCircuit               `gnark:",private"` // V4 only, virtually public
+	MessageHash             *MessageHashCircuit          `gnark:",private"` // hash-to-G1 only, virtually public
+}
+
+// QuorumCircuit holds the verification parameters a V4 input hash binds. It is a pointer in Circuit, so
+// older versions don't allocate its variables and keep their constraint systems.
+type QuorumCircuit struct {
+	QuorumThreshold frontend.Variable
+	Epoch           frontend.Variable
+	KeyTag          frontend.Variable
+}
+
+// InputHashLoCircuit holds the low half of a split input hash. Like QuorumCircuit it is a pointer in Circuit, so
+// circuits with a single input hash keep their public inputs.
+type InputHashLoCircuit struct {
+	Value frontend.Variable
+}
+
+// MessageHashCircuit holds the raw 32-byte message hash-to-G1 circuits hash to Message.
+type MessageHashCircuit struct {
+	Value [32]uints.U8
+}
+
+// newCircuit returns a placeholder of the configured version and input hash layout for nbValidators validators.
+func newCircuit(cfg Config, nbValidators int) *Circuit {
+	circuit := &Circuit{
+		Version:        cfg.CircuitVersion,
+		SplitInputHash: cfg.SplitInputHash,
+		HashToG1:       cfg.HashToG1,
+		SignerBitmap:   cfg.SignerBitmap,
+		CompressedKeys: cfg.CompressedKeys,
+	}
+	if cfg.CompressedKeys {
+		circuit.CompressedValidatorData = make([]CompressedValidatorCircuit, nbValidators)
+	} else {
+		circuit.ValidatorData = make([]ValidatorDataCircuit, nbValidators)
+	}
+	if cfg.CircuitVersion >= CircuitVersionV4 {
+		circuit.Quorum = &QuorumCircuit{}
+	}
+	if cfg.SplitInputHash {
+		circuit.InputHashLo = &InputHashLoCircuit{}
+	}
+	if cfg.HashToG1 {
+		circuit.MessageHash = &MessageHashCircuit{}
+	}
+	return circuit
 }
 
 type ValidatorDataCircuit struct {
@@ -36,157 +128,521 @@ type ValidatorDataCircuit struct {
 type ProveInput struct {
 	ValidatorData   []ValidatorData
 	MessageG1       bn254.G1Affine
+	MessageHash     [32]byte // hash-to-G1 circuits only, MessageG1 is its BN254.hashToG1
 	Signature       bn254.G1Affine
 	SignersAggKeyG2 bn254.G2Affine
+
+	// verification parameters bound by V4 circuits
+	QuorumThreshold *big.Int
+	Epoch           uint64
+	KeyTag          uint8
 }
 
 // Define declares the circuit's constraints
 func (circuit *Circuit) Define(api frontend.API) error {
 	// --------------------------------------- Prove ValSet consistency ---------------------------------------
-	curveApi, err := sw_emulated.New[emulated.BN254Fp, emulated.BN254Fr](api, sw_emulated.GetBN254Params())
+	hardened := circuit.Version >= CircuitVersionV2
+	if circuit.SplitInputHash && circuit.Version < CircuitVersionV3 {
+		return errors.Errorf("split input hash requires circuit version %d or later", CircuitVersionV3)
+	}
+	if circuit.HashToG1 && circuit.Version < CircuitVersionV3 {
+		return errors.Errorf("hash to G1 requires circuit version %d or later", CircuitVersionV3)
+	}
+	if circuit.SignerBitmap && circuit.Version < CircuitVersionV3 {
+		return errors.Errorf("signer bitmap requires circuit version %d or later", CircuitVersionV3)
+	}
+	if circuit.CompressedKeys && circuit.Version < CircuitVersionV3 {
+		return errors.Errorf("compressed keys require circuit version %d or later", CircuitVersionV3)
+	}
+	apis, err := newCircuitApis(api, hardened)
 	if err != nil {
 		return err
 	}
+	if circuit.Version == CircuitVersionV3 && !circuit.SignerBitmap && !circuit.CompressedKeys {
+		// the input hash is the only keccak hash and fits a single block
+		apis.keccak256 = newKeccak256Bits(api)
+		apis.keccakBits = true
+	}
+	apis.valsetHash = circuit.valsetHashFunction()
+	if apis.valsetHash == ValsetHashPoseidon2 {
+		apis.poseidon2 = newPoseidon2Hasher(api)
+	}
+
+	acc := valsetAccumulator{
+		valsetHash:            0,
+		prevIsFiller:          0,
+		signersAggVotingPower: 0,
+		signersAggKey: &sw_bn254.G1Affine{
+			X: emulated.ValueOf[emulated.BN254Fp](0),
+			Y: emulated.ValueOf[emulated.BN254Fp](0),
+		},
+		signersAggKeyIsZero: 1,
+	}
+
+	validatorData := circuit.ValidatorData
+	if circuit.CompressedKeys {
+		if validatorData, err = apis.decompressValset(circuit.CompressedValidatorData); err != nil {
+			return err
+		}
+	}
+
+	// calc valset hash, agg key and agg voting power
+	isFiller := make([]frontend.Variable, len(validatorData))
+	for i := range validatorData {
+		if isFiller[i], err = apis.accumulate(&acc, &validatorData[i], circuit.Version); err != nil {
+			return err
+		}
+	}
+
+	in := signedInput{
+		InputHash:             circuit.InputHash,
+		SignersAggVotingPower: circuit.SignersAggVotingPower,
+		Message:               &circuit.Message,
+		Signature:             &circuit.Signature,
+		SignersAggKeyG2:       &circuit.SignersAggKeyG2,
+		Quorum:                circuit.Quorum,
+	}
+	if circuit.SplitInputHash {
+		in.InputHashLo = circuit.InputHashLo.Value
+	}
+	if circuit.SignerBitmap {
+		if in.SignerBitmapHash, err = apis.signerBitmapHash(validatorData, isFiller); err != nil {
+			return err
+		}
+	}
+	if circuit.CompressedKeys {
+		if in.ValsetHashBytes, err = apis.compressedValsetHash(circuit.CompressedValidatorData, isFiller); err != nil {
+			return err
+		}
+	}
+	if circuit.HashToG1 {
+		in.MessageHash = make([]uints.U8, len(circuit.MessageHash.Value))
+		for i := range in.MessageHash {
+			in.MessageHash[i] = apis.u64.ByteValueOf(circuit.MessageHash.Value[i].Val)
+		}
+	}
+	return apis.assertSignedInput(circuit.Version, in, acc.valsetHash, acc.signersAggVotingPower, acc.signersAggKey)
+}
+
+// valsetHashFunction returns the valset commitment the circuit binds into the input hash.
+func (circuit *Circuit) valsetHashFunction() ValsetHashFunction {
+	return circuit.config().ValsetHash()
+}
+
+// config returns the configuration the circuit was built for, with the default backend.
+func (circuit *Circuit) config() Config {
+	return Config{
+		CircuitVersion: circuit.Version,
+		SplitInputHash: circuit.SplitInputHash,
+		HashToG1:       circuit.HashToG1,
+		SignerBitmap:   circuit.SignerBitmap,
+		CompressedKeys: circuit.CompressedKeys,
+	}
+}
+
+// circuitApis bundles the gadgets shared by the valset loop and the signature check. newCircuitApis creates them
+// in a fixed order, as reordering them changes the constraint systems behind existing keys.
+type circuitApis struct {
+	api          frontend.API
+	curve        *sw_emulated.Curve[emulated.BN254Fp, emulated.BN254Fr]
+	fieldFp      *emulated.Field[emulated.BN254Fp]
+	fieldFr      *emulated.Field[emulated.BN254Fr]
+	mimc         *mimc.MiMC
+	keccak256    hash.BinaryHasher
+	keccakBits   bool // keccak256 is a keccak256Bits, so no xor and and tables back it
+	u64          *uints.BinaryField[uints.U64]
+	pairing      *sw_bn254.Pairing
+	rangeChecker frontend.Rangechecker // nil unless hardened
+	valsetHash   ValsetHashFunction
+	poseidon2    *poseidon2Hasher // nil unless the valset is hashed with Poseidon2
+}
+
+func newCircuitApis(api frontend.API, hardened bool) (*circuitApis, error) {
+	curveApi, err := sw_emulated.New[emulated.BN254Fp, emulated.BN254Fr](api, sw_emulated.GetBN254Params())
+	if err != nil {
+		return nil, err
+	}
 
 	fieldFpApi, err := emulated.NewField[emulated.BN254Fp](api)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	fieldFrApi, err := emulated.NewField[emulated.BN254Fr](api)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	mimcApi, err := mimc.NewMiMC(api)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	keccak256Api, err := gnarkSha3.NewLegacyKeccak256(api)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	u64Api, err := uints.New[uints.U64](api)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	pairingApi, err := sw_bn254.NewPairing(api)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	valsetHash := frontend.Variable(0)
-	signersAggKey := &sw_bn254.G1Affine{
-		X: emulated.ValueOf[emulated.BN254Fp](0),
-		Y: emulated.ValueOf[emulated.BN254Fp](0),
+	apis := &circuitApis{
+		api:       api,
+		curve:     curveApi,
+		fieldFp:   fieldFpApi,
+		fieldFr:   fieldFrApi,
+		mimc:      &mimcApi,
+		keccak256: keccak256Api,
+		u64:       u64Api,
+		pairing:   pairingApi,
 	}
-	signersAggVotingPower := frontend.Variable(0)
+	if hardened {
+		apis.rangeChecker = rangecheck.New(api)
+	}
+	return apis, nil
+}
 
-	// calc valset hash, agg key and agg voting power
-	for i := range circuit.ValidatorData {
-		hashAffineG1(&mimcApi, &circuit.ValidatorData[i].Key)
-		mimcApi.Write(circuit.ValidatorData[i].VotingPower)
+// valsetAccumulator is the running state of the valset loop.
+type valsetAccumulator struct {
+	valsetHash            frontend.Variable
+	prevIsFiller          frontend.Variable
+	signersAggVotingPower frontend.Variable
+	signersAggKey         *sw_bn254.G1Affine
+	signersAggKeyIsZero   frontend.Variable // V3 on, set while signersAggKey is the (0,0) infinity
+}
 
-		isFillerValidatorData := api.And(fieldFpApi.IsZero(&circuit.ValidatorData[i].Key.X), fieldFpApi.IsZero(&circuit.ValidatorData[i].Key.Y))
+// accumulate absorbs a validator into the valset hash and, for signers, into the aggregated key and voting power.
+// It reports whether the validator is a filler.
+func (a *circuitApis) accumulate(acc *valsetAccumulator, validator *ValidatorDataCircuit, version CircuitVersion) (frontend.Variable, error) {
+	isFillerValidatorData := a.absorb(acc, validator, version)
+	if err := a.addSigner(acc, validator, version, isFillerValidatorData, validator.IsNonSigner); err != nil {
+		return nil, err
+	}
+	return isFillerValidatorData, nil
+}
 
-		// hash data if VALIDATOR is not a filler
-		valsetHash = api.Select(
-			isFillerValidatorData,
-			valsetHash,
-			mimcApi.Sum(),
-		)
+// addSigner adds a validator's key and voting power to the signers' aggregates unless it is a filler or a non-signer.
+func (a *circuitApis) addSigner(acc *valsetAccumulator, validator *ValidatorDataCircuit, version CircuitVersion, isFiller, isNonSigner frontend.Variable) error {
+	api := a.api
+	isFillerOrNonSigner := api.Or(isFiller, isNonSigner)
 
-		isFillerOrNonSigner := api.Or(isFillerValidatorData, circuit.ValidatorData[i].IsNonSigner)
+	// add power if VALIDATOR is not a filler and SIGNER
+	acc.signersAggVotingPower = api.Select(
+		isFillerOrNonSigner,
+		acc.signersAggVotingPower,
+		api.Add(acc.signersAggVotingPower, validator.VotingPower),
+	)
 
-		// add power if VALIDATOR is not a filler and SIGNER
-		signersAggVotingPower = api.Select(
+	// aggregate key if VALIDATOR is not a filler and SIGNER
+	if version < CircuitVersionV3 {
+		acc.signersAggKey = a.curve.Select(
 			isFillerOrNonSigner,
-			signersAggVotingPower,
-			api.Add(signersAggVotingPower, circuit.ValidatorData[i].VotingPower),
+			acc.signersAggKey,
+			a.curve.AddUnified(acc.signersAggKey, &validator.Key),
 		)
+		return nil
+	}
+	sum, sumIsZero, err := a.addKey(acc.signersAggKey, acc.signersAggKeyIsZero, &validator.Key)
+	if err != nil {
+		return err
+	}
+	acc.signersAggKey = a.curve.Select(isFillerOrNonSigner, acc.signersAggKey, sum)
+	acc.signersAggKeyIsZero = api.Select(isFillerOrNonSigner, acc.signersAggKeyIsZero, sumIsZero)
+	return nil
+}
 
-		// aggregate key if VALIDATOR is not a filler and SIGNER
-		signersAggKey = curveApi.Select(
-			isFillerOrNonSigner,
-			signersAggKey,
-			curveApi.AddUnified(signersAggKey, &circuit.ValidatorData[i].Key),
-		)
+// addKey returns acc + key and whether the sum is the (0,0) infinity, for acc on the curve or the infinity as
+// accIsZero tells, and key on the curve. Unlike AddUnified it knows which points are the infinity, so it only
+// compares the coordinates of the two points. G1 has no points of order two, so a key with the X of acc is acc
+// or -acc. The result is only used for signers, whose key absorb checked; for fillers it is garbage, but the
+// divisor stays nonzero.
+func (a *circuitApis) addKey(acc *sw_bn254.G1Affine, accIsZero frontend.Variable, key *sw_bn254.G1Affine) (*sw_bn254.G1Affine, frontend.Variable, error) {
+	api, f := a.api, a.fieldFp
+	dx := f.Sub(&key.X, &acc.X)
+	dy := f.Sub(&key.Y, &acc.Y)
+	isSameX := api.And(api.Sub(1, accIsZero), f.IsZero(dx))
+	isNeg := api.And(isSameX, api.Sub(1, f.IsZero(dy)))
+
+	// the slope is dy/dx for distinct X and 3x^2/2y for key = acc. It comes from a hint and is checked with a
+	// single multiplication check, lambda*den = (1-isSameX)*dy + isSameX*3x^2, where the divisor is 1 in the
+	// cases whose result is replaced below
+	one, zero, mone := f.One(), f.Zero(), f.NewElement(-1)
+	sameX := f.Select(isSameX, one, zero)
+	distinctX := f.Select(isSameX, zero, one)
+	den := f.Select(isSameX, f.MulConst(&acc.Y, big.NewInt(2)), dx)
+	den = f.Select(api.Or(accIsZero, isNeg), one, den)
+	hint, err := f.NewHint(slopeHint, 1, &acc.X, dy, den, sameX)
+	if err != nil {
+		return nil, nil, errors.Errorf("failed to hint the slope: %w", err)
 	}
+	lambda := hint[0]
+	f.AssertIsEqual(f.Eval([][]*emulated.Element[emulated.BN254Fp]{
+		{lambda, den}, {mone, distinctX, dy}, {mone, sameX, &acc.X, &acc.X},
+	}, []int{1, 1, 3}), zero)
+
+	x := f.Eval([][]*emulated.Element[emulated.BN254Fp]{{lambda, lambda}, {mone, &acc.X}, {mone, &key.X}}, []int{1, 1, 1})
+	y := f.Eval([][]*emulated.Element[emulated.BN254Fp]{{lambda, &acc.X}, {mone, lambda, x}, {mone, &acc.Y}}, []int{1, 1, 1})
+
+	sum := a.curve.Select(accIsZero, key, &sw_bn254.G1Affine{X: *x, Y: *y})
+	sum = a.curve.Select(isNeg, &sw_bn254.G1Affine{X: *zero, Y: *zero}, sum)
+	return sum, isNeg, nil
+}
+
+// slopeHint returns the slope addKey checks, ((1-sameX)*dy + sameX*3x^2) / den, from x, dy, den and sameX.
+func slopeHint(_ *big.Int, inputs, outputs []*big.Int) error {
+	return emulated.UnwrapHint(inputs, outputs, func(p *big.Int, inputs, outputs []*big.Int) error {
+		x, dy, den, sameX := inputs[0], inputs[1], inputs[2], inputs[3]
+		num := new(big.Int).Set(dy)
+		if sameX.Sign() != 0 {
+			num.Mul(x, x).Mul(num, big.NewInt(3))
+		}
+		inv := new(big.Int).ModInverse(den, p)
+		if inv == nil {
+			return errors.New("slope divisor is zero")
+		}
+		outputs[0].Mul(num, inv).Mod(outputs[0], p)
+		return nil
+	})
+}
+
+// absorb hashes a validator into the valset hash, applying the hardened checks, and reports whether it is a filler.
+func (a *circuitApis) absorb(acc *valsetAccumulator, validator *ValidatorDataCircuit, version CircuitVersion) frontend.Variable {
+	api := a.api
+	a.writeValidator(validator)
+
+	var isFillerValidatorData frontend.Variable
+	if version >= CircuitVersionV3 {
+		isFillerValidatorData = a.isZeroKey(&validator.Key)
+	} else {
+		isFillerValidatorData = api.And(a.fieldFp.IsZero(&validator.Key.X), a.fieldFp.IsZero(&validator.Key.Y))
+	}
+
+	if version >= CircuitVersionV2 {
+		api.AssertIsBoolean(validator.IsNonSigner)
+		a.rangeChecker.Check(validator.VotingPower, MaxVotingPowerBits)
+
+		// fillers are only allowed as a suffix, as HashValset stops at the first one
+		api.AssertIsEqual(api.Mul(acc.prevIsFiller, api.Sub(1, isFillerValidatorData)), 0)
+		acc.prevIsFiller = isFillerValidatorData
+
+		if version >= CircuitVersionV3 {
+			a.assertIsOnCurveOrFiller(&validator.Key, isFillerValidatorData)
+		} else {
+			// (0,0) is not on the curve, so check the generator instead for fillers
+			a.curve.AssertIsOnCurve(a.curve.Select(isFillerValidatorData, a.curve.Generator(), &validator.Key))
+		}
+	}
+
+	// hash data if VALIDATOR is not a filler
+	acc.valsetHash = api.Select(
+		isFillerValidatorData,
+		acc.valsetHash,
+		a.valsetDigest(),
+	)
+
+	return isFillerValidatorData
+}
+
+// isZeroKey reports whether key is encoded as (0,0). The limbs of a key are range checked by the emulated field
+// arithmetic on it, so their sum doesn't wrap around and is zero only for the zero encoding. Unlike
+// fieldFp.IsZero it doesn't reduce the coordinates: a key encoding zero as p is not a filler, fails the curve
+// check unless it encodes a curve point, and can't reproduce the limbs of HashValset either way.
+func (a *circuitApis) isZeroKey(key *sw_bn254.G1Affine) frontend.Variable {
+	sum := frontend.Variable(0)
+	for _, limbs := range [][]frontend.Variable{key.X.Limbs, key.Y.Limbs} {
+		for i := range limbs {
+			sum = a.api.Add(sum, limbs[i])
+		}
+	}
+	return a.api.IsZero(sum)
+}
+
+// assertIsOnCurveOrFiller checks y^2 = x^3 + 3 for keys and y^2 = x^3 for fillers, which isFiller restricts to
+// (0,0). AssertIsOnCurve does the same but detects (0,0) with two more emulated zero tests.
+func (a *circuitApis) assertIsOnCurveOrFiller(key *sw_bn254.G1Affine, isFiller frontend.Variable) {
+	f := a.fieldFp
+	b := f.Select(isFiller, f.Zero(), f.NewElement(3))
+	mone := f.NewElement(-1)
+	check := f.Eval([][]*emulated.Element[emulated.BN254Fp]{{&key.X, &key.X, &key.X}, {b}, {mone, &key.Y, &key.Y}}, []int{1, 1, 1})
+	f.AssertIsEqual(check, f.Zero())
+}
+
+// signedInput holds the virtually public data bound by the input hash and the signature to check.
+type signedInput struct {
+	InputHash             frontend.Variable
+	InputHashLo           frontend.Variable // split input hash only
+	SignersAggVotingPower frontend.Variable
+	Message               *sw_bn254.G1Affine
+	MessageHash           []uints.U8 // hash-to-G1 only, replaces Message in the input hash
+	Signature             *sw_bn254.G1Affine
+	SignersAggKeyG2       *sw_bn254.G2Affine
+	Quorum                *QuorumCircuit // V4 only
+	SignerBitmapHash      []uints.U8     // signer bitmap only, precedes the message in the input hash
+	ValsetHashBytes       []uints.U8     // compressed keys only, the keccak valset commitment
+}
+
+// assertSignedInput binds the valset hash, the signers' voting power and the message to the input hash and checks
+// the aggregated signature against the signers' aggregated key.
+func (a *circuitApis) assertSignedInput(version CircuitVersion, in signedInput, valsetHash, signersAggVotingPower frontend.Variable, signersAggKey *sw_bn254.G1Affine) error {
+	api := a.api
+	hardened := version >= CircuitVersionV2
+	optimized := version >= CircuitVersionV3
 
 	// compare with public inputs
-	api.AssertIsEqual(signersAggVotingPower, circuit.SignersAggVotingPower)
+	api.AssertIsEqual(signersAggVotingPower, in.SignersAggVotingPower)
+
+	if hardened {
+		a.assertSignedPointsOnCurve(in)
+	}
 
 	// --------------------------------------- Prove Input consistency ---------------------------------------
 
 	// valset consistency checked against InputHash which is Hash{valset-hash|non-signers-vp|message}
-	hashBytes := variableToBytes(api, u64Api, valsetHash)
-
-	api.Println("HashBytes:", hashBytes)
-	keccak256Api.Write(hashBytes)
-	aggVotingPowerBytes := variableToBytes(api, u64Api, circuit.SignersAggVotingPower)
+	if in.ValsetHashBytes != nil {
+		a.keccak256.Write(in.ValsetHashBytes)
+		if err := a.writeWords(a.keccak256, word{in.SignersAggVotingPower, signersVotingPowerBits}); err != nil {
+			return err
+		}
+	} else if optimized {
+		// a non-canonical encoding of the valset hash can't reproduce the keccak preimage, so it isn't bounded
+		if err := a.writeWords(a.keccak256, word{valsetHash, 256}, word{in.SignersAggVotingPower, signersVotingPowerBits}); err != nil {
+			return err
+		}
+	} else {
+		a.keccak256.Write(variableToBytes(api, a.u64, valsetHash))
+		a.keccak256.Write(variableToBytes(api, a.u64, in.SignersAggVotingPower))
+	}
+	if version >= CircuitVersionV4 {
+		// the lookup decomposition bounds the threshold, so the comparison below is sound
+		if err := a.writeWords(a.keccak256,
+			word{in.Quorum.QuorumThreshold, signersVotingPowerBits}, word{in.Quorum.Epoch, epochBits}, word{in.Quorum.KeyTag, 8},
+		); err != nil {
+			return err
+		}
+		api.AssertIsLessOrEqual(in.Quorum.QuorumThreshold, in.SignersAggVotingPower)
+	}
+	if in.SignerBitmapHash != nil {
+		a.keccak256.Write(in.SignerBitmapHash)
+	}
+	if in.MessageHash != nil {
+		if err := a.assertHashToG1(in.MessageHash, in.Message); err != nil {
+			return err
+		}
+		a.keccak256.Write(in.MessageHash)
+	} else {
+		a.keccak256.Write(keyToBytes(a.u64, in.Message))
+	}
+	inputDataHash := a.keccak256.Sum()
 
-	api.Println("aggVotingPowerBytes:", aggVotingPowerBytes)
-	keccak256Api.Write(aggVotingPowerBytes)
-	messageBytes := keyToBytes(u64Api, &circuit.Message)
+	if in.InputHashLo != nil {
+		a.assertSplitInputHash(inputDataHash, in.InputHash, in.InputHashLo)
+	} else {
+		a.assertMaskedInputHash(optimized, inputDataHash, in.InputHash)
+	}
 
-	api.Println("MessageBytes:", messageBytes)
-	keccak256Api.Write(messageBytes)
-	inputDataHash := keccak256Api.Sum()
-	api.Println("InputDataHash:", inputDataHash)
-	inputHashBytes := variableToBytes(api, u64Api, circuit.InputHash)
+	return a.assertSignature(optimized, in, signersAggKey)
+}
 
-	inputDataHash[0] = u64Api.ByteValueOf(u64Api.ToValue(u64Api.And(u64Api.ValueOf(inputDataHash[0].Val), uints.NewU64(0x1f)))) // zero two first bits
+// assertMaskedInputHash compares a keccak digest with the top three bits cleared to a single public input.
+func (a *circuitApis) assertMaskedInputHash(optimized bool, digest []uints.U8, inputHash frontend.Variable) {
+	api := a.api
+	if a.keccakBits {
+		// the digest bytes are sums of bits already, decomposing one again is cheaper than the and table
+		topBits := bits.ToBinary(api, digest[0].Val, bits.WithNbDigits(8))
+		digest[0] = uints.U8{Val: bits.FromBinary(api, topBits[:5])} // zero three first bits
+	} else {
+		digest[0] = a.u64.ByteValueOf(a.u64.ToValue(a.u64.And(a.u64.ValueOf(digest[0].Val), uints.NewU64(0x1f)))) // zero three first bits
+	}
+	if optimized {
+		// the masked digest is below 2^253 < r, so packing it into a single variable is exact
+		api.AssertIsEqual(bytesToVariable(api, digest), inputHash)
+		return
+	}
+	inputHashBytes := variableToBytes(api, a.u64, inputHash)
 	for i := range inputHashBytes {
-		u64Api.ByteAssertEq(inputDataHash[i], inputHashBytes[i])
+		a.u64.ByteAssertEq(digest[i], inputHashBytes[i])
 	}
+}
 
+// assertSplitInputHash compares the whole keccak digest to two public inputs holding its high and low 128 bits.
+// Both halves are below r, so packing them is exact.
+func (a *circuitApis) assertSplitInputHash(digest []uints.U8, inputHashHi, inputHashLo frontend.Variable) {
+	a.api.AssertIsEqual(bytesToVariable(a.api, digest[:16]), inputHashHi)
+	a.api.AssertIsEqual(bytesToVariable(a.api, digest[16:]), inputHashLo)
+}
+
+// assertSignedPointsOnCurve checks that the signature, the message and the signers' G2 key are in their groups.
+func (a *circuitApis) assertSignedPointsOnCurve(in signedInput) {
+	a.pairing.AssertIsOnG1(in.Signature)
+	a.pairing.AssertIsOnG1(in.Message)
+	a.pairing.AssertIsOnG2(in.SignersAggKeyG2)
+}
+
+// assertSignature checks the aggregated signature over the message against the signers' keys in G1 and G2,
+// randomized by a Fiat-Shamir challenge over all of them.
+func (a *circuitApis) assertSignature(optimized bool, in signedInput, signersAggKey *sw_bn254.G1Affine) error {
 	// --------------------------------------- Verify Signature ---------------------------------------
 
-	// calc alpha
-	mimcApi.Reset()
-	hashAffineG1(&mimcApi, &circuit.Signature)
-	hashAffineG1(&mimcApi, signersAggKey)
-	hashAffineG2(&mimcApi, &circuit.SignersAggKeyG2)
-	hashAffineG1(&mimcApi, &circuit.Message)
-	//TODO optimize
-	alpha := fieldFrApi.FromBits(bits.ToBinary(api, mimcApi.Sum())...)
+	alpha, err := a.signatureChallenge(optimized, in, signersAggKey)
+	if err != nil {
+		return err
+	}
 
 	// pairing check
 	_, _, g1Gen, g2Gen := bn254.Generators()
 	g1GenAffine := sw_bn254.NewG1Affine(g1Gen)
-	negG2GenAffine := sw_bn254.NewG2Affine(*g2Gen.Neg(&g2Gen))
-	err = pairingApi.PairingCheck(
+	var negG2GenAffine sw_bn254.G2Affine
+	if optimized {
+		// ScalarMulBase is not used for alpha*G1: on BN254 it runs plain GLV over precomputed multiples of G1,
+		// 78,389 constraints against 59,374 for the GLV and fake-GLV ScalarMul of the generator.
+		// JointScalarMulBase returns the single point alpha*key + alpha*G1, while the check needs both products
+		// in different pairings. Fixed G2 lines, in turn, save a whole line computation.
+		negG2GenAffine = sw_bn254.NewG2AffineFixed(*g2Gen.Neg(&g2Gen))
+	} else {
+		negG2GenAffine = sw_bn254.NewG2Affine(*g2Gen.Neg(&g2Gen))
+	}
+	return a.pairing.PairingCheck(
 		[]*sw_bn254.G1Affine{
-			curveApi.AddUnified(&circuit.Signature, curveApi.ScalarMul(signersAggKey, alpha)),
-			curveApi.AddUnified(&circuit.Message, curveApi.ScalarMul(&g1GenAffine, alpha)),
+			a.curve.AddUnified(in.Signature, a.curve.ScalarMul(signersAggKey, alpha)),
+			a.curve.AddUnified(in.Message, a.curve.ScalarMul(&g1GenAffine, alpha)),
 		},
 		[]*sw_bn254.G2Affine{
 			&negG2GenAffine,
-			&circuit.SignersAggKeyG2,
+			in.SignersAggKeyG2,
 		},
 	)
+}
 
-	return err
+// signatureChallenge returns the Fiat-Shamir challenge alpha over the signature, the signers' keys and the message.
+func (a *circuitApis) signatureChallenge(optimized bool, in signedInput, signersAggKey *sw_bn254.G1Affine) (*emulated.Element[emulated.BN254Fr], error) {
+	a.mimc.Reset()
+	hashAffineG1(a.mimc, in.Signature)
+	hashAffineG1(a.mimc, signersAggKey)
+	hashAffineG2(a.mimc, in.SignersAggKeyG2)
+	hashAffineG1(a.mimc, in.Message)
+	if optimized {
+		return digestToScalar(a.api, a.fieldFr, a.mimc.Sum())
+	}
+	return a.fieldFr.FromBits(bits.ToBinary(a.api, a.mimc.Sum())...), nil
 }
 
 func setCircuitData(circuit *Circuit, proveInput ProveInput) {
-	circuit.ValidatorData = make([]ValidatorDataCircuit, len(proveInput.ValidatorData))
-	for i := range proveInput.ValidatorData {
-		circuit.ValidatorData[i].Key = sw_bn254.NewG1Affine(proveInput.ValidatorData[i].Key)
-		circuit.ValidatorData[i].VotingPower = proveInput.ValidatorData[i].VotingPower
-		circuit.ValidatorData[i].IsNonSigner = *big.NewInt(0)
-
-		if proveInput.ValidatorData[i].IsNonSigner {
-			circuit.ValidatorData[i].IsNonSigner = *big.NewInt(1)
-		}
+	if circuit.CompressedKeys {
+		setCompressedValidatorData(&circuit.CompressedValidatorData, proveInput.ValidatorData)
+	} else {
+		setValidatorData(&circuit.ValidatorData, proveInput.ValidatorData)
 	}
 
 	_, nonSignersAggVotingPower, totalVotingPower := getNonSignersData(proveInput.ValidatorData)
 	signersAggVotingPower := new(big.Int).Sub(totalVotingPower, nonSignersAggVotingPower)
-	valsetHash := HashValset(proveInput.ValidatorData)
+	valsetHash := HashValsetWith(circuit.valsetHashFunction(), proveInput.ValidatorData)
 
 	circuit.SignersAggVotingPower = *signersAggVotingPower
 
@@ -194,26 +650,152 @@ func setCircuitData(circuit *Circuit, proveInput ProveInput) {
 	circuit.Message = sw_bn254.NewG1Affine(proveInput.MessageG1)
 	circuit.SignersAggKeyG2 = sw_bn254.NewG2Affine(proveInput.SignersAggKeyG2)
 
-	messageBytes := proveInput.MessageG1.RawBytes()
-	aggVotingPowerBuffer := make([]byte, 32)
-	signersAggVotingPower.FillBytes(aggVotingPowerBuffer)
-
-	inputHashBytes := valsetHash
-	inputHashBytes = append(inputHashBytes, aggVotingPowerBuffer...)
-	inputHashBytes = append(inputHashBytes, messageBytes[:]...)
-	inputHash := crypto.Keccak256(inputHashBytes)
+	inputContext := InputContext{
+		ValsetHash:      valsetHash,
+		MessageG1:       proveInput.MessageG1,
+		MessageHash:     proveInput.MessageHash,
+		QuorumThreshold: proveInput.QuorumThreshold,
+		Epoch:           proveInput.Epoch,
+		KeyTag:          proveInput.KeyTag,
+	}
+	if circuit.HashToG1 {
+		circuit.MessageHash = &MessageHashCircuit{}
+		for i := range proveInput.MessageHash {
+			circuit.MessageHash.Value[i] = uints.NewU8(proveInput.MessageHash[i])
+		}
+	}
+	if circuit.SignerBitmap {
+		inputContext.SignerBitmap = signerBitmap(proveInput.ValidatorData)
+	}
 
 	slog.Debug("signersAggVotingPower", "vp", signersAggVotingPower.String())
 	slog.Debug("signed message", "message", proveInput.MessageG1.String())
 	slog.Debug("signed message", "message.X", proveInput.MessageG1.X.String())
 	slog.Debug("signed message", "message.Y", proveInput.MessageG1.Y.String())
-	slog.Debug("MIMC hash", "hash", hex.EncodeToString(valsetHash))
+	slog.Debug("valset hash", "function", circuit.valsetHashFunction(), "hash", hex.EncodeToString(valsetHash))
+
+	if circuit.Version >= CircuitVersionV4 {
+		circuit.Quorum = &QuorumCircuit{
+			QuorumThreshold: proveInput.QuorumThreshold,
+			Epoch:           proveInput.Epoch,
+			KeyTag:          proveInput.KeyTag,
+		}
+	}
+	digest := circuit.config().PublicInputHash(signersAggVotingPower, inputContext).Bytes()
+	setInputHash(circuit, digest)
+
+	slog.Debug("[Prove] input hash", "hash", hex.EncodeToString(digest))
+}
 
-	inputHashInt := new(big.Int).SetBytes(inputHash)
-	mask, _ := big.NewInt(0).SetString("1FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF", 16)
-	inputHashInt.And(inputHashInt, mask)
+// InputContext is what a verifier hashes into the public input next to the signers voting power of the proof:
+// the header's valset hash and the signed message, plus the data of the configured circuit's modes.
+type InputContext struct {
+	ValsetHash  []byte
+	MessageG1   bn254.G1Affine
+	MessageHash [32]byte // hash-to-G1 circuits only, replaces MessageG1
+	// SignerBitmap is the bitmap of signer-bitmap circuits, see SignerBitmap
+	SignerBitmap []byte
+
+	// verification parameters bound by V4 circuits
+	QuorumThreshold *big.Int
+	Epoch           uint64
+	KeyTag          uint8
+}
 
-	circuit.InputHash = inputHashInt
+// PublicInputHash returns the unmasked keccak digest the configured circuit takes its public inputs from, the
+// hash ZkProver.Verify expects.
+func (c Config) PublicInputHash(signersAggVotingPower *big.Int, ctx InputContext) common.Hash {
+	messageRawBytes := ctx.MessageG1.RawBytes()
+	message := messageRawBytes[:]
+	if c.HashToG1 {
+		message = ctx.MessageHash[:]
+	}
+	if c.SignerBitmap {
+		// the bitmap hash directly precedes the message in the preimage
+		bitmapHash := SignerBitmapHash(ctx.SignerBitmap)
+		message = append(bitmapHash.Bytes(), message...)
+	}
+	if c.CircuitVersion >= CircuitVersionV4 {
+		return common.BytesToHash(quorumInputDigest(ctx.ValsetHash, signersAggVotingPower, ctx.QuorumThreshold, ctx.Epoch, ctx.KeyTag, message))
+	}
+	return common.BytesToHash(inputDigest(ctx.ValsetHash, signersAggVotingPower, message))
+}
+
+// setInputHash assigns the public inputs of circuit from the keccak digest of its input, in the circuit's layout.
+func setInputHash(circuit *Circuit, digest []byte) {
+	if !circuit.SplitInputHash {
+		circuit.InputHash = maskInputHash(digest)
+		return
+	}
+	hi, lo := splitInputHash(digest)
+	circuit.InputHash = hi
+	circuit.InputHashLo = &InputHashLoCircuit{Value: lo}
+}
 
-	slog.Debug("[Prove] input hash", "hash", hex.EncodeToString(inputHashInt.Bytes()))
+// inputHashMask clears the top three bits of a digest, so that it fits a single scalar field element.
+var inputHashMask, _ = new(big.Int).SetString("1FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF", 16)
+
+// maskInputHash returns digest with the top three bits cleared, the single public input of unsplit circuits.
+func maskInputHash(digest []byte) *big.Int {
+	inputHashInt := new(big.Int).SetBytes(digest)
+	return inputHashInt.And(inputHashInt, inputHashMask)
+}
+
+// splitInputHash returns the high and low 128 bits of digest, the two public inputs of split circuits.
+func splitInputHash(digest []byte) (hi, lo *big.Int) {
+	return new(big.Int).SetBytes(digest[:16]), new(big.Int).SetBytes(digest[16:32])
+}
+
+func setValidatorData(circuitData *[]ValidatorDataCircuit, valset []ValidatorData) {
+	*circuitData = make([]ValidatorDataCircuit, len(valset))
+	for i := range valset {
+		(*circuitData)[i].Key = sw_bn254.NewG1Affine(valset[i].Key)
+		(*circuitData)[i].VotingPower = valset[i].VotingPower
+		(*circuitData)[i].IsNonSigner = *big.NewInt(0)
+
+		if valset[i].IsNonSigner {
+			(*circuitData)[i].IsNonSigner = *big.NewInt(1)
+		}
+	}
+}
+
+// inputHash computes keccak(valsetHash || signersAggVotingPower || message) with the top three bits cleared.
+func inputHash(valsetHash []byte, signersAggVotingPower *big.Int, message bn254.G1Affine) *big.Int {
+	messageBytes := message.RawBytes()
+	return maskInputHash(inputDigest(valsetHash, signersAggVotingPower, messageBytes[:]))
+}
+
+// inputDigest computes keccak(valsetHash || signersAggVotingPower || message), where message is either the raw
+// G1 point or, for hash-to-G1 circuits, the 32-byte message hash.
+func inputDigest(valsetHash []byte, signersAggVotingPower *big.Int, messageBytes []byte) []byte {
+	aggVotingPowerBuffer := make([]byte, 32)
+	signersAggVotingPower.FillBytes(aggVotingPowerBuffer)
+
+	inputHashBytes := bytes.Clone(valsetHash)
+	inputHashBytes = append(inputHashBytes, aggVotingPowerBuffer...)
+	inputHashBytes = append(inputHashBytes, messageBytes...)
+	return crypto.Keccak256(inputHashBytes)
+}
+
+// QuorumInputHash computes the V4 input hash
+//
+//	keccak(valsetHash || signersAggVotingPower || quorumThreshold || epoch || keyTag || message)
+//
+// with every number encoded as a 32-byte word and the top three bits cleared.
+func QuorumInputHash(valsetHash []byte, signersAggVotingPower, quorumThreshold *big.Int, epoch uint64, keyTag uint8, message bn254.G1Affine) *big.Int {
+	messageBytes := message.RawBytes()
+	return maskInputHash(quorumInputDigest(valsetHash, signersAggVotingPower, quorumThreshold, epoch, keyTag, messageBytes[:]))
+}
+
+// quorumInputDigest computes the unmasked keccak digest behind QuorumInputHash, with the message encoded as in
+// inputDigest.
+func quorumInputDigest(valsetHash []byte, signersAggVotingPower, quorumThreshold *big.Int, epoch uint64, keyTag uint8, messageBytes []byte) []byte {
+	inputHashBytes := bytes.Clone(valsetHash)
+	for _, word := range []*big.Int{signersAggVotingPower, quorumThreshold, new(big.Int).SetUint64(epoch), big.NewInt(int64(keyTag))} {
+		wordBuffer := make([]byte, 32)
+		word.FillBytes(wordBuffer)
+		inputHashBytes = append(inputHashBytes, wordBuffer...)
+	}
+	inputHashBytes = append(inputHashBytes, messageBytes...)
+	return crypto.Keccak256(inputHashBytes)
 }
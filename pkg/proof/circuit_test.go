@@ -0,0 +1,473 @@
+package proof
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	mimc_native "github.com/consensys/gnark-crypto/ecc/bn254/fr/mimc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/test"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const testCircuitSize = 4
+
+func testMessageG1(t *testing.T) bn254.G1Affine {
+	t.Helper()
+	messageG1Hex := "04c3256b0d7e3f3766d9d3f08fad062e025db392f7b8d8d86322602365b82eba2370c94328160af53802c073a5ddafe012a4073eca842339acc5caae83e1b922"
+	var messageG1 bn254.G1Affine
+	if err := messageG1.Unmarshal(common.Hex2Bytes(messageG1Hex)); err != nil {
+		t.Fatal(err)
+	}
+	return messageG1
+}
+
+// newTestCircuit returns a placeholder and a satisfying assignment for valset, padded to testCircuitSize.
+func newTestCircuit(t *testing.T, version CircuitVersion, valset []ValidatorData) (*Circuit, *Circuit) {
+	t.Helper()
+	return newTestCircuitWithConfig(t, Config{CircuitVersion: version}, valset)
+}
+
+func newTestCircuitWithConfig(t *testing.T, cfg Config, valset []ValidatorData) (*Circuit, *Circuit) {
+	t.Helper()
+	valset = padValset(valset, testCircuitSize)
+	message := testMessageG1(t)
+	if cfg.HashToG1 {
+		message, _ = HashToG1(testMessageHash)
+	}
+	aggSignature, aggKeyG2, _ := getAggSignature(message, &valset)
+
+	assignment := &Circuit{Version: cfg.CircuitVersion, SplitInputHash: cfg.SplitInputHash, HashToG1: cfg.HashToG1, SignerBitmap: cfg.SignerBitmap, CompressedKeys: cfg.CompressedKeys}
+	setCircuitData(assignment, ProveInput{
+		ValidatorData:   valset,
+		MessageG1:       message,
+		MessageHash:     testMessageHash,
+		Signature:       *aggSignature,
+		SignersAggKeyG2: *aggKeyG2,
+		QuorumThreshold: big.NewInt(100),
+		Epoch:           7,
+		KeyTag:          15,
+	})
+
+	return newCircuit(cfg, testCircuitSize), assignment
+}
+
+// hashValsetThroughLastValidator hashes valset the way the V1 circuit does: every entry up to the last
+// non-filler is absorbed, including fillers in between, while HashValset stops at the first filler.
+func hashValsetThroughLastValidator(valset []ValidatorData) []byte {
+	last := -1
+	for i := range valset {
+		if !valset[i].Key.IsInfinity() {
+			last = i
+		}
+	}
+
+	h := mimc_native.NewMiMC()
+	for i := 0; i <= last; i++ {
+		xBytes := valset[i].Key.X.Bytes()
+		yBytes := valset[i].Key.Y.Bytes()
+		for _, b := range [][]byte{xBytes[24:32], xBytes[16:24], xBytes[8:16], xBytes[0:8], yBytes[24:32], yBytes[16:24], yBytes[8:16], yBytes[0:8]} {
+			h.Write(b)
+		}
+		votingPowerBuf := make([]byte, 32)
+		valset[i].VotingPower.FillBytes(votingPowerBuf)
+		h.Write(votingPowerBuf)
+	}
+	return h.Sum(nil)
+}
+
+func assertSolved(t *testing.T, placeholder, assignment *Circuit) {
+	t.Helper()
+	if err := test.IsSolved(placeholder, assignment, ecc.BN254.ScalarField()); err != nil {
+		t.Fatalf("expected circuit to be solved: %v", err)
+	}
+}
+
+func assertNotSolved(t *testing.T, placeholder, assignment *Circuit) {
+	t.Helper()
+	if err := test.IsSolved(placeholder, assignment, ecc.BN254.ScalarField()); err == nil {
+		t.Fatal("expected circuit not to be solved")
+	}
+}
+
+func TestCircuitV2Valid(t *testing.T) {
+	placeholder, assignment := newTestCircuit(t, CircuitVersionV2, genValset(3, []int{1}))
+	assertSolved(t, placeholder, assignment)
+}
+
+func TestCircuitV2NonBooleanSigner(t *testing.T) {
+	placeholder, assignment := newTestCircuit(t, CircuitVersionV2, genValset(3, []int{1}))
+	assignment.ValidatorData[1].IsNonSigner = 2
+	assertNotSolved(t, placeholder, assignment)
+}
+
+func TestCircuitV2VotingPowerOutOfRange(t *testing.T) {
+	valset := genValset(3, nil)
+	valset[0].VotingPower = new(big.Int).Lsh(big.NewInt(1), MaxVotingPowerBits)
+
+	placeholder, assignment := newTestCircuit(t, CircuitVersionV1, valset)
+	assertSolved(t, placeholder, assignment)
+
+	placeholder, assignment = newTestCircuit(t, CircuitVersionV2, valset)
+	assertNotSolved(t, placeholder, assignment)
+}
+
+func TestCircuitV2FillerNotSuffix(t *testing.T) {
+	// the filler at index 2 is followed by a validator
+	valset := padValset(genValset(2, nil), 3)
+	valset = append(valset, genValset(3, nil)[2])
+	message := testMessageG1(t)
+
+	for _, tc := range []struct {
+		version CircuitVersion
+		solved  bool
+	}{
+		{CircuitVersionV1, true},
+		{CircuitVersionV2, false},
+	} {
+		placeholder, assignment := newTestCircuit(t, tc.version, valset)
+		// HashValset stops at the filler, so rebind the input hash to the circuit's view of the valset
+		assignment.InputHash = inputHash(hashValsetThroughLastValidator(valset), big.NewInt(300), message)
+		if tc.solved {
+			assertSolved(t, placeholder, assignment)
+		} else {
+			assertNotSolved(t, placeholder, assignment)
+		}
+	}
+}
+
+func TestCircuitV2KeyNotOnCurve(t *testing.T) {
+	valset := genValset(3, []int{2})
+	valset[2].Key.X.SetOne()
+	valset[2].Key.Y.SetOne()
+
+	placeholder, assignment := newTestCircuit(t, CircuitVersionV1, valset)
+	assertSolved(t, placeholder, assignment)
+
+	placeholder, assignment = newTestCircuit(t, CircuitVersionV2, valset)
+	assertNotSolved(t, placeholder, assignment)
+}
+
+// signedPointsCircuit runs the group checks hardened circuits apply to the signed points, and nothing else.
+type signedPointsCircuit struct {
+	Signature       sw_bn254.G1Affine
+	Message         sw_bn254.G1Affine
+	SignersAggKeyG2 sw_bn254.G2Affine
+}
+
+func (c *signedPointsCircuit) Define(api frontend.API) error {
+	a, err := newCircuitApis(api, true)
+	if err != nil {
+		return err
+	}
+	a.assertSignedPointsOnCurve(signedInput{Signature: &c.Signature, Message: &c.Message, SignersAggKeyG2: &c.SignersAggKeyG2})
+	return nil
+}
+
+func TestCircuitV2AggKeyG2NotInSubgroup(t *testing.T) {
+	var u bn254.E2
+	u.A0.SetUint64(7)
+	point := bn254.MapToCurve2(&u)
+	if !point.IsOnCurve() || point.IsInSubGroup() {
+		t.Fatal("expected a point on the curve outside of G2")
+	}
+
+	// a key outside G2 also breaks the pairing, which isn't bilinear there, so no full assignment solves V1 and
+	// fails V2 only on the subgroup check: the check is isolated on the gadget
+	valset := padValset(genValset(3, nil), testCircuitSize)
+	message := testMessageG1(t)
+	signature, keyG2, _ := getAggSignature(message, &valset)
+	assignment := &signedPointsCircuit{
+		Signature:       sw_bn254.NewG1Affine(*signature),
+		Message:         sw_bn254.NewG1Affine(message),
+		SignersAggKeyG2: sw_bn254.NewG2Affine(*keyG2),
+	}
+	if err := test.IsSolved(&signedPointsCircuit{}, assignment, ecc.BN254.ScalarField()); err != nil {
+		t.Fatalf("rejected the points of a valid signature: %v", err)
+	}
+	assignment.SignersAggKeyG2 = sw_bn254.NewG2Affine(point)
+	if err := test.IsSolved(&signedPointsCircuit{}, assignment, ecc.BN254.ScalarField()); err == nil {
+		t.Fatal("accepted a G2 key outside the subgroup")
+	}
+
+	placeholder, full := newTestCircuit(t, CircuitVersionV2, genValset(3, nil))
+	full.SignersAggKeyG2 = sw_bn254.NewG2Affine(point)
+	assertNotSolved(t, placeholder, full)
+}
+
+func TestCircuitV3Valid(t *testing.T) {
+	placeholder, assignment := newTestCircuit(t, CircuitVersionV3, genValset(3, []int{1}))
+	assertSolved(t, placeholder, assignment)
+}
+
+func TestCircuitV3WrongInputHash(t *testing.T) {
+	placeholder, assignment := newTestCircuit(t, CircuitVersionV3, genValset(3, []int{1}))
+	assignment.InputHash = new(big.Int).Add(assignment.InputHash.(*big.Int), big.NewInt(1))
+	assertNotSolved(t, placeholder, assignment)
+}
+
+func TestCircuitV4Valid(t *testing.T) {
+	placeholder, assignment := newTestCircuit(t, CircuitVersionV4, genValset(3, []int{1}))
+	assertSolved(t, placeholder, assignment)
+}
+
+func TestCircuitV4QuorumNotReached(t *testing.T) {
+	valset := genValset(3, []int{1})
+	placeholder, assignment := newTestCircuit(t, CircuitVersionV4, valset)
+
+	// two signers with 100 voting power each, the input hash binds the unreachable threshold
+	quorumThreshold := big.NewInt(201)
+	assignment.Quorum.QuorumThreshold = quorumThreshold
+	assignment.InputHash = QuorumInputHash(HashValset(padValset(valset, testCircuitSize)), big.NewInt(200), quorumThreshold, 7, 15, testMessageG1(t))
+	assertNotSolved(t, placeholder, assignment)
+}
+
+func TestCircuitV4EpochReplay(t *testing.T) {
+	placeholder, assignment := newTestCircuit(t, CircuitVersionV4, genValset(3, []int{1}))
+	assignment.Quorum.Epoch = 8
+	assertNotSolved(t, placeholder, assignment)
+}
+
+func TestCircuitV5Valid(t *testing.T) {
+	placeholder, assignment := newTestCircuit(t, CircuitVersionV5, genValset(3, []int{1}))
+	assertSolved(t, placeholder, assignment)
+}
+
+func TestCircuitV5MimcValsetHash(t *testing.T) {
+	valset := genValset(3, []int{1})
+	placeholder, assignment := newTestCircuit(t, CircuitVersionV5, valset)
+
+	// a V5 circuit only accepts the Poseidon2 valset hash
+	assignment.InputHash = QuorumInputHash(HashValset(padValset(valset, testCircuitSize)), big.NewInt(200), big.NewInt(100), 7, 15, testMessageG1(t))
+	assertNotSolved(t, placeholder, assignment)
+}
+
+func TestCircuitSplitInputHashValid(t *testing.T) {
+	for _, version := range []CircuitVersion{CircuitVersionV3, CircuitVersionV4} {
+		placeholder, assignment := newTestCircuitWithConfig(t, Config{CircuitVersion: version, SplitInputHash: true}, genValset(3, []int{1}))
+		assertSolved(t, placeholder, assignment)
+	}
+}
+
+func TestCircuitSplitInputHashTopBits(t *testing.T) {
+	placeholder, assignment := newTestCircuitWithConfig(t, Config{CircuitVersion: CircuitVersionV3, SplitInputHash: true}, genValset(3, []int{1}))
+
+	// flipping the top bit of the digest is invisible to the masked layout but not to the split one
+	hi := assignment.InputHash.(*big.Int)
+	assignment.InputHash = new(big.Int).Xor(hi, new(big.Int).Lsh(big.NewInt(1), 127))
+	assertNotSolved(t, placeholder, assignment)
+}
+
+func TestCircuitSplitInputHashWrongLo(t *testing.T) {
+	placeholder, assignment := newTestCircuitWithConfig(t, Config{CircuitVersion: CircuitVersionV3, SplitInputHash: true}, genValset(3, []int{1}))
+	assignment.InputHashLo.Value = new(big.Int).Add(assignment.InputHashLo.Value.(*big.Int), big.NewInt(1))
+	assertNotSolved(t, placeholder, assignment)
+}
+
+func TestCircuitSplitInputHashBeforeV3(t *testing.T) {
+	placeholder, assignment := newTestCircuitWithConfig(t, Config{CircuitVersion: CircuitVersionV2, SplitInputHash: true}, genValset(3, nil))
+	assertNotSolved(t, placeholder, assignment)
+}
+
+func TestCircuitHashToG1Valid(t *testing.T) {
+	for _, cfg := range []Config{
+		{CircuitVersion: CircuitVersionV3, HashToG1: true},
+		{CircuitVersion: CircuitVersionV4, SplitInputHash: true, HashToG1: true},
+	} {
+		placeholder, assignment := newTestCircuitWithConfig(t, cfg, genValset(3, []int{1}))
+		assertSolved(t, placeholder, assignment)
+	}
+}
+
+func TestCircuitHashToG1WrongMessage(t *testing.T) {
+	placeholder, assignment := newTestCircuitWithConfig(t, Config{CircuitVersion: CircuitVersionV3, HashToG1: true}, genValset(3, []int{1}))
+
+	// the input hash binds another message hash, which doesn't hash to the signed point
+	valset := padValset(genValset(3, []int{1}), testCircuitSize)
+	messageHash := testMessageHash
+	// BN254.hashToG1 maps neighbouring hashes to the same point, so change a high byte
+	messageHash[0] ^= 1
+	assignment.MessageHash.Value[0] = uints.NewU8(messageHash[0])
+	assignment.InputHash = maskInputHash(inputDigest(HashValset(valset), big.NewInt(200), messageHash[:]))
+	assertNotSolved(t, placeholder, assignment)
+}
+
+func TestCircuitSignerBitmapValid(t *testing.T) {
+	for _, cfg := range []Config{
+		{CircuitVersion: CircuitVersionV3, SignerBitmap: true},
+		{CircuitVersion: CircuitVersionV4, SplitInputHash: true, HashToG1: true, SignerBitmap: true},
+	} {
+		placeholder, assignment := newTestCircuitWithConfig(t, cfg, genValset(3, []int{1}))
+		assertSolved(t, placeholder, assignment)
+	}
+}
+
+func TestCircuitSignerBitmapClaimsNonSigner(t *testing.T) {
+	placeholder, assignment := newTestCircuitWithConfig(t, Config{CircuitVersion: CircuitVersionV3, SignerBitmap: true}, genValset(3, []int{1}))
+
+	// the input hash commits to a bitmap in which the non-signer at index 1 signed
+	valset := padValset(genValset(3, []int{1}), testCircuitSize)
+	bitmap := signerBitmap(valset)
+	bitmap[0] |= 1 << 1
+	messageG1 := testMessageG1(t)
+	message := messageG1.RawBytes()
+	bitmapHash := SignerBitmapHash(bitmap)
+	assignment.InputHash = maskInputHash(inputDigest(HashValset(valset), big.NewInt(200), append(bitmapHash.Bytes(), message[:]...)))
+	assertNotSolved(t, placeholder, assignment)
+}
+
+func TestCircuitCompressedKeysValid(t *testing.T) {
+	for _, cfg := range []Config{
+		{CircuitVersion: CircuitVersionV3, CompressedKeys: true},
+		{CircuitVersion: CircuitVersionV5, SignerBitmap: true, CompressedKeys: true},
+	} {
+		placeholder, assignment := newTestCircuitWithConfig(t, cfg, genValset(3, []int{1}))
+		assertSolved(t, placeholder, assignment)
+	}
+}
+
+func TestCircuitCompressedKeysFlippedSign(t *testing.T) {
+	valset := padValset(genValset(3, []int{1}), testCircuitSize)
+	placeholder, assignment := newTestCircuitWithConfig(t, Config{CircuitVersion: CircuitVersionV3, CompressedKeys: true}, valset)
+
+	// the commitment binds the negated key of validator 0, which signed with the original key
+	negated := make([]ValidatorData, len(valset))
+	copy(negated, valset)
+	negated[0].Key.Neg(&negated[0].Key)
+	setCompressedValidatorData(&assignment.CompressedValidatorData, negated)
+	messageG1 := testMessageG1(t)
+	assignment.InputHash = inputHash(HashValsetWith(ValsetHashKeccak256, negated), big.NewInt(200), messageG1)
+	assertNotSolved(t, placeholder, assignment)
+}
+
+// TestCircuitCompressedKeysActivePrefix checks the circuit commits to the keccak hash of the active validators
+// only, the hash genesis and the Simple proof commit to, for every number of validators of the tier.
+func TestCircuitCompressedKeysActivePrefix(t *testing.T) {
+	cfg := Config{CircuitVersion: CircuitVersionV3, CompressedKeys: true}
+	messageG1 := testMessageG1(t)
+	for n := 1; n <= testCircuitSize; n++ {
+		valset := genValset(n, nil)
+		placeholder, assignment := newTestCircuitWithConfig(t, cfg, valset)
+		assignment.InputHash = inputHash(HashValsetWith(ValsetHashKeccak256, valset), big.NewInt(int64(100*n)), messageG1)
+		assertSolved(t, placeholder, assignment)
+	}
+
+	// the hash of the valset padded to the tier, with the tier as the length word, is rejected
+	valset := genValset(3, nil)
+	placeholder, assignment := newTestCircuitWithConfig(t, cfg, valset)
+	preimage := make([]byte, 32, 32+64*testCircuitSize)
+	big.NewInt(testCircuitSize).FillBytes(preimage)
+	for _, validator := range padValset(valset, testCircuitSize) {
+		compressed := CompressKey(validator.Key)
+		votingPower := make([]byte, 32)
+		validator.VotingPower.FillBytes(votingPower)
+		preimage = append(append(preimage, compressed[:]...), votingPower...)
+	}
+	assignment.InputHash = inputHash(crypto.Keccak256(preimage), big.NewInt(300), messageG1)
+	assertNotSolved(t, placeholder, assignment)
+}
+
+// addKeyCircuit asserts that addKey returns Sum and IsZero for Acc, AccIsZero and Key.
+type addKeyCircuit struct {
+	Acc       sw_bn254.G1Affine
+	AccIsZero frontend.Variable
+	Key       sw_bn254.G1Affine
+	Sum       sw_bn254.G1Affine
+	IsZero    frontend.Variable
+}
+
+func (c *addKeyCircuit) Define(api frontend.API) error {
+	apis, err := newCircuitApis(api, true)
+	if err != nil {
+		return err
+	}
+	sum, isZero, err := apis.addKey(&c.Acc, c.AccIsZero, &c.Key)
+	if err != nil {
+		return err
+	}
+	apis.curve.AssertIsEqual(sum, &c.Sum)
+	api.AssertIsEqual(isZero, c.IsZero)
+	return nil
+}
+
+func TestAddKey(t *testing.T) {
+	_, _, g1, _ := bn254.Generators()
+	var p, q, omegaP, negP bn254.G1Affine
+	p.ScalarMultiplication(&g1, big.NewInt(5))
+	q.ScalarMultiplication(&g1, big.NewInt(7))
+	negP.Neg(&p)
+	// the endomorphism point shares Y with -p, the case AddUnified maps to the infinity
+	var omega fp.Element
+	omega.SetString("2203960485148121921418603742825762020974279258880205651966")
+	omegaP.X.Mul(&p.X, &omega)
+	omegaP.Y.Neg(&p.Y)
+	if !omegaP.IsOnCurve() {
+		t.Fatal("endomorphism point not on curve")
+	}
+
+	sum := func(a, b *bn254.G1Affine) bn254.G1Affine {
+		var jac bn254.G1Jac
+		jac.FromAffine(a)
+		jac.AddMixed(b)
+		var res bn254.G1Affine
+		res.FromJacobian(&jac)
+		return res
+	}
+	for _, tc := range []struct {
+		name      string
+		acc, key  bn254.G1Affine
+		accIsZero int
+	}{
+		{"distinct", p, q, 0},
+		{"double", p, p, 0},
+		{"negated", p, negP, 0},
+		{"endomorphism", p, omegaP, 0},
+		{"from zero", bn254.G1Affine{}, q, 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			expected := sum(&tc.acc, &tc.key)
+			assignment := &addKeyCircuit{
+				Acc:       sw_bn254.NewG1Affine(tc.acc),
+				AccIsZero: tc.accIsZero,
+				Key:       sw_bn254.NewG1Affine(tc.key),
+				Sum:       sw_bn254.NewG1Affine(expected),
+				IsZero:    0,
+			}
+			if expected.IsInfinity() {
+				assignment.IsZero = 1
+			}
+			if err := test.IsSolved(&addKeyCircuit{}, assignment, ecc.BN254.ScalarField()); err != nil {
+				t.Fatalf("expected circuit to be solved: %v", err)
+			}
+		})
+	}
+}
+
+// BenchmarkCompile reports the number of R1CS constraints per circuit version and tier, e.g.
+//
+//	go test -run '^$' -bench Compile -benchtime 1x ./pkg/proof
+func BenchmarkCompile(b *testing.B) {
+	for _, version := range []CircuitVersion{CircuitVersionV1, CircuitVersionV2, CircuitVersionV3, CircuitVersionV4, CircuitVersionV5} {
+		for _, tier := range []int{10, 100, 1000} {
+			b.Run(fmt.Sprintf("v%d/%d", version, tier), func(b *testing.B) {
+				var nbConstraints int
+				for range b.N {
+					cs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, newCircuit(Config{CircuitVersion: version}, tier))
+					if err != nil {
+						b.Fatal(err)
+					}
+					nbConstraints = cs.GetNbConstraints()
+				}
+				b.ReportMetric(float64(nbConstraints), "constraints")
+			})
+		}
+	}
+}
@@ -0,0 +1,257 @@
+package proof
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/consensys/gnark/constraint/solver"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/std/permutation/keccakf"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func init() {
+	solver.RegisterHint(decompressKeyHint)
+}
+
+// CompressedValidatorCircuit is a validator of a compressed-key circuit. The key is the 32-byte KeyBlsBn254
+// encoding, (X << 1) | sign, where the sign bit is set if Y is not the root BN254.findYFromX returns.
+type CompressedValidatorCircuit struct {
+	CompressedKey [32]uints.U8
+	VotingPower   frontend.Variable
+	IsNonSigner   frontend.Variable
+}
+
+// CompressKey mirrors KeyBlsBn254.serialize. The zero point stays all zeros.
+func CompressKey(key bn254.G1Affine) [32]byte {
+	var compressed [32]byte
+	if key.IsInfinity() {
+		return compressed
+	}
+	x := key.X.BigInt(new(big.Int))
+	encoded := new(big.Int).Lsh(x, 1)
+	if !isSquare(&key.Y) {
+		encoded.SetBit(encoded, 0, 1)
+	}
+	encoded.FillBytes(compressed[:])
+	return compressed
+}
+
+// isSquare reports whether y is the root (x^3+3)^((p+1)/4) BN254.findYFromX returns. As p = 3 mod 4, that
+// root is the one of y and -y that is a square.
+func isSquare(y *fp.Element) bool {
+	return y.Legendre() >= 0
+}
+
+// hashValsetKeccak256 computes the SigVerifierBlsBn254Simple commitment of a normalized valset:
+//
+//	keccak256(n || compressedKey_0 || votingPower_0 || ... || compressedKey_n-1 || votingPower_n-1)
+//
+// with every entry a 32-byte word, the validators data the Simple verifier hashes from its proof. Like
+// HashValset it stops at the first filler entry, so a valset padded to its tier hashes like the unpadded one.
+func hashValsetKeccak256(valset []ValidatorData) []byte {
+	n := len(valset)
+	for i := range valset {
+		if valset[i].Key.IsInfinity() {
+			n = i
+			break
+		}
+	}
+	valset = valset[:n]
+
+	preimage := make([]byte, 32, 32+64*len(valset))
+	big.NewInt(int64(len(valset))).FillBytes(preimage)
+	for i := range valset {
+		compressed := CompressKey(valset[i].Key)
+		votingPowerBuf := make([]byte, 32)
+		valset[i].VotingPower.FillBytes(votingPowerBuf)
+		preimage = append(preimage, compressed[:]...)
+		preimage = append(preimage, votingPowerBuf...)
+	}
+	return crypto.Keccak256(preimage)
+}
+
+func setCompressedValidatorData(circuitData *[]CompressedValidatorCircuit, valset []ValidatorData) {
+	*circuitData = make([]CompressedValidatorCircuit, len(valset))
+	for i := range valset {
+		compressed := CompressKey(valset[i].Key)
+		for j := range compressed {
+			(*circuitData)[i].CompressedKey[j] = uints.NewU8(compressed[j])
+		}
+		(*circuitData)[i].VotingPower = valset[i].VotingPower
+		(*circuitData)[i].IsNonSigner = 0
+		if valset[i].IsNonSigner {
+			(*circuitData)[i].IsNonSigner = 1
+		}
+	}
+}
+
+// decompressValset turns compressed validators into the validators the valset loop runs on. X comes from the
+// encoding and Y from a hint. absorb checks the point is on the curve, and a root hint pins the sign: y must be
+// a square for a clear sign bit and -y for a set one. The all-zero encoding is the filler (0, 0).
+func (a *circuitApis) decompressValset(compressed []CompressedValidatorCircuit) ([]ValidatorDataCircuit, error) {
+	api := a.api
+	zero := a.fieldFp.Zero()
+	validators := make([]ValidatorDataCircuit, len(compressed))
+	for i := range compressed {
+		key := compressed[i].CompressedKey[:]
+		keyBits := make([]frontend.Variable, 0, 8*len(key))
+		for j := len(key) - 1; j >= 0; j-- {
+			keyBits = append(keyBits, bits.ToBinary(api, key[j].Val, bits.WithNbDigits(8))...)
+		}
+		sign := keyBits[0]
+		x := a.fieldFp.FromBits(keyBits[1:]...)
+		// a canonical X keeps the encoding injective, as KeyBlsBn254.wrap requires
+		a.fieldFp.AssertIsInRange(x)
+
+		hint, err := a.fieldFp.NewHintWithNativeInput(decompressKeyHint, 2, bytesToVariable(api, key[:16]), bytesToVariable(api, key[16:]))
+		if err != nil {
+			return nil, err
+		}
+		y, root := hint[0], hint[1]
+
+		isFiller := api.And(a.fieldFp.IsZero(x), api.IsZero(sign))
+		signedY := a.fieldFp.Select(sign, a.fieldFp.Neg(y), y)
+		a.fieldFp.AssertIsEqual(a.fieldFp.Select(isFiller, zero, a.fieldFp.Sub(a.fieldFp.Mul(root, root), signedY)), zero)
+
+		validators[i] = ValidatorDataCircuit{
+			Key:         sw_bn254.G1Affine{X: *x, Y: *a.fieldFp.Select(isFiller, zero, y)},
+			VotingPower: compressed[i].VotingPower,
+			IsNonSigner: compressed[i].IsNonSigner,
+		}
+	}
+	return validators, nil
+}
+
+// keccakRate is the rate of keccak256 in bytes.
+const keccakRate = 136
+
+// compressedValsetHash computes the keccak commitment of hashValsetKeccak256 over the active validators. Hardened
+// circuits only allow fillers as a suffix, so with n active validators the preimage is the length word n followed
+// by the first n entries. The sponge runs over the words of the whole tier: entries past n are zeroed, the keccak
+// padding is placed after the n-th entry and the digest is read from the state after its block. The voting powers
+// are range checked by absorb already.
+func (a *circuitApis) compressedValsetHash(compressed []CompressedValidatorCircuit, isFiller []frontend.Variable) ([]uints.U8, error) {
+	api := a.api
+	tier := len(compressed)
+
+	// isLast[k] is set iff exactly k validators are active, the fillers being a suffix
+	active := make([]frontend.Variable, tier+1)
+	active[0] = 1
+	for i := range tier {
+		active[i+1] = api.Sub(1, isFiller[i])
+	}
+	active = append(active, 0)
+	isLast := make([]frontend.Variable, tier+1)
+	nbActive := frontend.Variable(0)
+	for k := range isLast {
+		isLast[k] = api.Sub(active[k], active[k+1])
+		nbActive = api.Add(nbActive, active[k+1])
+	}
+
+	// the key bytes are checked by decompressValset and the voting power bytes by their decomposition, so the
+	// masked entries and the padding, which only lands on zeroed bytes, are bytes as well
+	nbActiveBytes, err := variableToBytesLookup(api, a.u64, a.rangeChecker, nbActive, 16)
+	if err != nil {
+		return nil, err
+	}
+	var message []frontend.Variable
+	for _, b := range nbActiveBytes {
+		message = append(message, b.Val)
+	}
+	for i := range compressed {
+		entry := make([]frontend.Variable, 0, 64)
+		for j := range compressed[i].CompressedKey {
+			entry = append(entry, compressed[i].CompressedKey[j].Val)
+		}
+		votingPowerBytes, err := variableToBytesLookup(api, a.u64, a.rangeChecker, compressed[i].VotingPower, MaxVotingPowerBits)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range votingPowerBytes {
+			entry = append(entry, b.Val)
+		}
+		for j := range entry {
+			message = append(message, api.Mul(entry[j], active[i+1]))
+		}
+	}
+
+	// the padding of k active validators: 0x01 after the message and 0x80 at the end of its last block
+	lastBlock := func(k int) int { return (32 + 64*k) / keccakRate }
+	nbBlocks := lastBlock(tier) + 1
+	padded := make([]uints.U8, nbBlocks*keccakRate)
+	for j := range padded {
+		padded[j] = uints.U8{Val: 0}
+		if j < len(message) {
+			padded[j].Val = message[j]
+		}
+	}
+	for k := range isLast {
+		padded[32+64*k].Val = api.Add(padded[32+64*k].Val, isLast[k])
+		end := (lastBlock(k)+1)*keccakRate - 1
+		padded[end].Val = api.Add(padded[end].Val, api.Mul(isLast[k], 0x80))
+	}
+
+	// the digest is the first 32 bytes of the state after the last block of the message
+	var state [25]uints.U64
+	for i := range state {
+		state[i] = uints.NewU64(0)
+	}
+	digest := make([]frontend.Variable, 32)
+	for j := range digest {
+		digest[j] = 0
+	}
+	for b := range nbBlocks {
+		for i := range keccakRate / 8 {
+			offset := b*keccakRate + 8*i
+			state[i] = a.u64.Xor(state[i], a.u64.PackLSB(padded[offset:offset+8]...))
+		}
+		state = keccakf.Permute(a.u64, state)
+
+		isDigest := frontend.Variable(0)
+		for k := range isLast {
+			if lastBlock(k) == b {
+				isDigest = api.Add(isDigest, isLast[k])
+			}
+		}
+		for i := range 4 {
+			for j, lane := range a.u64.UnpackLSB(state[i]) {
+				digest[8*i+j] = api.Add(digest[8*i+j], api.Mul(isDigest, lane.Val))
+			}
+		}
+	}
+	res := make([]uints.U8, len(digest))
+	for j := range res {
+		res[j] = uints.U8{Val: digest[j]}
+	}
+	return res, nil
+}
+
+// decompressKeyHint returns y and the square root of the one of y and -y that is a square, for the compressed
+// key given as its high and low 128 bits. Fillers get zeros.
+func decompressKeyHint(_ *big.Int, inputs, outputs []*big.Int) error {
+	return emulated.UnwrapHintWithNativeInput(inputs, outputs, func(p *big.Int, inputs, outputs []*big.Int) error {
+		encoded := new(big.Int).Lsh(inputs[0], 128)
+		encoded.Add(encoded, inputs[1])
+		if encoded.Sign() == 0 {
+			outputs[0].SetUint64(0)
+			outputs[1].SetUint64(0)
+			return nil
+		}
+
+		// the root findYFromX returns is a square itself, and the sign bit negates it
+		x := new(big.Int).Rsh(encoded, 1)
+		root := new(big.Int).Exp(curveEquation(x), sqrtExponent, p)
+		outputs[0].Set(root)
+		if encoded.Bit(0) == 1 {
+			outputs[0].Neg(root).Mod(outputs[0], p)
+		}
+		outputs[1].Exp(root, sqrtExponent, p)
+		return nil
+	})
+}
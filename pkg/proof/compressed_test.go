@@ -0,0 +1,42 @@
+package proof
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+)
+
+func TestCompressKey(t *testing.T) {
+	for _, validator := range genValset(4, nil) {
+		key := validator.Key
+		compressed := CompressKey(key)
+		encoded := new(big.Int).SetBytes(compressed[:])
+		if x := new(big.Int).Rsh(encoded, 1); x.Cmp(key.X.BigInt(new(big.Int))) != 0 {
+			t.Fatalf("expected X %s, got %s", key.X.String(), x)
+		}
+
+		// the sign bit is clear for the root BN254.findYFromX returns
+		root := new(big.Int).Exp(curveEquation(key.X.BigInt(new(big.Int))), sqrtExponent, fp.Modulus())
+		wantSign := uint(0)
+		if root.Cmp(key.Y.BigInt(new(big.Int))) != 0 {
+			wantSign = 1
+		}
+		if encoded.Bit(0) != wantSign {
+			t.Fatalf("expected sign bit %d", wantSign)
+		}
+
+		var negated = key
+		negated.Neg(&key)
+		negatedCompressed := CompressKey(negated)
+		if new(big.Int).SetBytes(negatedCompressed[:]).Bit(0) == wantSign {
+			t.Fatal("expected the negated key to flip the sign bit")
+		}
+	}
+
+	var zero [32]byte
+	filler := padValset(nil, 1)[0].Key
+	if CompressKey(filler) != zero {
+		t.Fatal("expected the zero key to compress to zeros")
+	}
+}
@@ -0,0 +1,344 @@
+package proof
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	mimc_native "github.com/consensys/gnark-crypto/ecc/bn254/fr/mimc"
+	"github.com/consensys/gnark/test"
+	"github.com/go-errors/errors"
+)
+
+// DiagnosticStage names a section of the circuit Diagnose checks.
+type DiagnosticStage string
+
+const (
+	// StageValset covers the tier, the normalization and the hardened per-validator checks, and the valset hash.
+	StageValset DiagnosticStage = "valset"
+	// StageSigners covers the signers' aggregated voting power and key, and the V4 quorum check.
+	StageSigners DiagnosticStage = "signers"
+	// StageInputHash covers the byte decompositions, hash to G1 and the keccak input hash.
+	StageInputHash DiagnosticStage = "input hash"
+	// StageKeys covers ValidateKeys, which Prove runs before building the witness.
+	StageKeys DiagnosticStage = "keys"
+	// StageSignature covers the group checks of the signed points and the alpha-weighted pairing.
+	StageSignature DiagnosticStage = "signature"
+	// StageSolver runs gnark's test engine on the assignment Prove would build.
+	StageSolver DiagnosticStage = "solver"
+)
+
+// StageResult is the outcome of one stage. Values holds what the stage computed natively and, if it failed, the
+// offending values.
+type StageResult struct {
+	Stage  DiagnosticStage
+	Err    error // nil if the stage passed
+	Values map[string]string
+}
+
+// DiagnosticReport is the result of Diagnose: the stages run in circuit order, the last one being the first to
+// fail if any did.
+type DiagnosticReport struct {
+	Tier   Tier
+	Stages []StageResult
+}
+
+// Failed returns the failed stage, or nil if every stage passed.
+func (r DiagnosticReport) Failed() *StageResult {
+	for i := range r.Stages {
+		if r.Stages[i].Err != nil {
+			return &r.Stages[i]
+		}
+	}
+	return nil
+}
+
+// Err returns the error of the failed stage, or nil if every stage passed.
+func (r DiagnosticReport) Err() error {
+	failed := r.Failed()
+	if failed == nil {
+		return nil
+	}
+	return errors.Errorf("%s stage failed: %w", failed.Stage, failed.Err)
+}
+
+// Diagnose runs native equivalents of the sections of the configured circuit on proveInput, then gnark's test
+// engine on the witness, and stops at the first failing stage. A failed Prove only tells that the constraint
+// system isn't satisfied, the report tells which section breaks and on which values.
+func Diagnose(cfg Config, proveInput ProveInput) DiagnosticReport {
+	d := diagnosis{cfg: cfg, input: proveInput}
+	for _, stage := range []struct {
+		name DiagnosticStage
+		run  func(values map[string]string) error
+	}{
+		{StageValset, d.valset},
+		{StageSigners, d.signers},
+		{StageInputHash, d.inputHash},
+		{StageKeys, d.keys},
+		{StageSignature, d.signature},
+		{StageSolver, d.solver},
+	} {
+		result := StageResult{Stage: stage.name, Values: make(map[string]string)}
+		result.Err = stage.run(result.Values)
+		d.report.Stages = append(d.report.Stages, result)
+		if result.Err != nil {
+			break
+		}
+	}
+	return d.report
+}
+
+// Diagnose runs Diagnose with the configuration of the prover. It needs no keys.
+func (p *ZkProver) Diagnose(proveInput ProveInput) DiagnosticReport {
+	return Diagnose(p.cfg, proveInput)
+}
+
+// diagnosis carries the values the stages compute for the later ones.
+type diagnosis struct {
+	cfg    Config
+	input  ProveInput
+	report DiagnosticReport
+
+	normalized            []ValidatorData
+	valsetHash            []byte
+	signersAggVotingPower *big.Int
+	signersAggKey         bn254.G1Affine
+	message               bn254.G1Affine
+}
+
+func (d *diagnosis) valset(values map[string]string) error {
+	values["validators"] = strconv.Itoa(len(d.input.ValidatorData))
+	tier, err := SelectTier(len(d.input.ValidatorData))
+	if err != nil {
+		return err
+	}
+	d.report.Tier = tier
+	values["tier"] = strconv.Itoa(int(tier))
+
+	for i, validator := range d.input.ValidatorData {
+		if validator.VotingPower == nil || validator.VotingPower.Sign() < 0 {
+			values["validator"] = strconv.Itoa(i)
+			return errors.Errorf("validator %d has no non-negative voting power", i)
+		}
+		// every version hashes the voting power as a 32-byte word
+		if validator.VotingPower.BitLen() > 256 {
+			values["validator"] = strconv.Itoa(i)
+			values["votingPower"] = validator.VotingPower.String()
+			return errors.Errorf("voting power of validator %d exceeds 256 bits", i)
+		}
+		if d.cfg.CircuitVersion < CircuitVersionV2 {
+			continue
+		}
+		if validator.VotingPower.BitLen() > MaxVotingPowerBits {
+			values["validator"] = strconv.Itoa(i)
+			values["votingPower"] = validator.VotingPower.String()
+			return errors.Errorf("voting power of validator %d exceeds %d bits", i, MaxVotingPowerBits)
+		}
+		if !validator.Key.IsOnCurve() {
+			values["validator"] = strconv.Itoa(i)
+			values["key"] = validator.Key.String()
+			return errors.Errorf("key of validator %d is not on the curve", i)
+		}
+	}
+	if d.normalized, err = NormalizeValset(d.cfg.CircuitVersion, d.input.ValidatorData); err != nil {
+		return err
+	}
+
+	d.valsetHash = HashValsetWith(d.cfg.ValsetHash(), d.normalized)
+	values["valsetHashFunction"] = d.cfg.ValsetHash().String()
+	values["valsetHash"] = "0x" + hex.EncodeToString(d.valsetHash)
+	return nil
+}
+
+func (d *diagnosis) signers(values map[string]string) error {
+	d.signersAggVotingPower = new(big.Int)
+	signers := 0
+	for i := range d.normalized {
+		validator := &d.normalized[i]
+		// fillers have no voting power and the point at infinity as key
+		if validator.IsNonSigner || validator.Key.IsInfinity() {
+			continue
+		}
+		signers++
+		d.signersAggVotingPower.Add(d.signersAggVotingPower, validator.VotingPower)
+		d.signersAggKey.Add(&d.signersAggKey, &validator.Key)
+	}
+	values["signers"] = strconv.Itoa(signers)
+	values["signersAggVotingPower"] = d.signersAggVotingPower.String()
+	values["signersAggKey"] = d.signersAggKey.String()
+
+	if d.cfg.CircuitVersion < CircuitVersionV4 {
+		return nil
+	}
+	if d.input.QuorumThreshold == nil {
+		return errors.Errorf("quorum threshold is required by circuit version %d", d.cfg.CircuitVersion)
+	}
+	values["quorumThreshold"] = d.input.QuorumThreshold.String()
+	if d.signersAggVotingPower.Cmp(d.input.QuorumThreshold) < 0 {
+		values["missingVotingPower"] = new(big.Int).Sub(d.input.QuorumThreshold, d.signersAggVotingPower).String()
+		return errors.Errorf("signers voting power %s is below quorum threshold %s", d.signersAggVotingPower, d.input.QuorumThreshold)
+	}
+	return nil
+}
+
+func (d *diagnosis) inputHash(values map[string]string) error {
+	// every version hashes the signers voting power as a 32-byte word
+	if !isWord(d.signersAggVotingPower) {
+		values["signersAggVotingPower"] = d.signersAggVotingPower.String()
+		return errors.Errorf("signers voting power exceeds 256 bits")
+	}
+	// the lookup decompositions of optimized circuits bound the words they write
+	if d.cfg.CircuitVersion >= CircuitVersionV3 && d.signersAggVotingPower.BitLen() > signersVotingPowerBits {
+		values["signersAggVotingPower"] = d.signersAggVotingPower.String()
+		return errors.Errorf("signers voting power exceeds %d bits", signersVotingPowerBits)
+	}
+	if d.cfg.CircuitVersion >= CircuitVersionV4 {
+		if d.input.QuorumThreshold.Sign() < 0 || d.input.QuorumThreshold.BitLen() > signersVotingPowerBits {
+			values["quorumThreshold"] = d.input.QuorumThreshold.String()
+			return errors.Errorf("quorum threshold is not a %d-bit word", signersVotingPowerBits)
+		}
+		if d.input.Epoch >= 1<<epochBits {
+			values["epoch"] = strconv.FormatUint(d.input.Epoch, 10)
+			return errors.Errorf("epoch exceeds %d bits", epochBits)
+		}
+	}
+
+	d.message = d.input.MessageG1
+	if d.cfg.HashToG1 {
+		values["messageHash"] = "0x" + hex.EncodeToString(d.input.MessageHash[:])
+		messageG1, counter := HashToG1(d.input.MessageHash)
+		if counter >= hashToG1MaxAttempts {
+			values["counter"] = strconv.FormatUint(counter, 10)
+			return errors.Errorf("hash to G1 needs %d increments, at most %d are supported", counter, hashToG1MaxAttempts-1)
+		}
+		if !d.input.MessageG1.IsInfinity() && !d.input.MessageG1.Equal(&messageG1) {
+			values["messageG1"] = d.input.MessageG1.String()
+			values["hashToG1"] = messageG1.String()
+			return errors.Errorf("message G1 is not the hash of the message hash")
+		}
+		d.message = messageG1
+	}
+	values["message"] = d.message.String()
+
+	ctx := InputContext{
+		ValsetHash:      d.valsetHash,
+		MessageG1:       d.message,
+		MessageHash:     d.input.MessageHash,
+		QuorumThreshold: d.input.QuorumThreshold,
+		Epoch:           d.input.Epoch,
+		KeyTag:          d.input.KeyTag,
+	}
+	if d.cfg.SignerBitmap {
+		ctx.SignerBitmap = signerBitmap(d.normalized)
+		values["signerBitmap"] = "0x" + hex.EncodeToString(ctx.SignerBitmap)
+	}
+	digest := d.cfg.PublicInputHash(d.signersAggVotingPower, ctx)
+	values["inputHashDigest"] = digest.Hex()
+	if d.cfg.SplitInputHash {
+		hi, lo := splitInputHash(digest.Bytes())
+		values["inputHashHi"] = hi.String()
+		values["inputHashLo"] = lo.String()
+	} else {
+		values["inputHash"] = maskInputHash(digest.Bytes()).String()
+	}
+	return nil
+}
+
+func (d *diagnosis) keys(values map[string]string) error {
+	report := ValidateKeys(d.input, KeyValidationOptions{})
+	for _, validator := range report.Invalid() {
+		values["validator "+strconv.Itoa(validator.Index)] = validator.Err.Error()
+	}
+	if report.Aggregate != nil {
+		values["signersAggKeyG2"] = d.input.SignersAggKeyG2.String()
+	}
+	return report.Err()
+}
+
+func (d *diagnosis) signature(values map[string]string) error {
+	signature, keyG2 := d.input.Signature, d.input.SignersAggKeyG2
+	values["signature"] = signature.String()
+	if d.cfg.CircuitVersion >= CircuitVersionV2 {
+		// the emulated (0, 0) encoding of the point at infinity is not on the curve
+		if !isG1Point(signature) {
+			return errors.Errorf("signature is not a point of G1")
+		}
+		if !isG1Point(d.message) {
+			return errors.Errorf("message is not a point of G1")
+		}
+		if keyG2.IsInfinity() || !keyG2.IsOnCurve() || !keyG2.IsInSubGroup() {
+			values["signersAggKeyG2"] = keyG2.String()
+			return errors.Errorf("signers' aggregated G2 key is not a point of G2")
+		}
+	}
+
+	alpha := signatureChallenge(signature, d.signersAggKey, keyG2, d.message)
+	values["alpha"] = alpha.String()
+
+	_, _, g1, g2 := bn254.Generators()
+	var lhs, rhs, term bn254.G1Affine
+	term.ScalarMultiplication(&d.signersAggKey, alpha)
+	lhs.Add(&signature, &term)
+	term.ScalarMultiplication(&g1, alpha)
+	rhs.Add(&d.message, &term)
+	var negG2 bn254.G2Affine
+	negG2.Neg(&g2)
+	ok, err := bn254.PairingCheck([]bn254.G1Affine{lhs, rhs}, []bn254.G2Affine{negG2, keyG2})
+	if err != nil {
+		return errors.Errorf("failed to check the pairing: %w", err)
+	}
+	if ok {
+		return nil
+	}
+
+	// tell a bad signature from keys that don't match
+	var negMessage bn254.G1Affine
+	negMessage.Neg(&d.message)
+	signatureOk, err := bn254.PairingCheck([]bn254.G1Affine{signature, negMessage}, []bn254.G2Affine{g2, keyG2})
+	values["signatureMatchesKeyG2"] = strconv.FormatBool(err == nil && signatureOk)
+	values["keyMatchesKeyG2"] = strconv.FormatBool(keysMatch(d.signersAggKey, keyG2, g1, g2))
+	return errors.Errorf("alpha-weighted pairing check fails")
+}
+
+func (d *diagnosis) solver(values map[string]string) error {
+	input := d.input
+	input.ValidatorData = d.normalized
+	input.MessageG1 = d.message
+	assignment := Circuit{Version: d.cfg.CircuitVersion, SplitInputHash: d.cfg.SplitInputHash, HashToG1: d.cfg.HashToG1, SignerBitmap: d.cfg.SignerBitmap, CompressedKeys: d.cfg.CompressedKeys}
+	setCircuitData(&assignment, input)
+
+	values["constraintsOf"] = fmt.Sprintf("v%d tier %d", d.cfg.CircuitVersion, d.report.Tier)
+	if err := test.IsSolved(newCircuit(d.cfg, int(d.report.Tier)), &assignment, ecc.BN254.ScalarField()); err != nil {
+		return errors.Errorf("constraints are not satisfied: %w", err)
+	}
+	return nil
+}
+
+func isG1Point(point bn254.G1Affine) bool {
+	return !point.IsInfinity() && point.IsOnCurve() && point.IsInSubGroup()
+}
+
+// signatureChallenge computes the challenge alpha of Circuit.signatureChallenge natively: the MiMC digest of the
+// 64-bit limbs, least significant first, of the signature, the signers' keys and the message. The digest is below
+// the scalar field modulus, so it is alpha as is.
+func signatureChallenge(signature, signersAggKey bn254.G1Affine, signersAggKeyG2 bn254.G2Affine, message bn254.G1Affine) *big.Int {
+	h := mimc_native.NewMiMC()
+	for _, e := range []*fp.Element{
+		&signature.X, &signature.Y,
+		&signersAggKey.X, &signersAggKey.Y,
+		&signersAggKeyG2.X.A0, &signersAggKeyG2.X.A1, &signersAggKeyG2.Y.A0, &signersAggKeyG2.Y.A1,
+		&message.X, &message.Y,
+	} {
+		b := e.Bytes()
+		// every limb is a block of its own, as in HashValset
+		h.Write(b[24:32])
+		h.Write(b[16:24])
+		h.Write(b[8:16])
+		h.Write(b[0:8])
+	}
+	return new(big.Int).SetBytes(h.Sum(nil))
+}
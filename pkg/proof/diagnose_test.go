@@ -0,0 +1,138 @@
+package proof
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+func assertFailedStage(t *testing.T, report DiagnosticReport, stage DiagnosticStage) *StageResult {
+	t.Helper()
+	failed := report.Failed()
+	if failed == nil {
+		t.Fatalf("expected the %s stage to fail, every stage passed", stage)
+	}
+	if failed.Stage != stage {
+		t.Fatalf("expected the %s stage to fail, got %v", stage, report.Err())
+	}
+	if last := report.Stages[len(report.Stages)-1]; last.Stage != stage {
+		t.Fatalf("ran the %s stage after the failed one", last.Stage)
+	}
+	return failed
+}
+
+func TestDiagnose(t *testing.T) {
+	cfg := Config{CircuitVersion: CircuitVersionV4}
+	input := newTestValidationInput(t, genValset(3, []int{1}))
+	report := Diagnose(cfg, input)
+	if err := report.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Stages) != 6 || report.Tier != Tier(MaxValidators[0]) {
+		t.Fatalf("got report %+v", report)
+	}
+	if vp := report.Stages[1].Values["signersAggVotingPower"]; vp != "200" {
+		t.Fatalf("got signers voting power %s", vp)
+	}
+}
+
+func TestDiagnoseFailures(t *testing.T) {
+	cfg := Config{CircuitVersion: CircuitVersionV4}
+	for name, tc := range map[string]struct {
+		cfg    Config
+		tamper func(input *ProveInput)
+		stage  DiagnosticStage
+	}{
+		"voting power too large": {cfg, func(input *ProveInput) {
+			input.ValidatorData[2].VotingPower = new(big.Int).Lsh(big.NewInt(1), MaxVotingPowerBits)
+		}, StageValset},
+		"voting power wider than a word": {DefaultConfig(), func(input *ProveInput) {
+			input.ValidatorData[1].VotingPower = new(big.Int).Lsh(big.NewInt(1), 300)
+		}, StageValset},
+		"quorum not reached": {cfg, func(input *ProveInput) { input.QuorumThreshold = big.NewInt(201) }, StageSigners},
+		"epoch too large":    {cfg, func(input *ProveInput) { input.Epoch = 1 << epochBits }, StageInputHash},
+		"message not hashed": {Config{CircuitVersion: CircuitVersionV4, HashToG1: true}, func(input *ProveInput) {
+			input.MessageHash = [32]byte{1}
+		}, StageInputHash},
+		"wrong aggregated G2 key": {cfg, func(input *ProveInput) {
+			input.SignersAggKeyG2 = input.ValidatorData[0].KeyG2
+		}, StageKeys},
+		"wrong signature": {cfg, func(input *ProveInput) {
+			_, _, g1, _ := bn254.Generators()
+			input.Signature.Add(&input.Signature, &g1)
+		}, StageSignature},
+	} {
+		t.Run(name, func(t *testing.T) {
+			input := newTestValidationInput(t, genValset(3, []int{1}))
+			if tc.cfg.HashToG1 {
+				input.MessageHash = testMessageHash
+			}
+			tc.tamper(&input)
+			failed := assertFailedStage(t, Diagnose(tc.cfg, input), tc.stage)
+			if tc.stage == StageValset && failed.Values["validator"] == "" {
+				t.Fatalf("no validator index in %v", failed.Values)
+			}
+		})
+	}
+}
+
+func TestDiagnoseSignature(t *testing.T) {
+	input := newTestValidationInput(t, genValset(3, []int{1}))
+	// a signature of another message by the signers
+	message, _ := HashToG1(testMessageHash)
+	signature, _, _ := getAggSignature(message, &input.ValidatorData)
+	input.Signature = *signature
+
+	failed := assertFailedStage(t, Diagnose(Config{CircuitVersion: CircuitVersionV3}, input), StageSignature)
+	if failed.Values["signatureMatchesKeyG2"] != "false" || failed.Values["keyMatchesKeyG2"] != "true" {
+		t.Fatalf("got values %v", failed.Values)
+	}
+}
+
+// signatureChallengeCircuit asserts that the in-circuit challenge equals Alpha.
+type signatureChallengeCircuit struct {
+	Optimized       bool `gnark:"-"`
+	Signature       sw_bn254.G1Affine
+	SignersAggKey   sw_bn254.G1Affine
+	SignersAggKeyG2 sw_bn254.G2Affine
+	Message         sw_bn254.G1Affine
+	Alpha           emulated.Element[emulated.BN254Fr]
+}
+
+func (c *signatureChallengeCircuit) Define(api frontend.API) error {
+	a, err := newCircuitApis(api, c.Optimized)
+	if err != nil {
+		return err
+	}
+	alpha, err := a.signatureChallenge(c.Optimized, signedInput{Signature: &c.Signature, SignersAggKeyG2: &c.SignersAggKeyG2, Message: &c.Message}, &c.SignersAggKey)
+	if err != nil {
+		return err
+	}
+	a.fieldFr.AssertIsEqual(alpha, &c.Alpha)
+	return nil
+}
+
+func TestSignatureChallenge(t *testing.T) {
+	input := newTestValidationInput(t, genValset(3, []int{1}))
+	_, _, signersAggKey := getAggSignature(input.MessageG1, &input.ValidatorData)
+	alpha := signatureChallenge(input.Signature, *signersAggKey, input.SignersAggKeyG2, input.MessageG1)
+
+	for _, optimized := range []bool{false, true} {
+		assignment := &signatureChallengeCircuit{
+			Signature:       sw_bn254.NewG1Affine(input.Signature),
+			SignersAggKey:   sw_bn254.NewG1Affine(*signersAggKey),
+			SignersAggKeyG2: sw_bn254.NewG2Affine(input.SignersAggKeyG2),
+			Message:         sw_bn254.NewG1Affine(input.MessageG1),
+			Alpha:           emulated.ValueOf[emulated.BN254Fr](alpha),
+		}
+		if err := test.IsSolved(&signatureChallengeCircuit{Optimized: optimized}, assignment, ecc.BN254.ScalarField()); err != nil {
+			t.Fatalf("optimized %t: %v", optimized, err)
+		}
+	}
+}
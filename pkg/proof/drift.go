@@ -0,0 +1,245 @@
+package proof
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/consensys/gnark/backend/groth16"
+	groth16_bn254 "github.com/consensys/gnark/backend/groth16/bn254"
+	"github.com/go-errors/errors"
+)
+
+// SolidityVerifyingKey is the verifying key embedded in a Groth16 Verifier_N.sol as exported by gnark. Beta, gamma
+// and delta are stored negated, as the verifier pairs them with the proof points directly.
+type SolidityVerifyingKey struct {
+	Alpha    bn254.G1Affine
+	BetaNeg  bn254.G2Affine
+	GammaNeg bn254.G2Affine
+	DeltaNeg bn254.G2Affine
+	// CommitmentKey is the Pedersen key of the single commitment gnark verifiers support, nil without commitments
+	CommitmentKey *SolidityCommitmentKey
+	// IC holds CONSTANT followed by PUB_0, PUB_1, ...
+	IC []bn254.G1Affine
+}
+
+// SolidityCommitmentKey is the Pedersen verifying key of a commitment.
+type SolidityCommitmentKey struct {
+	G         bn254.G2Affine
+	GSigmaNeg bn254.G2Affine
+}
+
+// VerifierDiff is a verifying-key constant whose value differs between a Solidity verifier and a verifying key.
+// A missing constant has a nil value on its side.
+type VerifierDiff struct {
+	Constant string   `json:"constant"`
+	Solidity *big.Int `json:"solidity"`
+	Key      *big.Int `json:"key"`
+}
+
+func (d VerifierDiff) String() string {
+	return fmt.Sprintf("%s: verifier %s, key %s", d.Constant, formatConstant(d.Solidity), formatConstant(d.Key))
+}
+
+func formatConstant(v *big.Int) string {
+	if v == nil {
+		return "missing"
+	}
+	return v.String()
+}
+
+// solidityConstant matches `uint256 constant NAME = VALUE;`, including values forge fmt wraps onto the next line
+// and groups with underscores.
+var solidityConstant = regexp.MustCompile(`uint256\s+constant\s+([A-Z][A-Z0-9_]*)\s*=\s*(0[xX][0-9a-fA-F_]+|[0-9_]+)\s*;`)
+
+// ParseSolidityVerifier extracts the verifying key from the source of a Groth16 Verifier_N.sol.
+func ParseSolidityVerifier(src []byte) (SolidityVerifyingKey, error) {
+	constants, err := parseVerifierConstants(src)
+	if err != nil {
+		return SolidityVerifyingKey{}, err
+	}
+
+	var key SolidityVerifyingKey
+	g1 := func(prefix string, p *bn254.G1Affine) error {
+		return setCoordinates(constants, []string{prefix + "_X", prefix + "_Y"}, &p.X, &p.Y)
+	}
+	g2 := func(prefix string, p *bn254.G2Affine) error {
+		return setCoordinates(constants, []string{prefix + "_X_0", prefix + "_X_1", prefix + "_Y_0", prefix + "_Y_1"}, &p.X.A0, &p.X.A1, &p.Y.A0, &p.Y.A1)
+	}
+	if err := g1("ALPHA", &key.Alpha); err != nil {
+		return SolidityVerifyingKey{}, err
+	}
+	if err := g2("BETA_NEG", &key.BetaNeg); err != nil {
+		return SolidityVerifyingKey{}, err
+	}
+	if err := g2("GAMMA_NEG", &key.GammaNeg); err != nil {
+		return SolidityVerifyingKey{}, err
+	}
+	if err := g2("DELTA_NEG", &key.DeltaNeg); err != nil {
+		return SolidityVerifyingKey{}, err
+	}
+	if _, ok := constants["PEDERSEN_G_X_0"]; ok {
+		key.CommitmentKey = &SolidityCommitmentKey{}
+		if err := g2("PEDERSEN_G", &key.CommitmentKey.G); err != nil {
+			return SolidityVerifyingKey{}, err
+		}
+		if err := g2("PEDERSEN_GSIGMANEG", &key.CommitmentKey.GSigmaNeg); err != nil {
+			return SolidityVerifyingKey{}, err
+		}
+	}
+
+	key.IC = make([]bn254.G1Affine, 1)
+	if err := g1("CONSTANT", &key.IC[0]); err != nil {
+		return SolidityVerifyingKey{}, err
+	}
+	for i := 0; ; i++ {
+		prefix := "PUB_" + strconv.Itoa(i)
+		if _, ok := constants[prefix+"_X"]; !ok {
+			break
+		}
+		var p bn254.G1Affine
+		if err := g1(prefix, &p); err != nil {
+			return SolidityVerifyingKey{}, err
+		}
+		key.IC = append(key.IC, p)
+	}
+	return key, nil
+}
+
+func parseVerifierConstants(src []byte) (map[string]*big.Int, error) {
+	constants := make(map[string]*big.Int)
+	for _, match := range solidityConstant.FindAllSubmatch(src, -1) {
+		name, literal := string(match[1]), strings.ReplaceAll(string(match[2]), "_", "")
+		value, ok := new(big.Int).SetString(literal, 0)
+		if !ok {
+			return nil, errors.Errorf("invalid value of %s: %s", name, match[2])
+		}
+		constants[name] = value
+	}
+	return constants, nil
+}
+
+func setCoordinates(constants map[string]*big.Int, names []string, coordinates ...*fp.Element) error {
+	for i, name := range names {
+		value, ok := constants[name]
+		if !ok {
+			return errors.Errorf("verifier has no constant %s", name)
+		}
+		if value.Cmp(fp.Modulus()) >= 0 {
+			return errors.Errorf("constant %s is not a canonical field element", name)
+		}
+		coordinates[i].SetBigInt(value)
+	}
+	return nil
+}
+
+// solidityVerifyingKey returns the key ExportSolidity embeds for vk.
+func solidityVerifyingKey(vk *groth16_bn254.VerifyingKey) SolidityVerifyingKey {
+	key := SolidityVerifyingKey{
+		Alpha: vk.G1.Alpha,
+		IC:    vk.G1.K,
+	}
+	key.BetaNeg.Neg(&vk.G2.Beta)
+	key.GammaNeg.Neg(&vk.G2.Gamma)
+	key.DeltaNeg.Neg(&vk.G2.Delta)
+	if len(vk.CommitmentKeys) > 0 {
+		key.CommitmentKey = &SolidityCommitmentKey{G: vk.CommitmentKeys[0].G, GSigmaNeg: vk.CommitmentKeys[0].GSigmaNeg}
+	}
+	return key
+}
+
+type namedConstant struct {
+	name  string
+	value *big.Int
+}
+
+// constants lists the key as the verifier constants, in the order of the exported file.
+func (k SolidityVerifyingKey) constants() []namedConstant {
+	var constants []namedConstant
+	add := func(name string, e fp.Element) {
+		constants = append(constants, namedConstant{name: name, value: e.BigInt(new(big.Int))})
+	}
+	g1 := func(prefix string, p bn254.G1Affine) {
+		add(prefix+"_X", p.X)
+		add(prefix+"_Y", p.Y)
+	}
+	g2 := func(prefix string, p bn254.G2Affine) {
+		add(prefix+"_X_0", p.X.A0)
+		add(prefix+"_X_1", p.X.A1)
+		add(prefix+"_Y_0", p.Y.A0)
+		add(prefix+"_Y_1", p.Y.A1)
+	}
+
+	g1("ALPHA", k.Alpha)
+	g2("BETA_NEG", k.BetaNeg)
+	g2("GAMMA_NEG", k.GammaNeg)
+	g2("DELTA_NEG", k.DeltaNeg)
+	if k.CommitmentKey != nil {
+		g2("PEDERSEN_G", k.CommitmentKey.G)
+		g2("PEDERSEN_GSIGMANEG", k.CommitmentKey.GSigmaNeg)
+	}
+	for i, p := range k.IC {
+		if i == 0 {
+			g1("CONSTANT", p)
+			continue
+		}
+		g1("PUB_"+strconv.Itoa(i-1), p)
+	}
+	return constants
+}
+
+// Diff returns the constants of k, the verifier side, that differ from other, the key side, in the order of the
+// exported file. Constants only one of the keys has, like extra public inputs or a missing commitment key, are
+// reported as missing on the other side.
+func (k SolidityVerifyingKey) Diff(other SolidityVerifyingKey) []VerifierDiff {
+	otherConstants := make(map[string]*big.Int)
+	for _, c := range other.constants() {
+		otherConstants[c.name] = c.value
+	}
+
+	var diffs []VerifierDiff
+	for _, c := range k.constants() {
+		otherValue, ok := otherConstants[c.name]
+		delete(otherConstants, c.name)
+		if !ok || c.value.Cmp(otherValue) != 0 {
+			diffs = append(diffs, VerifierDiff{Constant: c.name, Solidity: c.value, Key: otherValue})
+		}
+	}
+	for _, c := range other.constants() {
+		if _, ok := otherConstants[c.name]; ok {
+			diffs = append(diffs, VerifierDiff{Constant: c.name, Key: c.value})
+		}
+	}
+	return diffs
+}
+
+// CompareSolidityVerifier compares the verifying key embedded in the source of a Verifier_N.sol with vk, and
+// returns every constant that differs. No differences means proofs of vk verify on that contract.
+func CompareSolidityVerifier(src []byte, vk groth16.VerifyingKey) ([]VerifierDiff, error) {
+	bn254Vk, ok := vk.(*groth16_bn254.VerifyingKey)
+	if !ok {
+		return nil, errors.Errorf("verifying key is not a BN254 key")
+	}
+	solidityKey, err := ParseSolidityVerifier(src)
+	if err != nil {
+		return nil, err
+	}
+	return solidityKey.Diff(solidityVerifyingKey(bn254Vk)), nil
+}
+
+// CheckVerifierDrift compares a deployed Verifier_N.sol of tier with the verifying key of the configured circuit
+// on disk.
+func CheckVerifierDrift(cfg Config, tier Tier, src []byte) ([]VerifierDiff, error) {
+	if cfg.Backend != BackendGroth16 {
+		return nil, errors.Errorf("drift detection supports groth16 verifiers only")
+	}
+	vk, err := readVerifyingKey(cfg.artifactsDir(), strconv.Itoa(int(tier)))
+	if err != nil {
+		return nil, err
+	}
+	return CompareSolidityVerifier(src, vk)
+}
@@ -0,0 +1,85 @@
+package proof
+
+import (
+	"bytes"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestCompareSolidityVerifier(t *testing.T) {
+	vk, err := readVerifyingKey(DefaultConfig().artifactsDir(), "10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var exported bytes.Buffer
+	if err := ExportVerifier(DefaultConfig(), 10, &exported); err != nil {
+		t.Fatal(err)
+	}
+
+	diffs, err := CompareSolidityVerifier(exported.Bytes(), vk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diffs) != 0 {
+		t.Fatalf("exported verifier drifted: %v", diffs)
+	}
+
+	// forge fmt wraps the constants and groups their digits
+	formatted := regexp.MustCompile(`= (\d+);`).ReplaceAllStringFunc(exported.String(), func(s string) string {
+		digits := s[2 : len(s)-1]
+		var grouped strings.Builder
+		for i := range digits {
+			if i > 0 && (len(digits)-i)%3 == 0 {
+				grouped.WriteByte('_')
+			}
+			grouped.WriteByte(digits[i])
+		}
+		return "=\n        " + grouped.String() + ";"
+	})
+	if diffs, err := CompareSolidityVerifier([]byte(formatted), vk); err != nil || len(diffs) != 0 {
+		t.Fatalf("formatted verifier drifted: %v, %v", diffs, err)
+	}
+
+	tampered := regexp.MustCompile(`ALPHA_X = \d+;`).ReplaceAllString(exported.String(), "ALPHA_X = 1;")
+	diffs, err = CompareSolidityVerifier([]byte(tampered), vk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diffs) != 1 || diffs[0].Constant != "ALPHA_X" || diffs[0].Solidity.Int64() != 1 {
+		t.Fatalf("got diffs %v, want ALPHA_X only", diffs)
+	}
+
+	// a verifier for fewer public inputs misses the last IC point
+	truncated := regexp.MustCompile(`(?m)^.*PUB_1_[XY] = \d+;\n`).ReplaceAllString(exported.String(), "")
+	diffs, err = CompareSolidityVerifier([]byte(truncated), vk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diffs) != 2 || diffs[0].Constant != "PUB_1_X" || diffs[0].Solidity != nil || diffs[1].Constant != "PUB_1_Y" {
+		t.Fatalf("got diffs %v, want missing PUB_1", diffs)
+	}
+}
+
+// TestParseSolidityVerifier parses the committed, forge-formatted verifiers.
+func TestParseSolidityVerifier(t *testing.T) {
+	for _, tier := range []string{"10", "100", "1000"} {
+		src, err := os.ReadFile("../../script/test/data/zk/Verifier_" + tier + ".sol")
+		if err != nil {
+			t.Fatal(err)
+		}
+		key, err := ParseSolidityVerifier(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// the constant, the input hash and the commitment
+		if key.CommitmentKey == nil || len(key.IC) != 3 {
+			t.Fatalf("Verifier_%s.sol parsed with commitment key %v and %d IC points", tier, key.CommitmentKey, len(key.IC))
+		}
+		if !key.Alpha.IsInSubGroup() || !key.BetaNeg.IsInSubGroup() || !key.GammaNeg.IsInSubGroup() || !key.DeltaNeg.IsInSubGroup() ||
+			!key.CommitmentKey.G.IsInSubGroup() || !key.CommitmentKey.GSigmaNeg.IsInSubGroup() || !key.IC[0].IsInSubGroup() || !key.IC[1].IsInSubGroup() || !key.IC[2].IsInSubGroup() {
+			t.Fatalf("Verifier_%s.sol has points outside their groups", tier)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package proof
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/consensys/gnark/constraint/solver"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/go-errors/errors"
+)
+
+// hashToG1CounterBits bounds the number of increments BN254.hashToG1 may take in hash-to-G1 circuits. Every
+// attempt finds a point with probability 1/2, so a message hash needs more than 2^6 of them with probability 2^-64.
+const hashToG1CounterBits = 6
+
+const hashToG1MaxAttempts = 1 << hashToG1CounterBits
+
+func init() {
+	solver.RegisterHint(hashToG1CounterHint, hashToG1RootsHint)
+}
+
+// sqrtExponent is (p+1)/4. As p = 3 mod 4, beta^sqrtExponent is a square root of beta whenever beta is a square.
+var sqrtExponent = new(big.Int).Rsh(new(big.Int).Add(fp.Modulus(), big.NewInt(1)), 2)
+
+// HashToG1 mirrors BN254.hashToG1: x starts at messageHash mod p and is incremented until x^3+3 is a square,
+// whose root (x^3+3)^((p+1)/4) becomes y. It also returns the number of increments.
+func HashToG1(messageHash [32]byte) (bn254.G1Affine, uint64) {
+	p := fp.Modulus()
+	x := new(big.Int).SetBytes(messageHash[:])
+	x.Mod(x, p)
+	for counter := uint64(0); ; counter++ {
+		beta := curveEquation(x)
+		y := new(big.Int).Exp(beta, sqrtExponent, p)
+		ySquared := new(big.Int).Mul(y, y)
+		if ySquared.Mod(ySquared, p).Cmp(beta) == 0 {
+			var point bn254.G1Affine
+			point.X.SetBigInt(x)
+			point.Y.SetBigInt(y)
+			return point, counter
+		}
+		x.Add(x, big.NewInt(1)).Mod(x, p)
+	}
+}
+
+// curveEquation returns x^3+3 mod p.
+func curveEquation(x *big.Int) *big.Int {
+	p := fp.Modulus()
+	beta := new(big.Int).Exp(x, big.NewInt(3), p)
+	beta.Add(beta, big.NewInt(3))
+	return beta.Mod(beta, p)
+}
+
+// assertHashToG1 asserts that message is BN254.hashToG1(messageHash). The counter comes from a hint, and every
+// x skipped before it is shown to have no point: -(x^3+3) is a square there, as -1 is not a square in Fp.
+func (a *circuitApis) assertHashToG1(messageHash []uints.U8, message *sw_bn254.G1Affine) error {
+	api := a.api
+	hi := bytesToVariable(api, messageHash[:16])
+	lo := bytesToVariable(api, messageHash[16:])
+
+	counter, err := api.Compiler().NewHint(hashToG1CounterHint, 1, hi, lo)
+	if err != nil {
+		return err
+	}
+	counterBits := bits.ToBinary(api, counter[0], bits.WithNbDigits(hashToG1CounterBits))
+	// roots[0] is the root of y, roots[1+j] the root of -(x^3+3) for the j-th skipped x
+	roots, err := a.fieldFp.NewHintWithNativeInput(hashToG1RootsHint, 1+hashToG1MaxAttempts, hi, lo)
+	if err != nil {
+		return err
+	}
+
+	// the 256 bits of the message hash fit the four limbs, Fp operations reduce them mod p
+	messageHashBits := make([]frontend.Variable, 0, 8*len(messageHash))
+	for i := len(messageHash) - 1; i >= 0; i-- {
+		messageHashBits = append(messageHashBits, bits.ToBinary(api, messageHash[i].Val, bits.WithNbDigits(8))...)
+	}
+	x0 := a.fieldFp.FromBits(messageHashBits...)
+
+	three := a.fieldFp.NewElement(3)
+	zero := a.fieldFp.Zero()
+	isSkipped := frontend.Variable(1)
+	for j := range hashToG1MaxAttempts {
+		isSkipped = api.Mul(isSkipped, api.Sub(1, api.IsZero(api.Sub(counter[0], j))))
+		x := a.fieldFp.Add(x0, a.fieldFp.NewElement(j))
+		beta := a.fieldFp.Add(a.fieldFp.Mul(a.fieldFp.Mul(x, x), x), three)
+		nonResidue := a.fieldFp.Add(a.fieldFp.Mul(roots[1+j], roots[1+j]), beta)
+		a.fieldFp.AssertIsEqual(a.fieldFp.Select(isSkipped, nonResidue, zero), zero)
+	}
+
+	// message is on the curve by assertSignedPointsOnCurve, so y is one of both roots of x^3+3, and
+	// (x^3+3)^((p+1)/4) is the one that is a square itself
+	a.fieldFp.AssertIsEqual(&message.X, a.fieldFp.Add(x0, a.fieldFp.FromBits(counterBits...)))
+	a.fieldFp.AssertIsEqual(a.fieldFp.Mul(roots[0], roots[0]), &message.Y)
+	return nil
+}
+
+// hashToG1Input rebuilds the message hash from its high and low 128 bits.
+func hashToG1Input(hi, lo *big.Int) [32]byte {
+	var messageHash [32]byte
+	hi.FillBytes(messageHash[:16])
+	lo.FillBytes(messageHash[16:])
+	return messageHash
+}
+
+func hashToG1CounterHint(_ *big.Int, inputs, outputs []*big.Int) error {
+	_, counter := HashToG1(hashToG1Input(inputs[0], inputs[1]))
+	outputs[0].SetUint64(counter)
+	return nil
+}
+
+func hashToG1RootsHint(_ *big.Int, inputs, outputs []*big.Int) error {
+	return emulated.UnwrapHintWithNativeInput(inputs, outputs, func(p *big.Int, inputs, outputs []*big.Int) error {
+		messageHash := hashToG1Input(inputs[0], inputs[1])
+		point, counter := HashToG1(messageHash)
+		if counter >= hashToG1MaxAttempts {
+			return errors.Errorf("hash to G1 needs %d increments, at most %d are supported", counter, hashToG1MaxAttempts-1)
+		}
+
+		outputs[0].Exp(point.Y.BigInt(new(big.Int)), sqrtExponent, p)
+		x := new(big.Int).SetBytes(messageHash[:])
+		x.Mod(x, p)
+		for j := range counter {
+			negBeta := curveEquation(x)
+			negBeta.Neg(negBeta).Mod(negBeta, p)
+			outputs[1+j].Exp(negBeta, sqrtExponent, p)
+			x.Add(x, big.NewInt(1)).Mod(x, p)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,88 @@
+package proof
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/test"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// testMessageHash needs one increment in BN254.hashToG1.
+var testMessageHash = crypto.Keccak256Hash([]byte("message"))
+
+type hashToG1Circuit struct {
+	MessageHash [32]uints.U8
+	Message     sw_bn254.G1Affine
+}
+
+func (c *hashToG1Circuit) Define(api frontend.API) error {
+	apis, err := newCircuitApis(api, true)
+	if err != nil {
+		return err
+	}
+	messageHash := make([]uints.U8, len(c.MessageHash))
+	for i := range messageHash {
+		messageHash[i] = apis.u64.ByteValueOf(c.MessageHash[i].Val)
+	}
+	apis.pairing.AssertIsOnG1(&c.Message)
+	return apis.assertHashToG1(messageHash, &c.Message)
+}
+
+func newHashToG1Assignment(messageHash [32]byte, message bn254.G1Affine) *hashToG1Circuit {
+	assignment := &hashToG1Circuit{Message: sw_bn254.NewG1Affine(message)}
+	for i := range messageHash {
+		assignment.MessageHash[i] = uints.NewU8(messageHash[i])
+	}
+	return assignment
+}
+
+func TestHashToG1(t *testing.T) {
+	p := fp.Modulus()
+	for _, message := range []string{"relay message", "message"} {
+		messageHash := crypto.Keccak256Hash([]byte(message))
+		point, counter := HashToG1(messageHash)
+		if !point.IsOnCurve() || !point.IsInSubGroup() {
+			t.Fatalf("%q: point not in G1", message)
+		}
+
+		// every skipped x has no point, and y is the root findYFromX picks
+		x := new(big.Int).SetBytes(messageHash[:])
+		x.Mod(x, p)
+		for range counter {
+			if big.Jacobi(curveEquation(x), p) != -1 {
+				t.Fatalf("%q: skipped a point", message)
+			}
+			x.Add(x, big.NewInt(1))
+		}
+		if point.X.BigInt(new(big.Int)).Cmp(x) != 0 {
+			t.Fatalf("%q: x is %s, expected %s", message, point.X.String(), x)
+		}
+		if big.Jacobi(point.Y.BigInt(new(big.Int)), p) != 1 {
+			t.Fatalf("%q: y is not a square", message)
+		}
+	}
+}
+
+func TestHashToG1Circuit(t *testing.T) {
+	for _, message := range []string{"relay message", "message"} {
+		messageHash := crypto.Keccak256Hash([]byte(message))
+		point, _ := HashToG1(messageHash)
+		if err := test.IsSolved(&hashToG1Circuit{}, newHashToG1Assignment(messageHash, point), ecc.BN254.ScalarField()); err != nil {
+			t.Fatalf("%q: expected circuit to be solved: %v", message, err)
+		}
+
+		// the other root of x^3+3 is on the curve too
+		var negPoint bn254.G1Affine
+		negPoint.Neg(&point)
+		if err := test.IsSolved(&hashToG1Circuit{}, newHashToG1Assignment(messageHash, negPoint), ecc.BN254.ScalarField()); err == nil {
+			t.Fatalf("%q: expected circuit not to be solved", message)
+		}
+	}
+}
@@ -7,13 +7,21 @@ import (
 
 	"github.com/consensys/gnark-crypto/ecc/bn254"
 	mimc_native "github.com/consensys/gnark-crypto/ecc/bn254/fr/mimc"
+	"github.com/consensys/gnark/constraint/solver"
 	"github.com/consensys/gnark/frontend"
 	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/hash"
 	"github.com/consensys/gnark/std/hash/mimc"
 	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/emulated"
 	"github.com/consensys/gnark/std/math/uints"
+	"github.com/go-errors/errors"
 )
 
+func init() {
+	solver.RegisterHint(bytesHint, nativeToScalarHint, slopeHint)
+}
+
 func (p ProofData) Marshal() []byte {
 	var result bytes.Buffer
 
@@ -27,6 +35,52 @@ func (p ProofData) Marshal() []byte {
 	return result.Bytes()
 }
 
+// MarshalSolidity encodes the proof as calldata for the Solidity verifier of its backend, followed by the
+// signers voting power. Groth16 proofs are already in that layout, PLONK proofs are converted from the raw
+// encoding Marshal keeps for ZkProver.Verify.
+func (p ProofData) MarshalSolidity() ([]byte, error) {
+	if p.Backend != BackendPlonk {
+		return p.Marshal(), nil
+	}
+
+	proofBytes, err := plonkSolidityProof(p.Proof)
+	if err != nil {
+		return nil, err
+	}
+	signersAggVotingPowerBuffer := make([]byte, 32)
+	p.SignersAggVotingPower.FillBytes(signersAggVotingPowerBuffer)
+	return append(proofBytes, signersAggVotingPowerBuffer...), nil
+}
+
+// groth16ProofSize is the length of a Groth16 proof in the Marshal layout: A, B, C, the commitment, its proof of
+// knowledge and the 32-byte signers voting power.
+const groth16ProofSize = 256 + 64 + 64 + 32
+
+// UnmarshalProofData splits a proof in the Marshal layout of backend into its parts.
+func UnmarshalProofData(backend Backend, data []byte) (ProofData, error) {
+	if backend == BackendPlonk {
+		if len(data) <= 32 {
+			return ProofData{}, errors.Errorf("invalid proof length %d", len(data))
+		}
+		return ProofData{
+			Backend:               BackendPlonk,
+			Proof:                 bytes.Clone(data[:len(data)-32]),
+			SignersAggVotingPower: new(big.Int).SetBytes(data[len(data)-32:]),
+		}, nil
+	}
+
+	if len(data) != groth16ProofSize {
+		return ProofData{}, errors.Errorf("invalid proof length %d, want %d", len(data), groth16ProofSize)
+	}
+	return ProofData{
+		Backend:               BackendGroth16,
+		Proof:                 bytes.Clone(data[:256]),
+		Commitments:           bytes.Clone(data[256:320]),
+		CommitmentPok:         bytes.Clone(data[320:384]),
+		SignersAggVotingPower: new(big.Int).SetBytes(data[384:]),
+	}, nil
+}
+
 func hashAffineG1(h *mimc.MiMC, g1 *sw_bn254.G1Affine) {
 	h.Write(g1.X.Limbs...)
 	h.Write(g1.Y.Limbs...)
@@ -58,6 +112,93 @@ func variableToBytes(api frontend.API, u64api *uints.BinaryField[uints.U64], var
 	return res
 }
 
+// variableToBytesLookup decomposes variable into 32 big-endian bytes given by a hint. Every byte is range checked
+// through the uints lookup table and the top bytes are bounded so that the encoding stays below 2^nbBits.
+func variableToBytesLookup(api frontend.API, u64api *uints.BinaryField[uints.U64], rangeChecker frontend.Rangechecker, variable frontend.Variable, nbBits int) ([]uints.U8, error) {
+	hintBytes, err := api.Compiler().NewHint(bytesHint, 32, variable)
+	if err != nil {
+		return nil, errors.Errorf("failed to decompose into bytes: %w", err)
+	}
+
+	res := make([]uints.U8, 32)
+	for i := range res {
+		res[i] = u64api.ByteValueOf(hintBytes[i])
+	}
+	if nbBits < 256 {
+		nbZeroBytes := (256 - nbBits) / 8
+		for i := range nbZeroBytes {
+			api.AssertIsEqual(hintBytes[i], 0)
+		}
+		if nbBits%8 != 0 {
+			rangeChecker.Check(hintBytes[nbZeroBytes], nbBits%8)
+		}
+	}
+	api.AssertIsEqual(bytesToVariable(api, res), variable)
+
+	return res, nil
+}
+
+// word is a variable hashed as a 32-byte word, whose encoding variableToBytesLookup bounds to nbBits.
+type word struct {
+	value  frontend.Variable
+	nbBits int
+}
+
+// writeWords writes the variableToBytesLookup encodings of words to h.
+func (a *circuitApis) writeWords(h hash.BinaryHasher, words ...word) error {
+	for _, w := range words {
+		wordBytes, err := variableToBytesLookup(a.api, a.u64, a.rangeChecker, w.value, w.nbBits)
+		if err != nil {
+			return err
+		}
+		h.Write(wordBytes)
+	}
+	return nil
+}
+
+// bytesToVariable packs big-endian bytes into a single native variable.
+func bytesToVariable(api frontend.API, b []uints.U8) frontend.Variable {
+	res := frontend.Variable(0)
+	for i := range b {
+		res = api.Add(api.Mul(res, 1<<8), b[i].Val)
+	}
+	return res
+}
+
+// digestToScalar lifts a native digest into an emulated scalar. The limbs come from a hint and are tied back
+// to the digest by packing them natively, which avoids a full bit decomposition of the digest.
+func digestToScalar(api frontend.API, fieldFrApi *emulated.Field[emulated.BN254Fr], digest frontend.Variable) (*emulated.Element[emulated.BN254Fr], error) {
+	res, err := fieldFrApi.NewHintWithNativeInput(nativeToScalarHint, 1, digest)
+	if err != nil {
+		return nil, err
+	}
+
+	limbBase := new(big.Int).Lsh(big.NewInt(1), uint(emulated.BN254Fr{}.BitsPerLimb()))
+	packed := frontend.Variable(0)
+	for i := len(res[0].Limbs) - 1; i >= 0; i-- {
+		packed = api.Add(api.Mul(packed, limbBase), res[0].Limbs[i])
+	}
+	api.AssertIsEqual(packed, digest)
+
+	return res[0], nil
+}
+
+func bytesHint(_ *big.Int, inputs, outputs []*big.Int) error {
+	buf := make([]byte, len(outputs))
+	inputs[0].FillBytes(buf)
+	for i := range outputs {
+		outputs[i].SetUint64(uint64(buf[i]))
+	}
+	return nil
+}
+
+func nativeToScalarHint(_ *big.Int, inputs, outputs []*big.Int) error {
+	return emulated.UnwrapHintWithNativeInput(inputs, outputs, func(emulatedMod *big.Int, inputs, outputs []*big.Int) error {
+		outputs[0].Mod(inputs[0], emulatedMod)
+		return nil
+	})
+}
+
 func keyToBytes(u64api *uints.BinaryField[uints.U64], key *sw_bn254.G1Affine) []uints.U8 {
 	xLimbs := key.X.Limbs
 	yLimbs := key.Y.Limbs
@@ -107,20 +248,6 @@ func HashValset(valset []ValidatorData) []byte {
 	return h.Sum(nil)
 }
 
-func getPubkeyG1(pk *big.Int) bn254.G1Affine {
-	_, _, g1Aff, _ := bn254.Generators()
-	var p bn254.G1Affine
-	p.ScalarMultiplication(&g1Aff, pk)
-	return p
-}
-
-func getPubkeyG2(pk *big.Int) bn254.G2Affine {
-	_, _, _, g2Aff := bn254.Generators()
-	var p bn254.G2Affine
-	p.ScalarMultiplication(&g2Aff, pk)
-	return p
-}
-
 func getNonSignersData(valset []ValidatorData) (aggKey *bn254.G1Affine, aggVotingPower *big.Int, totalVotingPower *big.Int) { //nolint:unparam // maybe needed later
 	aggVotingPower = big.NewInt(0)
 	totalVotingPower = big.NewInt(0)
@@ -136,61 +263,73 @@ func getNonSignersData(valset []ValidatorData) (aggKey *bn254.G1Affine, aggVotin
 	return aggKey, aggVotingPower, totalVotingPower
 }
 
-func getAggSignature(message bn254.G1Affine, valset *[]ValidatorData) (signature *bn254.G1Affine, aggKeyG2 *bn254.G2Affine, aggKeyG1 *bn254.G1Affine) {
-	aggKeyG2 = new(bn254.G2Affine)
-	aggKeyG2.SetInfinity()
-
-	aggSignature := new(bn254.G1Affine)
-	aggSignature.SetInfinity()
-
-	aggKeyG1 = new(bn254.G1Affine)
-	aggKeyG1.SetInfinity()
+// NormalizeValset returns a copy of the active validators sorted by key in the order of version, see SortValset,
+// and padded with filler entries up to the tier selected for their count. Filler entries in the input are
+// rejected.
+func NormalizeValset(version CircuitVersion, valset []ValidatorData) ([]ValidatorData, error) {
+	tier, err := SelectTier(len(valset))
+	if err != nil {
+		return nil, err
+	}
+	return normalizeValset(version, valset, int(tier))
+}
 
-	for i := range *valset {
-		if !(*valset)[i].IsNonSigner {
-			aggKeyG2 = aggKeyG2.Add(aggKeyG2, &(*valset)[i].KeyG2)
-			aggKeyG1 = aggKeyG1.Add(aggKeyG1, &(*valset)[i].Key)
-			msg := bn254.G1Affine{X: message.X, Y: message.Y} // have to copy msg since ScalarMultiplication rewrite it
-			sig := msg.ScalarMultiplication(&msg, (*valset)[i].PrivateKey)
-			aggSignature = aggSignature.Add(aggSignature, sig)
+// normalizeValset sorts the active validators by key and pads them with filler entries up to size.
+func normalizeValset(version CircuitVersion, valset []ValidatorData, size int) ([]ValidatorData, error) {
+	for i := range valset {
+		if valset[i].Key.IsInfinity() {
+			return nil, errors.Errorf("validator %d is a filler entry, valset must contain active validators only", i)
 		}
 	}
 
-	return aggSignature, aggKeyG2, aggKeyG1
+	normalizedValset := padValset(valset, size)
+	SortValset(version, normalizedValset[:len(valset)])
+	return normalizedValset, nil
 }
 
-func NormalizeValset(valset []ValidatorData) []ValidatorData {
-	// Sort validators by key in ascending order
+// SortValset sorts validators by key in ascending order, the order the valset hash of version is computed in.
+// From V5 on keys are compared by X, then Y. V1 to V4 keep the comparator their artifacts were released with,
+// less(a, b) = a.X < b.X || a.Y < b.Y, which is not a strict ordering. They first sort by X, then Y, and then run
+// an insertion sort with less over that order: every validator, front to back, moves forward while it is less
+// than its predecessor. The result doesn't depend on the input order, and sorting a sorted valset keeps it
+// unchanged.
+func SortValset(version CircuitVersion, valset []ValidatorData) {
 	sort.Slice(valset, func(i, j int) bool {
-		// Compare keys (lower first)
-		return valset[i].Key.X.Cmp(&valset[j].Key.X) < 0 || valset[i].Key.Y.Cmp(&valset[j].Key.Y) < 0
+		if c := valset[i].Key.X.Cmp(&valset[j].Key.X); c != 0 {
+			return c < 0
+		}
+		return valset[i].Key.Y.Cmp(&valset[j].Key.Y) < 0
 	})
-	n := getOptimalN(len(valset))
-	normalizedValset := make([]ValidatorData, n)
-	for i := range n {
-		if i < len(valset) {
-			normalizedValset[i] = valset[i]
-		} else {
-			zeroPoint := new(bn254.G1Affine)
-			zeroPoint.SetInfinity()
-			zeroPointG2 := new(bn254.G2Affine)
-			zeroPointG2.SetInfinity()
-			normalizedValset[i] = ValidatorData{PrivateKey: big.NewInt(0), Key: *zeroPoint, KeyG2: *zeroPointG2, VotingPower: big.NewInt(0), IsNonSigner: false}
+	if version >= CircuitVersionV5 {
+		return
+	}
+	for i := 1; i < len(valset); i++ {
+		for j := i; j > 0 && legacyKeyLess(&valset[j].Key, &valset[j-1].Key); j-- {
+			valset[j], valset[j-1] = valset[j-1], valset[j]
 		}
 	}
-	return normalizedValset
 }
 
-func getOptimalN(valsetLength int) int {
-	var capSize int
-	for _, m := range MaxValidators {
-		if m >= valsetLength {
-			capSize = m
-			break
-		}
-	}
-	if capSize == 0 {
-		return 0
+// legacyKeyLess is the key comparator of the V1 to V4 valset order.
+func legacyKeyLess(a, b *bn254.G1Affine) bool {
+	return a.X.Cmp(&b.X) < 0 || a.Y.Cmp(&b.Y) < 0
+}
+
+// isWord reports whether v is a uint256, the 32-byte word the hashes of the package encode numbers as.
+func isWord(v *big.Int) bool {
+	return v != nil && v.Sign() >= 0 && v.BitLen() <= 256
+}
+
+// padValset copies valset into a slice of the given size, filling the tail with filler entries.
+func padValset(valset []ValidatorData, size int) []ValidatorData {
+	padded := make([]ValidatorData, size)
+	copy(padded, valset)
+	for i := len(valset); i < size; i++ {
+		zeroPoint := new(bn254.G1Affine)
+		zeroPoint.SetInfinity()
+		zeroPointG2 := new(bn254.G2Affine)
+		zeroPointG2.SetInfinity()
+		padded[i] = ValidatorData{Key: *zeroPoint, KeyG2: *zeroPointG2, VotingPower: big.NewInt(0), IsNonSigner: false}
 	}
-	return capSize
+	return padded
 }
@@ -0,0 +1,215 @@
+package proof
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark/constraint/solver"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/uints"
+)
+
+func init() {
+	solver.RegisterHint(halfHint)
+}
+
+var keccakRoundConstants = [24]uint64{
+	0x0000000000000001, 0x0000000000008082, 0x800000000000808A, 0x8000000080008000,
+	0x000000000000808B, 0x0000000080000001, 0x8000000080008081, 0x8000000000008009,
+	0x000000000000008A, 0x0000000000000088, 0x0000000080008009, 0x000000008000000A,
+	0x000000008000808B, 0x800000000000008B, 0x8000000000008089, 0x8000000000008003,
+	0x8000000000008002, 0x8000000000000080, 0x000000000000800A, 0x800000008000000A,
+	0x8000000080008081, 0x8000000000008080, 0x0000000080000001, 0x8000000080008008,
+}
+
+// keccakRotations and keccakPiLanes walk the ρ and π steps lane by lane, like keccakf of gnark.
+var keccakRotations = [24]int{1, 3, 6, 10, 15, 21, 28, 36, 45, 55, 2, 14, 27, 41, 56, 8, 25, 43, 62, 18, 39, 61, 20, 44}
+var keccakPiLanes = [24]int{10, 7, 11, 17, 18, 3, 5, 16, 8, 21, 24, 4, 15, 23, 19, 13, 12, 2, 20, 14, 22, 9, 6, 1}
+
+// keccakLane holds the bits of a 64-bit lane, least significant first.
+type keccakLane [64]frontend.Variable
+
+// keccak256Bits is a legacy keccak256 hash.BinaryHasher running Keccak-f[1600] on single bits. A permutation
+// costs about 146k constraints, more than the roughly 60k of gnark's sha3, but it needs none of the 2^16-entry
+// xor and and tables sha3 queries, which cost about 131k once per circuit. It is cheaper for circuits whose
+// only keccak hash fits a single block.
+type keccak256Bits struct {
+	api frontend.API
+	in  []uints.U8
+}
+
+func newKeccak256Bits(api frontend.API) *keccak256Bits {
+	return &keccak256Bits{api: api}
+}
+
+func (h *keccak256Bits) Write(data []uints.U8) {
+	h.in = append(h.in, data...)
+}
+
+func (h *keccak256Bits) Size() int {
+	return 32
+}
+
+func (h *keccak256Bits) Reset() {
+	h.in = nil
+}
+
+// Sum returns the digest of the bytes written so far. The bytes must be range checked by the caller, their
+// decomposition into bits only bounds them to 8 bits.
+func (h *keccak256Bits) Sum() []uints.U8 {
+	api := h.api
+	padded := make([]uints.U8, len(h.in), len(h.in)+keccakRate)
+	copy(padded, h.in)
+	if q := keccakRate - len(padded)%keccakRate; q == 1 {
+		padded = append(padded, uints.NewU8(0x81))
+	} else {
+		padded = append(padded, uints.NewU8(0x01))
+		padded = append(padded, uints.NewU8Array(make([]uint8, q-2))...)
+		padded = append(padded, uints.NewU8(0x80))
+	}
+
+	var state [25]keccakLane
+	for i := range state {
+		for z := range state[i] {
+			state[i][z] = 0
+		}
+	}
+	for block := 0; block < len(padded); block += keccakRate {
+		for i := range keccakRate {
+			byteBits := bits.ToBinary(api, padded[block+i].Val, bits.WithNbDigits(8))
+			lane := &state[i/8]
+			for k := range byteBits {
+				lane[8*(i%8)+k] = h.xor(lane[8*(i%8)+k], byteBits[k])
+			}
+		}
+		h.permute(&state)
+	}
+
+	digest := make([]uints.U8, h.Size())
+	for i := range digest {
+		lane := &state[i/8]
+		value := frontend.Variable(0)
+		for k := 7; k >= 0; k-- {
+			value = api.Add(api.Mul(value, 2), lane[8*(i%8)+k])
+		}
+		digest[i] = uints.U8{Val: value}
+	}
+	return digest
+}
+
+// permute applies Keccak-f[1600] to state.
+func (h *keccak256Bits) permute(state *[25]keccakLane) {
+	for round := range keccakRoundConstants {
+		// θ
+		var columns [5]keccakLane
+		for x := range columns {
+			for z := range columns[x] {
+				columns[x][z] = h.parity(state[x][z], state[x+5][z], state[x+10][z], state[x+15][z], state[x+20][z])
+			}
+		}
+		for x := range 5 {
+			for z := range 64 {
+				d := h.xor(columns[(x+4)%5][z], columns[(x+1)%5][(z+63)%64])
+				for y := 0; y < 25; y += 5 {
+					state[x+y][z] = h.xor(state[x+y][z], d)
+				}
+			}
+		}
+
+		// ρ and π
+		t := state[1]
+		for i, j := range keccakPiLanes {
+			next := state[j]
+			state[j] = rotateLane(t, keccakRotations[i])
+			t = next
+		}
+
+		// χ
+		for y := 0; y < 25; y += 5 {
+			var row [5]keccakLane
+			copy(row[:], state[y:y+5])
+			for x := range 5 {
+				for z := range 64 {
+					state[x+y][z] = h.xor(row[x][z], h.andNot(row[(x+1)%5][z], row[(x+2)%5][z]))
+				}
+			}
+		}
+
+		// ι
+		for z := range 64 {
+			if keccakRoundConstants[round]>>z&1 == 1 {
+				state[0][z] = h.api.Sub(1, state[0][z])
+			}
+		}
+	}
+}
+
+// rotateLane rotates lane left by n bits.
+func rotateLane(lane keccakLane, n int) keccakLane {
+	var res keccakLane
+	for z := range res {
+		res[z] = lane[(z-n+64)%64]
+	}
+	return res
+}
+
+// xor returns a xor b for bits a and b, in one constraint unless one of them is constant.
+func (h *keccak256Bits) xor(a, b frontend.Variable) frontend.Variable {
+	api := h.api
+	return api.Sub(api.Add(a, b), api.Mul(2, api.Mul(a, b)))
+}
+
+// andNot returns (not a) and b for bits a and b.
+func (h *keccak256Bits) andNot(a, b frontend.Variable) frontend.Variable {
+	return h.api.Mul(h.api.Sub(1, a), b)
+}
+
+// parity returns the xor of up to five bits. Two variable bits are xored directly, more are summed and halved
+// through a hint: sum = parity + 2*half, with parity a bit and half in [0, 2], so the split is unique.
+func (h *keccak256Bits) parity(bitsIn ...frontend.Variable) frontend.Variable {
+	api := h.api
+	var constant uint64
+	var variables []frontend.Variable
+	for _, b := range bitsIn {
+		if c, ok := api.Compiler().ConstantValue(b); ok {
+			constant += c.Uint64()
+		} else {
+			variables = append(variables, b)
+		}
+	}
+
+	var res frontend.Variable = 0
+	switch len(variables) {
+	case 0:
+	case 1:
+		res = variables[0]
+	case 2:
+		res = h.xor(variables[0], variables[1])
+	default:
+		sum := api.Add(variables[0], variables[1], variables[2:]...)
+		half, err := api.Compiler().NewHint(halfHint, 1, sum)
+		if err != nil {
+			panic(err)
+		}
+		if len(variables) > 5 {
+			panic("parity of more than five bits")
+		}
+		if len(variables) == 3 {
+			api.AssertIsBoolean(half[0])
+		} else {
+			api.AssertIsEqual(api.Mul(api.Sub(api.Mul(half[0], half[0]), half[0]), api.Sub(half[0], 2)), 0)
+		}
+		res = api.Sub(sum, api.Mul(half[0], 2))
+		api.AssertIsBoolean(res)
+	}
+	if constant%2 == 1 {
+		res = api.Sub(1, res)
+	}
+	return res
+}
+
+// halfHint returns inputs[0] / 2, rounded down.
+func halfHint(_ *big.Int, inputs, outputs []*big.Int) error {
+	outputs[0].Rsh(inputs[0], 1)
+	return nil
+}
@@ -0,0 +1,49 @@
+package proof
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/test"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+type keccak256BitsCircuit struct {
+	Data   []uints.U8
+	Digest [32]uints.U8
+}
+
+func (c *keccak256BitsCircuit) Define(api frontend.API) error {
+	h := newKeccak256Bits(api)
+	h.Write(c.Data)
+	digest := h.Sum()
+	for i := range digest {
+		api.AssertIsEqual(digest[i].Val, c.Digest[i].Val)
+	}
+	return nil
+}
+
+func TestKeccak256Bits(t *testing.T) {
+	// around the block boundaries, where the padding takes one or two bytes or a whole block
+	for _, n := range []int{0, 1, 64, 128, keccakRate - 2, keccakRate - 1, keccakRate, 2*keccakRate - 1} {
+		data := make([]byte, n)
+		for i := range data {
+			data[i] = byte(7*i + 3)
+		}
+		digest := crypto.Keccak256(data)
+
+		assignment := &keccak256BitsCircuit{Data: uints.NewU8Array(data)}
+		copy(assignment.Digest[:], uints.NewU8Array(digest))
+		placeholder := &keccak256BitsCircuit{Data: make([]uints.U8, n)}
+		if err := test.IsSolved(placeholder, assignment, ecc.BN254.ScalarField()); err != nil {
+			t.Fatalf("%d bytes: %v", n, err)
+		}
+
+		assignment.Digest[31] = uints.NewU8(digest[31] ^ 1)
+		if err := test.IsSolved(placeholder, assignment, ecc.BN254.ScalarField()); err == nil {
+			t.Fatalf("%d bytes: wrong digest accepted", n)
+		}
+	}
+}
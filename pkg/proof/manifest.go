@@ -0,0 +1,80 @@
+package proof
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/go-errors/errors"
+)
+
+// ArtifactManifest describes the circuit an artifacts directory was generated for. It is written as
+// manifest.json next to newly generated keys, so operators and tooling can tell which valset hash the verifiers
+// of that directory expect without recompiling the circuit.
+type ArtifactManifest struct {
+	CircuitVersion         CircuitVersion `json:"circuitVersion"`
+	ValsetHash             string         `json:"valsetHash"`
+	ValsetHashExtraDataKey string         `json:"valsetHashExtraDataName"`
+	SplitInputHash         bool           `json:"splitInputHash"`
+	HashToG1               bool           `json:"hashToG1"`
+	SignerBitmap           bool           `json:"signerBitmap"`
+	CompressedKeys         bool           `json:"compressedKeys"`
+}
+
+// Manifest returns the manifest of the configured circuit.
+func (c Config) Manifest() ArtifactManifest {
+	valsetHash := c.ValsetHash()
+	return ArtifactManifest{
+		CircuitVersion:         c.CircuitVersion,
+		ValsetHash:             valsetHash.String(),
+		ValsetHashExtraDataKey: valsetHash.ExtraDataName(),
+		SplitInputHash:         c.SplitInputHash,
+		HashToG1:               c.HashToG1,
+		SignerBitmap:           c.SignerBitmap,
+		CompressedKeys:         c.CompressedKeys,
+	}
+}
+
+func manifestPath(dir string) string {
+	return dir + "/manifest.json"
+}
+
+// ReadArtifactManifest reads the manifest of an artifacts directory.
+func ReadArtifactManifest(dir string) (ArtifactManifest, error) {
+	data, err := os.ReadFile(manifestPath(dir))
+	if err != nil {
+		return ArtifactManifest{}, errors.Errorf("failed to read manifest: %w", err)
+	}
+	var manifest ArtifactManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return ArtifactManifest{}, errors.Errorf("failed to decode manifest: %w", err)
+	}
+	return manifest, nil
+}
+
+// writeManifest records the configured circuit in dir.
+func writeManifest(dir string, cfg Config) error {
+	data, err := json.MarshalIndent(cfg.Manifest(), "", "  ")
+	if err != nil {
+		return errors.Errorf("failed to encode manifest: %w", err)
+	}
+	if err := os.WriteFile(manifestPath(dir), append(data, '\n'), 0o644); err != nil {
+		return errors.Errorf("failed to write manifest: %w", err)
+	}
+	return nil
+}
+
+// checkManifest fails if dir holds a manifest of another circuit. Directories generated before manifests were
+// introduced have none and pass.
+func checkManifest(dir string, cfg Config) error {
+	if !exists(manifestPath(dir)) {
+		return nil
+	}
+	manifest, err := ReadArtifactManifest(dir)
+	if err != nil {
+		return err
+	}
+	if manifest != cfg.Manifest() {
+		return errors.Errorf("artifacts in %s were generated for %+v, not %+v", dir, manifest, cfg.Manifest())
+	}
+	return nil
+}
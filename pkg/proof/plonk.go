@@ -0,0 +1,298 @@
+package proof
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"os"
+	"strconv"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/backend/plonk"
+	plonk_bn254 "github.com/consensys/gnark/backend/plonk/bn254"
+	"github.com/consensys/gnark/backend/witness"
+	"github.com/consensys/gnark/constraint"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/scs"
+	"github.com/go-errors/errors"
+)
+
+// plonkDir returns the directory holding the PLONK artifacts of the configured circuit.
+func plonkDir(cfg Config) string {
+	return cfg.artifactsDir() + "/plonk"
+}
+
+func scsPathTmp(dir, suffix string) string {
+	return fmt.Sprintf(dir+"/circuit_%s.scs", suffix)
+}
+
+// srsPath is the canonical KZG SRS shared by all tiers. A ceremony SRS can be put there before the first
+// start, otherwise a single-party SRS is generated which must not be used in production.
+func srsPath(dir string) string {
+	return dir + "/kzg.srs"
+}
+
+// MaxPlonkDomain is the PLONK evaluation domain a generated SRS fits at least. The SRS also fits every tier of
+// MaxValidators, but a tier added later that doesn't fit it needs a new SRS and the setup of every tier, so set
+// it to the domain of the largest tier that may be added.
+var MaxPlonkDomain uint64
+
+func (p *ZkProver) provePlonk(tier Tier, fullWitness, publicWitness witness.Witness) ([]byte, error) {
+	ccs, ok := p.cs[tier]
+	if !ok {
+		return nil, errors.Errorf("failed to load cs, vk, pk for tier: %d", tier)
+	}
+
+	proof, err := plonk.Prove(ccs, p.plonkPk[tier], fullWitness)
+	if err != nil {
+		return nil, errors.Errorf("failed to prove: %w", err)
+	}
+
+	err = plonk.Verify(proof, p.plonkVk[tier], publicWitness)
+	if err != nil {
+		return nil, err
+	}
+
+	// the Solidity encoding drops the linearised polynomial opening, which the native verifier needs
+	var proofBuffer bytes.Buffer
+	_, err = proof.WriteRawTo(&proofBuffer)
+	if err != nil {
+		return nil, errors.Errorf("failed to write proof: %w", err)
+	}
+	return proofBuffer.Bytes(), nil
+}
+
+func (p *ZkProver) verifyPlonk(tier Tier, proofBytes []byte, publicWitness witness.Witness) error {
+	vk, ok := p.plonkVk[tier]
+	if !ok {
+		return errors.Errorf("failed to find verification key for tier %d", tier)
+	}
+
+	proof := plonk.NewProof(ecc.BN254)
+	if _, err := proof.ReadFrom(bytes.NewReader(proofBytes)); err != nil {
+		return errors.Errorf("failed to read proof: %w", err)
+	}
+
+	err := plonk.Verify(proof, vk, publicWitness)
+	if err != nil {
+		return errors.Errorf("%w: %w", ErrInvalidProof, err)
+	}
+	return nil
+}
+
+// plonkSolidityProof converts a raw PLONK proof into the calldata layout of the exported Solidity verifier.
+func plonkSolidityProof(proofBytes []byte) ([]byte, error) {
+	proof := plonk.NewProof(ecc.BN254)
+	if _, err := proof.ReadFrom(bytes.NewReader(proofBytes)); err != nil {
+		return nil, errors.Errorf("failed to read proof: %w", err)
+	}
+	return proof.(*plonk_bn254.Proof).MarshalSolidity(), nil
+}
+
+// loadPlonk loads the PLONK artifacts of tier, failing if the setup didn't write them.
+func loadPlonk(cfg Config, tier Tier) (constraint.ConstraintSystem, plonk.ProvingKey, plonk.VerifyingKey, error) {
+	dir := plonkDir(cfg)
+	suffix := strconv.Itoa(int(tier))
+	if err := checkManifest(cfg.artifactsDir(), cfg); err != nil {
+		return nil, nil, nil, err
+	}
+	if !exists(scsPathTmp(dir, suffix)) || !exists(pkPathTmp(dir, suffix)) || !exists(vkPathTmp(dir, suffix)) {
+		return nil, nil, nil, errors.Errorf("missing PLONK artifacts of tier %d in %s, run the setup first", tier, dir)
+	}
+	return readPlonk(dir, suffix)
+}
+
+func loadOrInitPlonk(cfg Config, tier Tier) (constraint.ConstraintSystem, plonk.ProvingKey, plonk.VerifyingKey, error) {
+	dir := plonkDir(cfg)
+	suffix := strconv.Itoa(int(tier))
+	scsP := scsPathTmp(dir, suffix)
+	pkP := pkPathTmp(dir, suffix)
+	vkP := vkPathTmp(dir, suffix)
+	solP := solPathTmp(dir, suffix)
+
+	if err := checkManifest(cfg.artifactsDir(), cfg); err != nil {
+		return nil, nil, nil, err
+	}
+	if exists(scsP) && exists(pkP) && exists(vkP) && exists(solP) {
+		return readPlonk(dir, suffix)
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, nil, nil, err
+	}
+	if err := writeManifest(cfg.artifactsDir(), cfg); err != nil {
+		return nil, nil, nil, err
+	}
+
+	if err := setupPlonk(dir, func(m int) frontend.Circuit { return newCircuit(cfg, m) }); err != nil {
+		return nil, nil, nil, err
+	}
+
+	return loadOrInitPlonk(cfg, tier)
+}
+
+// setupPlonk compiles and sets up the tiers of MaxValidators missing in dir, with the circuits of newTierCircuit
+// and the shared SRS.
+func setupPlonk(dir string, newTierCircuit func(int) frontend.Circuit) error {
+	// compile every missing tier first, the shared SRS has to fit every tier, the ones on disk included
+	compiled := make(map[int]constraint.ConstraintSystem)
+	srsSize := MaxPlonkDomain + 3
+	for _, m := range MaxValidators {
+		suf := strconv.Itoa(m)
+		if exists(scsPathTmp(dir, suf)) && exists(pkPathTmp(dir, suf)) && exists(vkPathTmp(dir, suf)) && exists(solPathTmp(dir, suf)) {
+			vk, err := readPlonkVerifyingKey(dir, suf)
+			if err != nil {
+				return err
+			}
+			srsSize = max(srsSize, vk.(*plonk_bn254.VerifyingKey).Size+3)
+			continue
+		}
+
+		cs_i, err := frontend.Compile(bn254.ID.ScalarField(), scs.NewBuilder, newTierCircuit(m))
+		if err != nil {
+			return err
+		}
+		compiled[m] = cs_i
+		srsSize = max(srsSize, lagrangeSize(cs_i)+3)
+	}
+	if len(compiled) == 0 {
+		return nil
+	}
+
+	srs, err := loadOrInitSRS(srsPath(dir), srsSize)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range MaxValidators {
+		cs_i, ok := compiled[m]
+		if !ok {
+			continue
+		}
+		suf := strconv.Itoa(m)
+
+		srsLagrange, err := lagrangeSRS(srs, lagrangeSize(cs_i))
+		if err != nil {
+			return err
+		}
+		pk_i, vk_i, err := plonk.Setup(cs_i, srs, srsLagrange)
+		if err != nil {
+			return err
+		}
+
+		{
+			var buf bytes.Buffer
+			cs_i.WriteTo(&buf)
+			os.WriteFile(scsPathTmp(dir, suf), buf.Bytes(), 0600)
+		}
+		{
+			f, err := os.Create(pkPathTmp(dir, suf))
+			if err != nil {
+				return err
+			}
+			pk_i.WriteRawTo(f)
+			f.Close()
+			f, err = os.Create(vkPathTmp(dir, suf))
+			if err != nil {
+				return err
+			}
+			vk_i.WriteRawTo(f)
+			f.Close()
+		}
+		{
+			f, err := os.Create(solPathTmp(dir, suf))
+			if err != nil {
+				return err
+			}
+			vk_i.ExportSolidity(f)
+			f.Close()
+		}
+	}
+
+	return nil
+}
+
+// readPlonk loads the constraint system and keys stored under suffix in dir.
+func readPlonk(dir, suffix string) (constraint.ConstraintSystem, plonk.ProvingKey, plonk.VerifyingKey, error) {
+	scsCS := plonk.NewCS(bn254.ID)
+	data, err := os.Open(scsPathTmp(dir, suffix))
+	if err != nil {
+		return nil, nil, nil, errors.Errorf("failed to open scs: %w", err)
+	}
+	defer data.Close()
+	if _, err := scsCS.ReadFrom(data); err != nil {
+		return nil, nil, nil, errors.Errorf("failed to read scs: %w", err)
+	}
+
+	pk := plonk.NewProvingKey(bn254.ID)
+	data, err = os.Open(pkPathTmp(dir, suffix))
+	if err != nil {
+		return nil, nil, nil, errors.Errorf("failed to open pk: %w", err)
+	}
+	defer data.Close()
+	if _, err := pk.UnsafeReadFrom(data); err != nil {
+		return nil, nil, nil, errors.Errorf("failed to read pk: %w", err)
+	}
+
+	vk, err := readPlonkVerifyingKey(dir, suffix)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return scsCS, pk, vk, nil
+}
+
+// lagrangeSize is the size of the evaluation domain PLONK uses for cs.
+func lagrangeSize(cs constraint.ConstraintSystem) uint64 {
+	return ecc.NextPowerOfTwo(uint64(cs.GetNbConstraints() + cs.GetNbPublicVariables()))
+}
+
+func loadOrInitSRS(path string, size uint64) (*kzg.SRS, error) {
+	srs := new(kzg.SRS)
+	if exists(path) {
+		data, err := os.Open(path)
+		if err != nil {
+			return nil, errors.Errorf("failed to open srs: %w", err)
+		}
+		defer data.Close()
+		if _, err := srs.UnsafeReadFrom(data); err != nil {
+			return nil, errors.Errorf("failed to read srs: %w", err)
+		}
+		if uint64(len(srs.Pk.G1)) < size {
+			return nil, errors.Errorf("srs has %d points, %d required, see MaxPlonkDomain", len(srs.Pk.G1), size)
+		}
+		return srs, nil
+	}
+
+	slog.Warn("Generating single-party KZG SRS, provide a ceremony SRS for production", "path", path, "size", size)
+	var tau fr.Element
+	if _, err := tau.SetRandom(); err != nil {
+		return nil, err
+	}
+	srs, err := kzg.NewSRS(size, tau.BigInt(new(big.Int)))
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	if _, err := srs.WriteRawTo(f); err != nil {
+		return nil, err
+	}
+	return srs, nil
+}
+
+// lagrangeSRS derives the Lagrange form of the first size points of the canonical SRS.
+func lagrangeSRS(canonical *kzg.SRS, size uint64) (*kzg.SRS, error) {
+	g1, err := kzg.ToLagrangeG1(canonical.Pk.G1[:size])
+	if err != nil {
+		return nil, err
+	}
+	return &kzg.SRS{Pk: kzg.ProvingKey{G1: g1}, Vk: canonical.Vk}, nil
+}
@@ -0,0 +1,191 @@
+package proof
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend/plonk"
+	plonk_bn254 "github.com/consensys/gnark/backend/plonk/bn254"
+	"github.com/consensys/gnark/constraint"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/scs"
+)
+
+// sumCircuit proves knowledge of n values summing up to a public total.
+type sumCircuit struct {
+	Total  frontend.Variable `gnark:",public"`
+	Values []frontend.Variable
+}
+
+func (c *sumCircuit) Define(api frontend.API) error {
+	sum := frontend.Variable(0)
+	for i := range c.Values {
+		sum = api.Add(sum, api.Mul(c.Values[i], c.Values[i]))
+	}
+	api.AssertIsEqual(sum, c.Total)
+	return nil
+}
+
+func TestPlonkSharedSRS(t *testing.T) {
+	sizes := []int{4, 64}
+	compiled := make([]constraint.ConstraintSystem, len(sizes))
+	var srsSize uint64
+	for i, n := range sizes {
+		ccs, err := frontend.Compile(ecc.BN254.ScalarField(), scs.NewBuilder, &sumCircuit{Values: make([]frontend.Variable, n)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		compiled[i] = ccs
+		srsSize = max(srsSize, lagrangeSize(ccs)+3)
+	}
+
+	path := filepath.Join(t.TempDir(), "kzg.srs")
+	if _, err := loadOrInitSRS(path, srsSize); err != nil {
+		t.Fatal(err)
+	}
+	// the second tier setup reads the SRS back from disk
+	srs, err := loadOrInitSRS(path, srsSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadOrInitSRS(path, srsSize*2); err == nil {
+		t.Fatal("expected a too small SRS to be rejected")
+	}
+
+	for i, n := range sizes {
+		srsLagrange, err := lagrangeSRS(srs, lagrangeSize(compiled[i]))
+		if err != nil {
+			t.Fatal(err)
+		}
+		pk, vk, err := plonk.Setup(compiled[i], srs, srsLagrange)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assignment := &sumCircuit{Total: n, Values: make([]frontend.Variable, n)}
+		for j := range assignment.Values {
+			assignment.Values[j] = 1
+		}
+		fullWitness, err := frontend.NewWitness(assignment, ecc.BN254.ScalarField())
+		if err != nil {
+			t.Fatal(err)
+		}
+		publicWitness, err := fullWitness.Public()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tier := Tier(n)
+		p := &ZkProver{
+			cs:      map[Tier]constraint.ConstraintSystem{tier: compiled[i]},
+			plonkPk: map[Tier]plonk.ProvingKey{tier: pk},
+			plonkVk: map[Tier]plonk.VerifyingKey{tier: vk},
+		}
+		proofBytes, err := p.provePlonk(tier, fullWitness, publicWitness)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := p.verifyPlonk(tier, proofBytes, publicWitness); err != nil {
+			t.Fatalf("tier %d: %v", n, err)
+		}
+
+		solidityProof, err := plonkSolidityProof(proofBytes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		nbCommitments := len(vk.(*plonk_bn254.VerifyingKey).CommitmentConstraintIndexes)
+		if want := 768 + 96*nbCommitments; len(solidityProof) != want {
+			t.Fatalf("solidity proof length = %d, want %d", len(solidityProof), want)
+		}
+	}
+}
+
+func TestPlonkSetupAddTier(t *testing.T) {
+	defer func(maxValidators []int, maxDomain uint64) {
+		MaxValidators, MaxPlonkDomain = maxValidators, maxDomain
+	}(MaxValidators, MaxPlonkDomain)
+	newSumCircuit := func(n int) frontend.Circuit { return &sumCircuit{Values: make([]frontend.Variable, n)} }
+	large, err := frontend.Compile(ecc.BN254.ScalarField(), scs.NewBuilder, newSumCircuit(64))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// without a max domain, the SRS of the small tier doesn't fit the large one
+	dir := t.TempDir()
+	MaxPlonkDomain = 0
+	MaxValidators = []int{4}
+	if err := setupPlonk(dir, newSumCircuit); err != nil {
+		t.Fatal(err)
+	}
+	MaxValidators = []int{4, 64}
+	if err := setupPlonk(dir, newSumCircuit); err == nil {
+		t.Fatal("expected the large tier not to fit the SRS of the small one")
+	}
+
+	dir = t.TempDir()
+	MaxPlonkDomain = lagrangeSize(large)
+	MaxValidators = []int{4}
+	if err := setupPlonk(dir, newSumCircuit); err != nil {
+		t.Fatal(err)
+	}
+	MaxValidators = []int{4, 64}
+	if err := setupPlonk(dir, newSumCircuit); err != nil {
+		t.Fatal(err)
+	}
+	for _, n := range MaxValidators {
+		testPlonkTier(t, dir, n)
+	}
+
+	// a regenerated SRS fits the tiers on disk, not only the missing ones
+	MaxPlonkDomain = 0
+	for _, path := range []string{srsPath(dir), scsPathTmp(dir, "4"), pkPathTmp(dir, "4"), vkPathTmp(dir, "4"), solPathTmp(dir, "4")} {
+		if err := os.Remove(path); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := setupPlonk(dir, newSumCircuit); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadOrInitSRS(srsPath(dir), lagrangeSize(large)+3); err != nil {
+		t.Fatal(err)
+	}
+	testPlonkTier(t, dir, 4)
+}
+
+// testPlonkTier proves and verifies the sumCircuit of n values with the artifacts of tier n in dir.
+func testPlonkTier(t *testing.T, dir string, n int) {
+	t.Helper()
+	ccs, pk, vk, err := readPlonk(dir, strconv.Itoa(n))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assignment := &sumCircuit{Total: n, Values: make([]frontend.Variable, n)}
+	for j := range assignment.Values {
+		assignment.Values[j] = 1
+	}
+	fullWitness, err := frontend.NewWitness(assignment, ecc.BN254.ScalarField())
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicWitness, err := fullWitness.Public()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tier := Tier(n)
+	p := &ZkProver{
+		cs:      map[Tier]constraint.ConstraintSystem{tier: ccs},
+		plonkPk: map[Tier]plonk.ProvingKey{tier: pk},
+		plonkVk: map[Tier]plonk.VerifyingKey{tier: vk},
+	}
+	proofBytes, err := p.provePlonk(tier, fullWitness, publicWitness)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.verifyPlonk(tier, proofBytes, publicWitness); err != nil {
+		t.Fatalf("tier %d: %v", n, err)
+	}
+}
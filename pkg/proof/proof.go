@@ -3,7 +3,6 @@ package proof
 import (
 	"bytes"
 	"crypto/sha256"
-	"encoding/hex"
 	"fmt"
 	"log/slog"
 	"math/big"
@@ -19,7 +18,9 @@ import (
 	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
 	"github.com/consensys/gnark/backend"
 	"github.com/consensys/gnark/backend/groth16"
+	"github.com/consensys/gnark/backend/plonk"
 	"github.com/consensys/gnark/backend/solidity"
+	"github.com/consensys/gnark/backend/witness"
 	"github.com/consensys/gnark/constraint"
 	"github.com/consensys/gnark/frontend"
 	"github.com/consensys/gnark/frontend/cs/r1cs"
@@ -30,6 +31,34 @@ var (
 	MaxValidators = []int{10}
 )
 
+// Tier identifies a circuit size by the maximum number of validators it accepts.
+type Tier int
+
+// ErrValsetTooLarge is returned when the number of active validators exceeds the largest tier in MaxValidators.
+type ErrValsetTooLarge struct {
+	TotalActiveValidators int
+	MaxValidators         int
+}
+
+func (e *ErrValsetTooLarge) Error() string {
+	return fmt.Sprintf("valset too large: %d active validators, largest tier is %d", e.TotalActiveValidators, e.MaxValidators)
+}
+
+// SelectTier returns the smallest tier that fits totalActiveValidators.
+// It mirrors SigVerifierBlsBn254ZK._getVerifier, so MaxValidators must be sorted in ascending order.
+func SelectTier(totalActiveValidators int) (Tier, error) {
+	for _, m := range MaxValidators {
+		if totalActiveValidators <= m {
+			return Tier(m), nil
+		}
+	}
+	largest := 0
+	if len(MaxValidators) > 0 {
+		largest = MaxValidators[len(MaxValidators)-1]
+	}
+	return 0, &ErrValsetTooLarge{TotalActiveValidators: totalActiveValidators, MaxValidators: largest}
+}
+
 func InitCircuitsDir(newCircuitsDir string) {
 	circuitsDir = newCircuitsDir
 }
@@ -38,84 +67,279 @@ var (
 	circuitsDir = "circuits"
 )
 
-func r1csPathTmp(suffix string) string {
-	return fmt.Sprintf(circuitsDir+"/circuit_%s.r1cs", suffix)
+func r1csPathTmp(dir, suffix string) string {
+	return fmt.Sprintf(dir+"/circuit_%s.r1cs", suffix)
+}
+
+func pkPathTmp(dir, suffix string) string {
+	return fmt.Sprintf(dir+"/circuit_%s.pk", suffix)
+}
+
+func vkPathTmp(dir, suffix string) string {
+	return fmt.Sprintf(dir+"/circuit_%s.vk", suffix)
 }
 
-func pkPathTmp(suffix string) string {
-	return fmt.Sprintf(circuitsDir+"/circuit_%s.pk", suffix)
+func solPathTmp(dir, suffix string) string {
+	return fmt.Sprintf(dir+"/Verifier_%s.sol", suffix)
 }
 
-func vkPathTmp(suffix string) string {
-	return fmt.Sprintf(circuitsDir+"/circuit_%s.vk", suffix)
+// artifactsDir returns the directory holding the artifact generation of the given circuit version.
+// V1 artifacts stay at the root of circuitsDir so existing setups keep loading them.
+func artifactsDir(version CircuitVersion) string {
+	if version == CircuitVersionV1 {
+		return circuitsDir
+	}
+	return fmt.Sprintf("%s/v%d", circuitsDir, version)
 }
 
-func solPathTmp(suffix string) string {
-	return fmt.Sprintf(circuitsDir+"/Verifier_%s.sol", suffix)
+// artifactsDir returns the directory holding the artifacts of the configured circuit. Every optional circuit mode
+// is a separate generation in a subdirectory of the version's artifacts.
+func (c Config) artifactsDir() string {
+	dir := artifactsDir(c.CircuitVersion)
+	if c.SplitInputHash {
+		dir += "/split"
+	}
+	if c.HashToG1 {
+		dir += "/hash-to-g1"
+	}
+	if c.SignerBitmap {
+		dir += "/signer-bitmap"
+	}
+	if c.CompressedKeys {
+		dir += "/compressed-keys"
+	}
+	return dir
 }
 
 type ProofData struct {
+	Backend               Backend
 	Proof                 []byte
 	Commitments           []byte
 	CommitmentPok         []byte
 	SignersAggVotingPower *big.Int
+	SignerBitmap          []byte // signer-bitmap circuits only, the bitmap the input hash commits to
 }
 
+// ValidatorData is the public data of a validator. Signing keys live apart, see pkg/bls.
 type ValidatorData struct {
-	PrivateKey  *big.Int
 	Key         bn254.G1Affine
 	KeyG2       bn254.G2Affine
 	VotingPower *big.Int
 	IsNonSigner bool
 }
 
+// Backend selects the proof system a ZkProver runs.
+type Backend int
+
+const (
+	// BackendGroth16 needs a circuit-specific setup and verifier per tier.
+	BackendGroth16 Backend = iota
+	// BackendPlonk shares one universal KZG SRS between all tiers.
+	BackendPlonk
+)
+
+func (b Backend) String() string {
+	switch b {
+	case BackendGroth16:
+		return "groth16"
+	case BackendPlonk:
+		return "plonk"
+	default:
+		return "unknown(" + strconv.Itoa(int(b)) + ")"
+	}
+}
+
+// ParseBackend returns the backend named s, as printed by Backend.String.
+func ParseBackend(s string) (Backend, error) {
+	for _, b := range []Backend{BackendGroth16, BackendPlonk} {
+		if s == b.String() {
+			return b, nil
+		}
+	}
+	return 0, errors.Errorf("unknown backend %q", s)
+}
+
+// Config selects the circuit artifacts a ZkProver loads.
+type Config struct {
+	CircuitVersion CircuitVersion
+	Backend        Backend
+	// SplitInputHash exposes the whole keccak input hash as two public inputs, its high and low 128 bits,
+	// instead of a single one with the top three bits cleared. It needs CircuitVersionV3 or later and verifiers
+	// taking two public inputs, so it stays off until SigVerifierBlsBn254ZK is migrated.
+	SplitInputHash bool
+	// HashToG1 proves MessageG1 = BN254.hashToG1(MessageHash) in-circuit and binds the raw 32-byte message hash
+	// instead of the point, so verifiers only hash bytes. It needs CircuitVersionV3 or later.
+	HashToG1 bool
+	// SignerBitmap binds keccak256 of the signer bitmap, see SignerBitmap, into the input hash, so contracts can
+	// check participation claims against the proof. It needs CircuitVersionV3 or later.
+	SignerBitmap bool
+	// CompressedKeys takes the validators' keys in the 32-byte KeyBlsBn254 encoding and commits to the valset with
+	// the keccak hash SigVerifierBlsBn254Simple checks, so both verifiers share validatorSetHashKeccak256. It needs
+	// CircuitVersionV3 or later.
+	CompressedKeys bool
+}
+
+// ValsetHash returns the valset hash function of the configured circuit.
+func (c Config) ValsetHash() ValsetHashFunction {
+	if c.CompressedKeys {
+		return ValsetHashKeccak256
+	}
+	return c.CircuitVersion.ValsetHash()
+}
+
+// DefaultConfig returns the configuration matching the committed Verifier_N.sol files.
+func DefaultConfig() Config {
+	return Config{
+		CircuitVersion: CircuitVersionV1,
+		Backend:        BackendGroth16,
+	}
+}
+
+// ErrInvalidProof is wrapped by the errors of proofs the verifier rejects, as opposed to proofs that can't be read.
+var ErrInvalidProof = errors.New("invalid proof")
+
 type ZkProver struct {
-	cs map[int]constraint.ConstraintSystem
-	pk map[int]groth16.ProvingKey
-	vk map[int]groth16.VerifyingKey
+	cfg     Config
+	cs      map[Tier]constraint.ConstraintSystem
+	pk      map[Tier]groth16.ProvingKey
+	vk      map[Tier]groth16.VerifyingKey
+	plonkPk map[Tier]plonk.ProvingKey
+	plonkVk map[Tier]plonk.VerifyingKey
 }
 
 func NewZkProver() *ZkProver {
-	p := ZkProver{
-		cs: make(map[int]constraint.ConstraintSystem),
-		pk: make(map[int]groth16.ProvingKey),
-		vk: make(map[int]groth16.VerifyingKey),
-	}
-	p.init()
-	return &p
+	return NewZkProverWithConfig(DefaultConfig())
 }
 
-func (p *ZkProver) init() {
+// NewZkProverWithConfig loads the prover of cfg, running the setup of the tiers whose artifacts are missing. It
+// panics if the artifacts can't be loaded or generated.
+func NewZkProverWithConfig(cfg Config) *ZkProver {
+	p := newZkProver(cfg)
 	slog.Warn("ZK prover initialization started (might take a few seconds)")
+	if err := p.load(true); err != nil {
+		panic(err)
+	}
+	slog.Info("ZK prover initialization is done")
+	return p
+}
+
+// LoadZkProver loads the prover of cfg from the artifacts on disk. Unlike NewZkProverWithConfig it never runs the
+// setup: missing artifacts are an error.
+func LoadZkProver(cfg Config) (*ZkProver, error) {
+	p := newZkProver(cfg)
+	if err := p.load(false); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func newZkProver(cfg Config) *ZkProver {
+	return &ZkProver{
+		cfg:     cfg,
+		cs:      make(map[Tier]constraint.ConstraintSystem),
+		pk:      make(map[Tier]groth16.ProvingKey),
+		vk:      make(map[Tier]groth16.VerifyingKey),
+		plonkPk: make(map[Tier]plonk.ProvingKey),
+		plonkVk: make(map[Tier]plonk.VerifyingKey),
+	}
+}
+
+// Config returns the configuration the prover loaded its artifacts for.
+func (p *ZkProver) Config() Config {
+	return p.cfg
+}
+
+// load reads the artifacts of every tier, generating the missing ones if setup is set.
+func (p *ZkProver) load(setup bool) error {
 	for _, size := range MaxValidators {
-		cs, pk, vk, err := loadOrInit(size)
+		tier := Tier(size)
+		if p.cfg.Backend == BackendPlonk {
+			load := loadPlonk
+			if setup {
+				load = loadOrInitPlonk
+			}
+			cs, pk, vk, err := load(p.cfg, tier)
+			if err != nil {
+				return err
+			}
+			p.cs[tier] = cs
+			p.plonkPk[tier] = pk
+			p.plonkVk[tier] = vk
+			continue
+		}
+
+		load := loadGroth16
+		if setup {
+			load = loadOrInit
+		}
+		cs, pk, vk, err := load(p.cfg, tier)
 		if err != nil {
-			panic(err)
+			return err
 		}
-		p.cs[size] = cs
-		p.pk[size] = pk
-		p.vk[size] = vk
+		p.cs[tier] = cs
+		p.pk[tier] = pk
+		p.vk[tier] = vk
 	}
-	slog.Info("ZK prover initialization is done")
+	return nil
+}
+
+// Verify checks proofBytes against publicInputHash using the tier selected for totalActiveValidators,
+// the same way SigVerifierBlsBn254ZK picks its verifier.
+func (p *ZkProver) Verify(totalActiveValidators int, publicInputHash common.Hash, proofBytes []byte) (bool, error) {
+	tier, err := SelectTier(totalActiveValidators)
+	if err != nil {
+		return false, err
+	}
+
+	publicWitness, err := publicInputWitness(publicInputHash, p.cfg.SplitInputHash)
+	if err != nil {
+		return false, err
+	}
+
+	if p.cfg.Backend == BackendPlonk {
+		// the proof is followed by the 32-byte signers voting power
+		if len(proofBytes) <= 32 {
+			return false, errors.Errorf("invalid proof length %d", len(proofBytes))
+		}
+		if err := p.verifyPlonk(tier, proofBytes[:len(proofBytes)-32], publicWitness); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	vk, ok := p.vk[tier]
+	if !ok {
+		return false, errors.Errorf("failed to find verification key for tier %d", tier)
+	}
+	if err := verifyGroth16(vk, proofBytes, publicWitness); err != nil {
+		return false, err
+	}
+	return true, nil
 }
 
-func (p *ZkProver) Verify(valsetLen int, publicInputHash common.Hash, proofBytes []byte) (bool, error) {
-	valsetLen = getOptimalN(valsetLen)
-	assignment := Circuit{}
-	publicInputHashInt := new(big.Int).SetBytes(publicInputHash[:])
-	mask, _ := big.NewInt(0).SetString("1FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF", 16)
-	publicInputHashInt.And(publicInputHashInt, mask)
-	assignment.InputHash = publicInputHashInt
+// publicInputWitness returns the public witness of a circuit whose public inputs are derived from the keccak
+// digest publicInputHash, either masked into one input or split into two.
+func publicInputWitness(publicInputHash common.Hash, split bool) (witness.Witness, error) {
+	assignment := Circuit{SplitInputHash: split}
+	setInputHash(&assignment, publicInputHash[:])
 
-	slog.Debug("[Verify] input hash", "hash", hex.EncodeToString(publicInputHashInt.Bytes()))
+	slog.Debug("[Verify] input hash", "hash", publicInputHash.Hex(), "split", split)
 
 	witness, err := frontend.NewWitness(&assignment, ecc.BN254.ScalarField(), frontend.PublicOnly())
 	if err != nil {
-		return false, errors.Errorf("failed to create witness: %w", err)
+		return nil, errors.Errorf("failed to create witness: %w", err)
 	}
 	publicWitness, err := witness.Public()
 	if err != nil {
-		return false, errors.Errorf("failed to get public witness: %w", err)
+		return nil, errors.Errorf("failed to get public witness: %w", err)
+	}
+	return publicWitness, nil
+}
+
+// verifyGroth16 checks a proof in the ProofData.Marshal layout against vk.
+func verifyGroth16(vk groth16.VerifyingKey, proofBytes []byte, publicWitness witness.Witness) error {
+	if len(proofBytes) < 384 {
+		return errors.Errorf("invalid proof length %d", len(proofBytes))
 	}
 
 	rawProofBytes := bytes.Clone(proofBytes[:256])
@@ -123,44 +347,149 @@ func (p *ZkProver) Verify(valsetLen int, publicInputHash common.Hash, proofBytes
 	rawProofBytes = append(rawProofBytes, proofBytes[256:384]...)
 	reader := bytes.NewReader(rawProofBytes)
 	proof := groth16.NewProof(ecc.BN254)
-	_, err = proof.ReadFrom(reader)
+	_, err := proof.ReadFrom(reader)
 	if err != nil {
-		return false, errors.Errorf("failed to read proof: %w", err)
-	}
-
-	vk, ok := p.vk[valsetLen]
-	if !ok {
-		return false, errors.Errorf("failed to find verification key for valset length %d", valsetLen)
+		return errors.Errorf("failed to read proof: %w", err)
 	}
 
 	err = groth16.Verify(proof, vk, publicWitness, backend.WithVerifierHashToFieldFunction(sha256.New()))
 	if err != nil {
-		return false, errors.Errorf("failed to verify: %w", err)
+		return errors.Errorf("%w: %w", ErrInvalidProof, err)
 	}
-	return true, nil
+	return nil
 }
 
+// Prove generates a proof for the active validators in proveInput.ValidatorData.
+// The valset is sorted and padded to the selected tier internally, so it must not contain filler entries. Its keys
+// are checked with ValidateKeys first.
 func (p *ZkProver) Prove(proveInput ProveInput) (ProofData, error) {
-	pk := p.pk[len(proveInput.ValidatorData)]
-	vk := p.vk[len(proveInput.ValidatorData)]
-	r1cs, ok := p.cs[len(proveInput.ValidatorData)]
-	if !ok {
-		return ProofData{}, errors.Errorf("failed to load cs, vk, pk for valset size: %d", len(proveInput.ValidatorData))
+	prepared, err := prepareWitness(p.cfg, proveInput)
+	if err != nil {
+		return ProofData{}, err
+	}
+	return p.proveWitness(prepared)
+}
+
+// preparedWitness is a full witness with the tier and the proof tail data it was built for.
+type preparedWitness struct {
+	tier                  Tier
+	witness               witness.Witness
+	signersAggVotingPower *big.Int
+	signerBitmap          []byte // signer-bitmap circuits only
+}
+
+// prepareWitness normalizes proveInput, checks it against the configured circuit and assigns the full witness.
+// It needs no proving keys.
+func prepareWitness(cfg Config, proveInput ProveInput) (preparedWitness, error) {
+	tier, err := SelectTier(len(proveInput.ValidatorData))
+	if err != nil {
+		return preparedWitness{}, err
+	}
+
+	// every version hashes voting powers as 32-byte words
+	for i := range proveInput.ValidatorData {
+		if !isWord(proveInput.ValidatorData[i].VotingPower) {
+			return preparedWitness{}, errors.Errorf("voting power of validator %d is not a uint256", i)
+		}
+	}
+	normalizedValset, err := NormalizeValset(cfg.CircuitVersion, proveInput.ValidatorData)
+	if err != nil {
+		return preparedWitness{}, err
+	}
+	// the circuit trusts the keys, a bad one would only fail the proof
+	if err := ValidateKeys(proveInput, KeyValidationOptions{}).Err(); err != nil {
+		return preparedWitness{}, err
+	}
+	proveInput.ValidatorData = normalizedValset
+	if cfg.HashToG1 {
+		messageG1, counter := HashToG1(proveInput.MessageHash)
+		if counter >= hashToG1MaxAttempts {
+			return preparedWitness{}, errors.Errorf("hash to G1 needs %d increments, at most %d are supported", counter, hashToG1MaxAttempts-1)
+		}
+		if !proveInput.MessageG1.IsInfinity() && !proveInput.MessageG1.Equal(&messageG1) {
+			return preparedWitness{}, errors.Errorf("message G1 is not the hash of message %x", proveInput.MessageHash)
+		}
+		proveInput.MessageG1 = messageG1
+	}
+	if cfg.CircuitVersion >= CircuitVersionV2 {
+		for i := range proveInput.ValidatorData {
+			if proveInput.ValidatorData[i].VotingPower.BitLen() > MaxVotingPowerBits {
+				return preparedWitness{}, errors.Errorf("voting power of validator %d exceeds %d bits", i, MaxVotingPowerBits)
+			}
+		}
+	}
+
+	_, nonSignersAggVotingPower, totalVotingPower := getNonSignersData(proveInput.ValidatorData)
+	signersAggVotingPower := new(big.Int).Sub(totalVotingPower, nonSignersAggVotingPower)
+	if !isWord(signersAggVotingPower) {
+		return preparedWitness{}, errors.Errorf("signers voting power %s exceeds 256 bits", signersAggVotingPower)
+	}
+
+	if cfg.CircuitVersion >= CircuitVersionV4 {
+		if proveInput.QuorumThreshold == nil {
+			return preparedWitness{}, errors.Errorf("quorum threshold is required by circuit version %d", cfg.CircuitVersion)
+		}
+		if !isWord(proveInput.QuorumThreshold) {
+			return preparedWitness{}, errors.Errorf("quorum threshold %s is not a uint256", proveInput.QuorumThreshold)
+		}
+		if signersAggVotingPower.Cmp(proveInput.QuorumThreshold) < 0 {
+			return preparedWitness{}, errors.Errorf("signers voting power %s is below quorum threshold %s", signersAggVotingPower, proveInput.QuorumThreshold)
+		}
+		if proveInput.Epoch >= 1<<epochBits {
+			return preparedWitness{}, errors.Errorf("epoch %d exceeds %d bits", proveInput.Epoch, epochBits)
+		}
 	}
 
 	// witness definition
-	assignment := Circuit{}
+	assignment := Circuit{Version: cfg.CircuitVersion, SplitInputHash: cfg.SplitInputHash, HashToG1: cfg.HashToG1, SignerBitmap: cfg.SignerBitmap, CompressedKeys: cfg.CompressedKeys}
 	setCircuitData(&assignment, proveInput)
 
+	var bitmap []byte
+	if cfg.SignerBitmap {
+		bitmap = signerBitmap(proveInput.ValidatorData)
+	}
+
 	witness, err := frontend.NewWitness(&assignment, ecc.BN254.ScalarField())
 	if err != nil {
-		return ProofData{}, errors.Errorf("failed to create witness: %w", err)
+		return preparedWitness{}, errors.Errorf("failed to create witness: %w", err)
+	}
+	return preparedWitness{
+		tier:                  tier,
+		witness:               witness,
+		signersAggVotingPower: signersAggVotingPower,
+		signerBitmap:          bitmap,
+	}, nil
+}
+
+// proveWitness proves and verifies a prepared witness with the keys of its tier.
+func (p *ZkProver) proveWitness(prepared preparedWitness) (ProofData, error) {
+	tier := prepared.tier
+	pk := p.pk[tier]
+	vk := p.vk[tier]
+	r1cs, ok := p.cs[tier]
+	if !ok {
+		return ProofData{}, errors.Errorf("failed to load cs, vk, pk for tier: %d", tier)
 	}
+
+	witness := prepared.witness
 	publicWitness, err := witness.Public()
 	if err != nil {
 		return ProofData{}, errors.Errorf("failed to get public witness: %w", err)
 	}
 
+	if p.cfg.Backend == BackendPlonk {
+		proofBytes, err := p.provePlonk(tier, witness, publicWitness)
+		if err != nil {
+			return ProofData{}, err
+		}
+		return ProofData{
+			Backend:               BackendPlonk,
+			Proof:                 proofBytes,
+			SignersAggVotingPower: prepared.signersAggVotingPower,
+			SignerBitmap:          prepared.signerBitmap,
+		}, nil
+	}
+
 	// groth16: Prove & Verify
 	proof, err := groth16.Prove(r1cs, pk, witness, backend.WithProverHashToFieldFunction(sha256.New()))
 	if err != nil {
@@ -192,9 +521,19 @@ func (p *ZkProver) Prove(proveInput ProveInput) (ProofData, error) {
 		return ProofData{}, err
 	}
 
+	proofData, err := groth16ProofData(proof, prepared.signersAggVotingPower)
+	if err != nil {
+		return ProofData{}, err
+	}
+	proofData.SignerBitmap = prepared.signerBitmap
+	return proofData, nil
+}
+
+// groth16ProofData splits a Groth16 proof into the ProofData layout SigVerifierBlsBn254ZK expects.
+func groth16ProofData(proof groth16.Proof, signersAggVotingPower *big.Int) (ProofData, error) {
 	// Serialize the proof
 	var proofBuffer bytes.Buffer
-	_, err = proof.WriteRawTo(&proofBuffer)
+	_, err := proof.WriteRawTo(&proofBuffer)
 	if err != nil {
 		return ProofData{}, errors.Errorf("failed to write proof: %w", err)
 	}
@@ -221,76 +560,61 @@ func (p *ZkProver) Prove(proveInput ProveInput) (ProofData, error) {
 	commitmentPok[0] = new(big.Int).SetBytes(proofBytes[4+fpSize*10 : 4+fpSize*11]) // CommitmentPok.x
 	commitmentPok[1] = new(big.Int).SetBytes(proofBytes[4+fpSize*11 : 4+fpSize*12]) // CommitmentPok.y
 
-	_, nonSignersAggVotingPower, totalVotingPower := getNonSignersData(proveInput.ValidatorData)
 	return ProofData{
 		Proof:                 proofBytes[:256],
 		Commitments:           proofBytes[260:324],
 		CommitmentPok:         proofBytes[324:388],
-		SignersAggVotingPower: new(big.Int).Sub(totalVotingPower, nonSignersAggVotingPower),
+		SignersAggVotingPower: signersAggVotingPower,
 	}, nil
 }
 
-func loadOrInit(valsetLen int) (constraint.ConstraintSystem, groth16.ProvingKey, groth16.VerifyingKey, error) {
-	suffix := strconv.Itoa(valsetLen)
-	r1csP := r1csPathTmp(suffix)
-	pkP := pkPathTmp(suffix)
-	vkP := vkPathTmp(suffix)
-	solP := solPathTmp(suffix)
-
-	if exists(r1csP) && exists(pkP) && exists(vkP) && exists(solP) {
-		r1csCS := groth16.NewCS(bn254.ID)
-		data, err := os.Open(r1csP)
-		if err != nil {
-			return nil, nil, nil, errors.Errorf("failed to open r1cs: %w", err)
-		}
-		defer data.Close()
-		if _, err := r1csCS.ReadFrom(data); err != nil {
-			return nil, nil, nil, errors.Errorf("failed to read r1cs: %w", err)
-		}
-
-		pk := groth16.NewProvingKey(bn254.ID)
-		data, err = os.Open(pkP)
-		if err != nil {
-			return nil, nil, nil, errors.Errorf("failed to open pk: %w", err)
-		}
-		defer data.Close()
-		if _, err := pk.UnsafeReadFrom(data); err != nil {
-			return nil, nil, nil, errors.Errorf("failed to read pk: %w", err)
-		}
+// loadGroth16 loads the Groth16 artifacts of tier, failing if the setup didn't write them.
+func loadGroth16(cfg Config, tier Tier) (constraint.ConstraintSystem, groth16.ProvingKey, groth16.VerifyingKey, error) {
+	dir := cfg.artifactsDir()
+	suffix := strconv.Itoa(int(tier))
+	if err := checkManifest(dir, cfg); err != nil {
+		return nil, nil, nil, err
+	}
+	if !exists(r1csPathTmp(dir, suffix)) || !exists(pkPathTmp(dir, suffix)) || !exists(vkPathTmp(dir, suffix)) {
+		return nil, nil, nil, errors.Errorf("missing artifacts of tier %d in %s, run the setup first", tier, dir)
+	}
+	return readGroth16(dir, suffix)
+}
 
-		vk := groth16.NewVerifyingKey(bn254.ID)
-		data, err = os.Open(vkP)
-		if err != nil {
-			return nil, nil, nil, errors.Errorf("failed to open vk: %w", err)
-		}
-		defer data.Close()
-		if _, err := vk.UnsafeReadFrom(data); err != nil {
-			return nil, nil, nil, errors.Errorf("failed to read vk: %w", err)
-		}
+func loadOrInit(cfg Config, tier Tier) (constraint.ConstraintSystem, groth16.ProvingKey, groth16.VerifyingKey, error) {
+	dir := cfg.artifactsDir()
+	suffix := strconv.Itoa(int(tier))
+	r1csP := r1csPathTmp(dir, suffix)
+	pkP := pkPathTmp(dir, suffix)
+	vkP := vkPathTmp(dir, suffix)
+	solP := solPathTmp(dir, suffix)
 
-		return r1csCS, pk, vk, nil
+	if err := checkManifest(dir, cfg); err != nil {
+		return nil, nil, nil, err
+	}
+	if exists(r1csP) && exists(pkP) && exists(vkP) && exists(solP) {
+		return readGroth16(dir, suffix)
 	}
 
-	if err := os.MkdirAll(circuitsDir, 0o755); err != nil {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, nil, nil, err
+	}
+	if err := writeManifest(dir, cfg); err != nil {
 		return nil, nil, nil, err
 	}
 
 	for _, m := range MaxValidators {
 		suf := strconv.Itoa(m)
-		r1csFile := r1csPathTmp(suf)
-		pkFile := pkPathTmp(suf)
-		vkFile := vkPathTmp(suf)
-		solFile := solPathTmp(suf)
+		r1csFile := r1csPathTmp(dir, suf)
+		pkFile := pkPathTmp(dir, suf)
+		vkFile := vkPathTmp(dir, suf)
+		solFile := solPathTmp(dir, suf)
 
 		if exists(r1csFile) && exists(pkFile) && exists(vkFile) && exists(solFile) {
 			continue
 		}
 
-		circ := Circuit{
-			ValidatorData: make([]ValidatorDataCircuit, m),
-		}
-
-		cs_i, err := frontend.Compile(bn254.ID.ScalarField(), r1cs.NewBuilder, &circ)
+		cs_i, err := frontend.Compile(bn254.ID.ScalarField(), r1cs.NewBuilder, newCircuit(cfg, m))
 		if err != nil {
 			return nil, nil, nil, err
 		}
@@ -299,24 +623,8 @@ func loadOrInit(valsetLen int) (constraint.ConstraintSystem, groth16.ProvingKey,
 			return nil, nil, nil, err
 		}
 
-		{
-			var buf bytes.Buffer
-			cs_i.WriteTo(&buf)
-			os.WriteFile(r1csFile, buf.Bytes(), 0600)
-		}
-		{
-			f, err := os.Create(pkFile)
-			if err != nil {
-				return nil, nil, nil, err
-			}
-			pk_i.WriteRawTo(f)
-			f.Close()
-			f, err = os.Create(vkFile)
-			if err != nil {
-				return nil, nil, nil, err
-			}
-			vk_i.WriteRawTo(f)
-			f.Close()
+		if err := writeGroth16(dir, suf, cs_i, pk_i, vk_i); err != nil {
+			return nil, nil, nil, err
 		}
 		{
 			f, err := os.Create(solFile)
@@ -328,7 +636,72 @@ func loadOrInit(valsetLen int) (constraint.ConstraintSystem, groth16.ProvingKey,
 		}
 	}
 
-	return loadOrInit(valsetLen)
+	return loadOrInit(cfg, tier)
+}
+
+// readGroth16 loads the constraint system and keys stored under suffix in dir.
+func readGroth16(dir, suffix string) (constraint.ConstraintSystem, groth16.ProvingKey, groth16.VerifyingKey, error) {
+	r1csCS := groth16.NewCS(bn254.ID)
+	data, err := os.Open(r1csPathTmp(dir, suffix))
+	if err != nil {
+		return nil, nil, nil, errors.Errorf("failed to open r1cs: %w", err)
+	}
+	defer data.Close()
+	if _, err := r1csCS.ReadFrom(data); err != nil {
+		return nil, nil, nil, errors.Errorf("failed to read r1cs: %w", err)
+	}
+
+	pk := groth16.NewProvingKey(bn254.ID)
+	data, err = os.Open(pkPathTmp(dir, suffix))
+	if err != nil {
+		return nil, nil, nil, errors.Errorf("failed to open pk: %w", err)
+	}
+	defer data.Close()
+	if _, err := pk.UnsafeReadFrom(data); err != nil {
+		return nil, nil, nil, errors.Errorf("failed to read pk: %w", err)
+	}
+
+	vk := groth16.NewVerifyingKey(bn254.ID)
+	data, err = os.Open(vkPathTmp(dir, suffix))
+	if err != nil {
+		return nil, nil, nil, errors.Errorf("failed to open vk: %w", err)
+	}
+	defer data.Close()
+	if _, err := vk.UnsafeReadFrom(data); err != nil {
+		return nil, nil, nil, errors.Errorf("failed to read vk: %w", err)
+	}
+
+	return r1csCS, pk, vk, nil
+}
+
+// writeGroth16 stores the constraint system and keys under suffix in dir.
+func writeGroth16(dir, suffix string, cs constraint.ConstraintSystem, pk groth16.ProvingKey, vk groth16.VerifyingKey) error {
+	var buf bytes.Buffer
+	if _, err := cs.WriteTo(&buf); err != nil {
+		return errors.Errorf("failed to write r1cs: %w", err)
+	}
+	if err := os.WriteFile(r1csPathTmp(dir, suffix), buf.Bytes(), 0600); err != nil {
+		return errors.Errorf("failed to write r1cs: %w", err)
+	}
+
+	f, err := os.Create(pkPathTmp(dir, suffix))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err := pk.WriteRawTo(f); err != nil {
+		return errors.Errorf("failed to write pk: %w", err)
+	}
+
+	f, err = os.Create(vkPathTmp(dir, suffix))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err := vk.WriteRawTo(f); err != nil {
+		return errors.Errorf("failed to write vk: %w", err)
+	}
+	return nil
 }
 
 func exists(path string) bool {
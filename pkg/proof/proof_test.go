@@ -1,26 +1,83 @@
 package proof
 
 import (
+	"bytes"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"math/big"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 
+	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	plonk_bn254 "github.com/consensys/gnark/backend/plonk/bn254"
+	"github.com/consensys/gnark/frontend"
+
+	"middleware-offchain/pkg/bls"
 )
 
+// testSigners maps the keys of the test valsets to their signers, since ValidatorData holds no secret keys.
+var testSigners sync.Map
+
+func newTestSigner(scalar *big.Int) *bls.SecretKey {
+	signer, err := bls.NewSecretKey(scalar)
+	if err != nil {
+		panic(err)
+	}
+	testSigners.Store(signer.PublicKeyG1(), signer)
+	return signer
+}
+
+func testSigner(key bn254.G1Affine) *bls.SecretKey {
+	signer, ok := testSigners.Load(key)
+	if !ok {
+		panic(fmt.Sprintf("no test signer of key %s", key.String()))
+	}
+	return signer.(*bls.SecretKey)
+}
+
+// getAggSignature signs message with every signer of valset and aggregates the signatures and keys.
+func getAggSignature(message bn254.G1Affine, valset *[]ValidatorData) (signature *bn254.G1Affine, aggKeyG2 *bn254.G2Affine, aggKeyG1 *bn254.G1Affine) {
+	aggKeyG2 = new(bn254.G2Affine)
+	aggKeyG2.SetInfinity()
+
+	aggSignature := new(bn254.G1Affine)
+	aggSignature.SetInfinity()
+
+	aggKeyG1 = new(bn254.G1Affine)
+	aggKeyG1.SetInfinity()
+
+	for i := range *valset {
+		if !(*valset)[i].IsNonSigner && !(*valset)[i].Key.IsInfinity() {
+			aggKeyG2 = aggKeyG2.Add(aggKeyG2, &(*valset)[i].KeyG2)
+			aggKeyG1 = aggKeyG1.Add(aggKeyG1, &(*valset)[i].Key)
+			sig, err := testSigner((*valset)[i].Key).Sign(message)
+			if err != nil {
+				panic(err)
+			}
+			aggSignature = aggSignature.Add(aggSignature, &sig)
+		}
+	}
+
+	return aggSignature, aggKeyG2, aggKeyG1
+}
+
 func genValset(numValidators int, nonSigners []int) []ValidatorData {
 	valset := make([]ValidatorData, numValidators)
 	for i := 0; i < numValidators; i++ {
-		pk := big.NewInt(int64(i + 10))
-		valset[i].PrivateKey = pk
-		valset[i].Key = getPubkeyG1(pk)
-		valset[i].KeyG2 = getPubkeyG2(pk)
+		signer := newTestSigner(big.NewInt(int64(i + 10)))
+		valset[i].Key = signer.PublicKeyG1()
+		valset[i].KeyG2 = signer.PublicKeyG2()
 		valset[i].VotingPower = big.NewInt(100)
 		valset[i].IsNonSigner = false
 	}
@@ -46,9 +103,9 @@ func mockValset() []ValidatorData {
 		if !ok {
 			panic(errors.New("failed to convert pk to big.Int"))
 		}
-		valset[i].PrivateKey = pk
-		valset[i].Key = getPubkeyG1(pk)
-		valset[i].KeyG2 = getPubkeyG2(pk)
+		signer := newTestSigner(pk)
+		valset[i].Key = signer.PublicKeyG1()
+		valset[i].KeyG2 = signer.PublicKeyG2()
 		valset[i].VotingPower = big.NewInt(10000000000000)
 		valset[i].IsNonSigner = false
 	}
@@ -82,7 +139,10 @@ func TestProof(t *testing.T) {
 	valset := genValset(10, []int{})
 	// valset := mockValset()
 
-	validatorData := NormalizeValset(valset)
+	validatorData, err := NormalizeValset(CircuitVersionV1, valset)
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	messageG1Hex := "04c3256b0d7e3f3766d9d3f08fad062e025db392f7b8d8d86322602365b82eba2370c94328160af53802c073a5ddafe012a4073eca842339acc5caae83e1b922"
 	messageG1 := &bn254.G1Affine{}
@@ -90,10 +150,10 @@ func TestProof(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	aggSignature, aggKeyG2, _ := getAggSignature(*messageG1, &validatorData)
+	aggSignature, aggKeyG2, _ := getAggSignature(*messageG1, &valset)
 
 	proveInput := ProveInput{
-		ValidatorData:   validatorData,
+		ValidatorData:   valset,
 		MessageG1:       *messageG1,
 		Signature:       *aggSignature,
 		SignersAggKeyG2: *aggKeyG2,
@@ -111,9 +171,9 @@ func TestProof(t *testing.T) {
 	fmt.Println("CommitmentPok:", hex.EncodeToString(proofData.CommitmentPok))
 	fmt.Println("SignersAggVotingPower:", proofData.SignersAggVotingPower.String())
 
-	inputHash := calculateInputHash(HashValset(valset), proofData.SignersAggVotingPower, messageG1)
+	inputHash := calculateInputHash(HashValset(validatorData), proofData.SignersAggVotingPower, messageG1)
 	startTime = time.Now()
-	res, err := prover.Verify(len(validatorData), inputHash, proofData.Marshal())
+	res, err := prover.Verify(len(valset), inputHash, proofData.Marshal())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -123,3 +183,297 @@ func TestProof(t *testing.T) {
 		t.Fatal("failed to verify")
 	}
 }
+
+// TestProofPlonk runs the PLONK backend on a 2-validator instance of the real circuit: setup, proving, native
+// verification and the Solidity export. The setup and proving take a while on a single core, -short skips it.
+func TestProofPlonk(t *testing.T) {
+	if testing.Short() {
+		t.Skip("PLONK setup and proving are slow")
+	}
+	prevCircuitsDir := circuitsDir
+	InitCircuitsDir(t.TempDir())
+	t.Cleanup(func() { InitCircuitsDir(prevCircuitsDir) })
+	defer func(maxValidators []int) { MaxValidators = maxValidators }(MaxValidators)
+	MaxValidators = []int{2}
+
+	cfg := DefaultConfig()
+	cfg.Backend = BackendPlonk
+	tier := Tier(MaxValidators[0])
+	prover := NewZkProverWithConfig(cfg)
+
+	valset := genValset(int(tier), []int{0})
+	validatorData, err := NormalizeValset(cfg.CircuitVersion, valset)
+	if err != nil {
+		t.Fatal(err)
+	}
+	messageG1 := testMessageG1(t)
+	aggSignature, aggKeyG2, _ := getAggSignature(messageG1, &valset)
+
+	proofData, err := prover.Prove(ProveInput{
+		ValidatorData:   valset,
+		MessageG1:       messageG1,
+		Signature:       *aggSignature,
+		SignersAggKeyG2: *aggKeyG2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proofData.Backend != BackendPlonk {
+		t.Fatalf("expected a PLONK proof, got %s", proofData.Backend)
+	}
+	if proofData.SignersAggVotingPower.Cmp(big.NewInt(100)) != 0 {
+		t.Fatalf("expected signers voting power 100, got %s", proofData.SignersAggVotingPower)
+	}
+
+	inputHash := calculateInputHash(HashValset(validatorData), proofData.SignersAggVotingPower, &messageG1)
+	res, err := prover.Verify(int(tier), inputHash, proofData.Marshal())
+	if err != nil || !res {
+		t.Fatalf("failed to verify: %v", err)
+	}
+	// the stand-alone verifier only loads the verifying key
+	res, err = VerifyProof(cfg, int(tier), inputHash, proofData.Marshal())
+	if err != nil || !res {
+		t.Fatalf("failed to verify with the verifying key: %v", err)
+	}
+	wrongHash := inputHash
+	wrongHash[31] ^= 1
+	if _, err := prover.Verify(int(tier), wrongHash, proofData.Marshal()); !errors.Is(err, ErrInvalidProof) {
+		t.Fatalf("expected a wrong input hash to be rejected as an invalid proof, got %v", err)
+	}
+
+	// the exported verifier is the one the setup wrote, and the calldata is its proof followed by the voting power
+	var exported bytes.Buffer
+	if err := ExportVerifier(cfg, tier, &exported); err != nil {
+		t.Fatal(err)
+	}
+	written, err := os.ReadFile(solPathTmp(plonkDir(cfg), strconv.Itoa(int(tier))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(exported.Bytes(), written) {
+		t.Fatalf("exported verifier differs from Verifier_%d.sol", tier)
+	}
+
+	calldata, err := proofData.MarshalSolidity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	nbCommitments := len(prover.plonkVk[tier].(*plonk_bn254.VerifyingKey).CommitmentConstraintIndexes)
+	if want := 768 + 96*nbCommitments + 32; len(calldata) != want {
+		t.Fatalf("calldata length = %d, want %d", len(calldata), want)
+	}
+	if new(big.Int).SetBytes(calldata[len(calldata)-32:]).Cmp(proofData.SignersAggVotingPower) != 0 {
+		t.Fatal("expected the calldata to end with the signers voting power")
+	}
+}
+
+// TestPublicInputWitness checks that Verify derives the public inputs the prover assigns, for both input hash
+// layouts. The exported Verifier_N.sol takes them in the same order.
+func TestPublicInputWitness(t *testing.T) {
+	valset := padValset(genValset(3, []int{1}), testCircuitSize)
+	message := testMessageG1(t)
+	aggSignature, aggKeyG2, _ := getAggSignature(message, &valset)
+	digest := calculateInputHash(HashValset(valset), big.NewInt(200), &message)
+
+	for _, tc := range []struct {
+		split         bool
+		nbPublicInput int
+	}{
+		{false, 1},
+		{true, 2},
+	} {
+		assignment := Circuit{Version: CircuitVersionV3, SplitInputHash: tc.split}
+		setCircuitData(&assignment, ProveInput{
+			ValidatorData:   valset,
+			MessageG1:       message,
+			Signature:       *aggSignature,
+			SignersAggKeyG2: *aggKeyG2,
+		})
+		proverWitness, err := frontend.NewWitness(&assignment, ecc.BN254.ScalarField(), frontend.PublicOnly())
+		if err != nil {
+			t.Fatal(err)
+		}
+		verifierWitness, err := publicInputWitness(digest, tc.split)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		proverInputs := proverWitness.Vector().(fr.Vector)
+		verifierInputs := verifierWitness.Vector().(fr.Vector)
+		if len(proverInputs) != tc.nbPublicInput || !slices.Equal(proverInputs, verifierInputs) {
+			t.Fatalf("split=%v: prover inputs %v, verifier inputs %v", tc.split, proverInputs, verifierInputs)
+		}
+	}
+}
+
+func TestSelectTier(t *testing.T) {
+	defer func(maxValidators []int) { MaxValidators = maxValidators }(MaxValidators)
+	MaxValidators = []int{10, 100, 1000}
+
+	for _, tc := range []struct {
+		totalActiveValidators int
+		tier                  Tier
+	}{
+		{0, 10},
+		{1, 10},
+		{10, 10},
+		{11, 100},
+		{100, 100},
+		{101, 1000},
+		{1000, 1000},
+	} {
+		tier, err := SelectTier(tc.totalActiveValidators)
+		if err != nil {
+			t.Fatalf("SelectTier(%d): %v", tc.totalActiveValidators, err)
+		}
+		if tier != tc.tier {
+			t.Fatalf("SelectTier(%d) = %d, want %d", tc.totalActiveValidators, tier, tc.tier)
+		}
+	}
+
+	_, err := SelectTier(1001)
+	var tooLarge *ErrValsetTooLarge
+	if !errors.As(err, &tooLarge) {
+		t.Fatalf("SelectTier(1001) error = %v, want ErrValsetTooLarge", err)
+	}
+	if tooLarge.TotalActiveValidators != 1001 || tooLarge.MaxValidators != 1000 {
+		t.Fatalf("unexpected error contents: %+v", tooLarge)
+	}
+}
+
+func TestNormalizeValset(t *testing.T) {
+	valset := genValset(3, []int{1})
+	normalized, err := NormalizeValset(CircuitVersionV1, valset)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(normalized) != MaxValidators[0] {
+		t.Fatalf("normalized length = %d, want %d", len(normalized), MaxValidators[0])
+	}
+	for i := len(valset); i < len(normalized); i++ {
+		if !normalized[i].Key.IsInfinity() {
+			t.Fatalf("entry %d is not a filler", i)
+		}
+	}
+
+	if _, err := NormalizeValset(CircuitVersionV1, normalized); err == nil {
+		t.Fatal("expected pre-padded valset to be rejected")
+	}
+
+	_, err = NormalizeValset(CircuitVersionV1, genValset(MaxValidators[len(MaxValidators)-1]+1, nil))
+	var tooLarge *ErrValsetTooLarge
+	if !errors.As(err, &tooLarge) {
+		t.Fatalf("error = %v, want ErrValsetTooLarge", err)
+	}
+}
+
+func TestSortValset(t *testing.T) {
+	// indices into genValset(16, nil) of the sorted valset, pinned so that the order is reproducible
+	want := map[CircuitVersion][]int{
+		CircuitVersionV4: {4, 15, 6, 8, 9, 14, 0, 10, 5, 7, 3, 13, 12, 11, 2, 1},
+		CircuitVersionV5: {3, 0, 9, 4, 6, 7, 13, 11, 12, 2, 10, 1, 14, 5, 8, 15},
+	}
+	valset := genValset(16, nil)
+	for version, indices := range want {
+		// the order doesn't depend on the input order, and sorting again keeps it
+		for shift := range valset {
+			permuted := append(slices.Clone(valset[shift:]), valset[:shift]...)
+			if shift%2 == 1 {
+				slices.Reverse(permuted)
+			}
+			SortValset(version, permuted)
+			SortValset(version, permuted)
+			for i, index := range indices {
+				if !permuted[i].Key.Equal(&valset[index].Key) {
+					t.Fatalf("V%d, shift %d: validator %d is not validator %d of the valset", version, shift, i, index)
+				}
+			}
+		}
+	}
+
+	// up to 12 validators, sort.Slice sorts by insertion too, so V1 to V4 keep the order their comparator gave
+	// a valset in the V5 order
+	for n := 1; n <= 12; n++ {
+		v5 := genValset(n, nil)
+		SortValset(CircuitVersionV5, v5)
+		released := slices.Clone(v5)
+		sort.Slice(released, func(i, j int) bool {
+			return released[i].Key.X.Cmp(&released[j].Key.X) < 0 || released[i].Key.Y.Cmp(&released[j].Key.Y) < 0
+		})
+		v4 := slices.Clone(v5)
+		slices.Reverse(v4)
+		SortValset(CircuitVersionV4, v4)
+		for i := range released {
+			if !v4[i].Key.Equal(&released[i].Key) {
+				t.Fatalf("%d validators: validator %d is not in the released V4 order", n, i)
+			}
+		}
+	}
+}
+
+// BenchmarkProve compares proving time and calldata size of the backends on the smallest tier.
+// Missing artifacts are generated before the timer starts, which takes a while on the first run.
+func BenchmarkProve(b *testing.B) {
+	messageG1 := &bn254.G1Affine{}
+	if err := messageG1.Unmarshal(common.Hex2Bytes("04c3256b0d7e3f3766d9d3f08fad062e025db392f7b8d8d86322602365b82eba2370c94328160af53802c073a5ddafe012a4073eca842339acc5caae83e1b922")); err != nil {
+		b.Fatal(err)
+	}
+	valset := genValset(MaxValidators[0], []int{0})
+	aggSignature, aggKeyG2, _ := getAggSignature(*messageG1, &valset)
+	proveInput := ProveInput{
+		ValidatorData:   valset,
+		MessageG1:       *messageG1,
+		Signature:       *aggSignature,
+		SignersAggKeyG2: *aggKeyG2,
+	}
+
+	for _, backend := range []Backend{BackendGroth16, BackendPlonk} {
+		b.Run(backend.String(), func(b *testing.B) {
+			cfg := DefaultConfig()
+			cfg.Backend = backend
+			prover := NewZkProverWithConfig(cfg)
+			b.ResetTimer()
+
+			var proofData ProofData
+			for range b.N {
+				var err error
+				proofData, err = prover.Prove(proveInput)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+
+			b.StopTimer()
+			calldata, err := proofData.MarshalSolidity()
+			if err != nil {
+				b.Fatal(err)
+			}
+			b.ReportMetric(float64(len(calldata)), "calldata-bytes")
+		})
+	}
+}
+
+func TestArtifactManifest(t *testing.T) {
+	dir := t.TempDir()
+	cfg := Config{CircuitVersion: CircuitVersionV5, SignerBitmap: true}
+	if err := checkManifest(dir, cfg); err != nil {
+		t.Fatalf("expected a directory without manifest to pass: %v", err)
+	}
+	if err := writeManifest(dir, cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	manifest, err := ReadArtifactManifest(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if manifest.ValsetHash != "poseidon2" || manifest.ValsetHashExtraDataKey != "validatorSetHashPoseidon2" {
+		t.Fatalf("unexpected valset hash in manifest %+v", manifest)
+	}
+	if err := checkManifest(dir, cfg); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkManifest(dir, Config{CircuitVersion: CircuitVersionV4, SignerBitmap: true}); err == nil {
+		t.Fatal("expected a manifest of another circuit to be rejected")
+	}
+}
@@ -0,0 +1,119 @@
+package proof
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/go-errors/errors"
+)
+
+// proveInputJSON is the JSON encoding of ProveInput. Points are hex strings of their uncompressed raw bytes,
+// 64 bytes for G1 and 128 for G2, and are checked to be in the subgroup when decoded. Private keys are never
+// encoded.
+type proveInputJSON struct {
+	Validators      []validatorJSON `json:"validators"`
+	MessageG1       hexutil.Bytes   `json:"messageG1,omitempty"`
+	MessageHash     *common.Hash    `json:"messageHash,omitempty"`
+	Signature       hexutil.Bytes   `json:"signature"`
+	SignersAggKeyG2 hexutil.Bytes   `json:"signersAggKeyG2"`
+	QuorumThreshold *big.Int        `json:"quorumThreshold,omitempty"`
+	Epoch           uint64          `json:"epoch,omitempty"`
+	KeyTag          uint8           `json:"keyTag,omitempty"`
+}
+
+type validatorJSON struct {
+	Key         hexutil.Bytes `json:"key"`
+	KeyG2       hexutil.Bytes `json:"keyG2"`
+	VotingPower *big.Int      `json:"votingPower"`
+	IsNonSigner bool          `json:"isNonSigner,omitempty"`
+}
+
+func (p ProveInput) MarshalJSON() ([]byte, error) {
+	encoded := proveInputJSON{
+		Validators:      make([]validatorJSON, len(p.ValidatorData)),
+		Signature:       marshalG1(p.Signature),
+		SignersAggKeyG2: marshalG2(p.SignersAggKeyG2),
+		QuorumThreshold: p.QuorumThreshold,
+		Epoch:           p.Epoch,
+		KeyTag:          p.KeyTag,
+	}
+	for i := range p.ValidatorData {
+		encoded.Validators[i] = validatorJSON{
+			Key:         marshalG1(p.ValidatorData[i].Key),
+			KeyG2:       marshalG2(p.ValidatorData[i].KeyG2),
+			VotingPower: p.ValidatorData[i].VotingPower,
+			IsNonSigner: p.ValidatorData[i].IsNonSigner,
+		}
+	}
+	if !p.MessageG1.IsInfinity() {
+		encoded.MessageG1 = marshalG1(p.MessageG1)
+	}
+	if p.MessageHash != ([32]byte{}) {
+		messageHash := common.Hash(p.MessageHash)
+		encoded.MessageHash = &messageHash
+	}
+	return json.Marshal(encoded)
+}
+
+func (p *ProveInput) UnmarshalJSON(data []byte) error {
+	var decoded proveInputJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	if decoded.QuorumThreshold != nil && !isWord(decoded.QuorumThreshold) {
+		return errors.Errorf("quorum threshold is not a uint256")
+	}
+	input := ProveInput{
+		ValidatorData:   make([]ValidatorData, len(decoded.Validators)),
+		QuorumThreshold: decoded.QuorumThreshold,
+		Epoch:           decoded.Epoch,
+		KeyTag:          decoded.KeyTag,
+	}
+	for i, validator := range decoded.Validators {
+		if validator.VotingPower == nil {
+			return errors.Errorf("validator %d has no voting power", i)
+		}
+		if !isWord(validator.VotingPower) {
+			return errors.Errorf("voting power of validator %d is not a uint256", i)
+		}
+		if err := input.ValidatorData[i].Key.Unmarshal(validator.Key); err != nil {
+			return errors.Errorf("invalid key of validator %d: %w", i, err)
+		}
+		if err := input.ValidatorData[i].KeyG2.Unmarshal(validator.KeyG2); err != nil {
+			return errors.Errorf("invalid G2 key of validator %d: %w", i, err)
+		}
+		input.ValidatorData[i].VotingPower = validator.VotingPower
+		input.ValidatorData[i].IsNonSigner = validator.IsNonSigner
+	}
+	if len(decoded.MessageG1) > 0 {
+		if err := input.MessageG1.Unmarshal(decoded.MessageG1); err != nil {
+			return errors.Errorf("invalid message: %w", err)
+		}
+	}
+	if decoded.MessageHash != nil {
+		input.MessageHash = *decoded.MessageHash
+	}
+	if err := input.Signature.Unmarshal(decoded.Signature); err != nil {
+		return errors.Errorf("invalid signature: %w", err)
+	}
+	if err := input.SignersAggKeyG2.Unmarshal(decoded.SignersAggKeyG2); err != nil {
+		return errors.Errorf("invalid signers aggregated key: %w", err)
+	}
+
+	*p = input
+	return nil
+}
+
+func marshalG1(point bn254.G1Affine) []byte {
+	raw := point.RawBytes()
+	return raw[:]
+}
+
+func marshalG2(point bn254.G2Affine) []byte {
+	raw := point.RawBytes()
+	return raw[:]
+}
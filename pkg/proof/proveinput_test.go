@@ -0,0 +1,105 @@
+package proof
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+func TestProveInputJSON(t *testing.T) {
+	valset := genValset(3, []int{1})
+	messageG1 := testMessageG1(t)
+	aggSignature, aggKeyG2, _ := getAggSignature(messageG1, &valset)
+	input := ProveInput{
+		ValidatorData:   valset,
+		MessageG1:       messageG1,
+		Signature:       *aggSignature,
+		SignersAggKeyG2: *aggKeyG2,
+		QuorumThreshold: big.NewInt(200),
+		Epoch:           7,
+		KeyTag:          15,
+	}
+
+	data, err := json.Marshal(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(strings.ToLower(string(data)), "privatekey") {
+		t.Fatal("prove input JSON contains private keys")
+	}
+
+	var decoded ProveInput
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded.ValidatorData) != len(valset) {
+		t.Fatalf("decoded %d validators, want %d", len(decoded.ValidatorData), len(valset))
+	}
+	for i := range valset {
+		got, want := decoded.ValidatorData[i], valset[i]
+		if !got.Key.Equal(&want.Key) || !got.KeyG2.Equal(&want.KeyG2) || got.VotingPower.Cmp(want.VotingPower) != 0 || got.IsNonSigner != want.IsNonSigner {
+			t.Fatalf("validator %d decoded as %+v, want %+v", i, got, want)
+		}
+	}
+	if !decoded.MessageG1.Equal(&input.MessageG1) || !decoded.Signature.Equal(&input.Signature) || !decoded.SignersAggKeyG2.Equal(&input.SignersAggKeyG2) {
+		t.Fatal("points were not decoded")
+	}
+	if decoded.QuorumThreshold.Cmp(input.QuorumThreshold) != 0 || decoded.Epoch != input.Epoch || decoded.KeyTag != input.KeyTag {
+		t.Fatal("verification parameters were not decoded")
+	}
+
+	// a point off the curve is rejected
+	var encoded map[string]any
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		t.Fatal(err)
+	}
+	signature := aggSignature.RawBytes()
+	signature[63] ^= 1
+	encoded["signature"] = hexutil.Encode(signature[:])
+	tampered, err := json.Marshal(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(tampered, &decoded); err == nil {
+		t.Fatal("decoded a point off the curve")
+	}
+
+	// voting powers must be uint256s, as every circuit version hashes them as 32-byte words
+	for _, votingPower := range []*big.Int{new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(-1)} {
+		wide := input
+		wide.ValidatorData = append([]ValidatorData(nil), valset...)
+		wide.ValidatorData[2].VotingPower = votingPower
+		data, err := json.Marshal(wide)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal(data, &decoded); err == nil {
+			t.Fatalf("decoded voting power %s", votingPower)
+		}
+		if _, err := prepareWitness(DefaultConfig(), wide); err == nil {
+			t.Fatalf("prepared a witness with voting power %s", votingPower)
+		}
+	}
+	maxWord := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	overflowing := input
+	overflowing.ValidatorData = append([]ValidatorData(nil), valset...)
+	overflowing.ValidatorData[0].VotingPower = maxWord
+	overflowing.ValidatorData[2].VotingPower = maxWord
+	if _, err := prepareWitness(DefaultConfig(), overflowing); err == nil {
+		t.Fatal("prepared a witness whose signers voting power overflows a word")
+	}
+
+	// V4 hashes the epoch as a uint48
+	v4 := Config{CircuitVersion: CircuitVersionV4}
+	if _, err := prepareWitness(v4, input); err != nil {
+		t.Fatal(err)
+	}
+	wideEpoch := input
+	wideEpoch.Epoch = 1 << epochBits
+	if _, err := prepareWitness(v4, wideEpoch); err == nil {
+		t.Fatalf("prepared a witness with epoch %d", wideEpoch.Epoch)
+	}
+}
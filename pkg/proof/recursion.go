@@ -0,0 +1,727 @@
+package proof
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"os"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	mimc_native "github.com/consensys/gnark-crypto/ecc/bn254/fr/mimc"
+	"github.com/consensys/gnark/backend"
+	"github.com/consensys/gnark/backend/groth16"
+	"github.com/consensys/gnark/backend/solidity"
+	"github.com/consensys/gnark/constraint"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_emulated"
+	"github.com/consensys/gnark/std/hash/mimc"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/rangecheck"
+	stdgroth16 "github.com/consensys/gnark/std/recursion/groth16"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+)
+
+// ChunkCircuit proves the partial aggregates of a fixed-size slice of the valset: the MiMC valset hash chain
+// from PrevValsetHash to ValsetHash, the signers' voting power and the signers' aggregated key. PrevIsFiller
+// and LastIsFiller carry the filler suffix rule across slices. It always applies the V2 constraints.
+//
+// The aggregates are private, and the only public input is their MiMC hash, see hashChunkAggregates. Every
+// public input costs a scalar multiplication where the proof is verified in-circuit.
+type ChunkCircuit struct {
+	AggregatesHash     frontend.Variable      `gnark:",public"`
+	PrevValsetHash     frontend.Variable      `gnark:",private"`
+	ValsetHash         frontend.Variable      `gnark:",private"`
+	PrevIsFiller       frontend.Variable      `gnark:",private"`
+	LastIsFiller       frontend.Variable      `gnark:",private"`
+	SignersVotingPower frontend.Variable      `gnark:",private"`
+	SignersAggKey      sw_bn254.G1Affine      `gnark:",private"`
+	ValidatorData      []ValidatorDataCircuit `gnark:",private"`
+}
+
+// chunkPublicInputs is the number of public inputs of ChunkCircuit.
+const chunkPublicInputs = 1
+
+// Define declares the circuit's constraints
+func (circuit *ChunkCircuit) Define(api frontend.API) error {
+	curveApi, err := sw_emulated.New[emulated.BN254Fp, emulated.BN254Fr](api, sw_emulated.GetBN254Params())
+	if err != nil {
+		return err
+	}
+
+	fieldFpApi, err := emulated.NewField[emulated.BN254Fp](api)
+	if err != nil {
+		return err
+	}
+
+	mimcApi, err := mimc.NewMiMC(api)
+	if err != nil {
+		return err
+	}
+	if err := mimcApi.SetState([]frontend.Variable{circuit.PrevValsetHash}); err != nil {
+		return err
+	}
+
+	// only the gadgets of the valset loop are needed
+	apis := circuitApis{
+		api:          api,
+		curve:        curveApi,
+		fieldFp:      fieldFpApi,
+		mimc:         &mimcApi,
+		rangeChecker: rangecheck.New(api),
+	}
+
+	api.AssertIsBoolean(circuit.PrevIsFiller)
+	acc := valsetAccumulator{
+		valsetHash:            circuit.PrevValsetHash,
+		prevIsFiller:          circuit.PrevIsFiller,
+		signersAggVotingPower: 0,
+		signersAggKey: &sw_bn254.G1Affine{
+			X: emulated.ValueOf[emulated.BN254Fp](0),
+			Y: emulated.ValueOf[emulated.BN254Fp](0),
+		},
+		signersAggKeyIsZero: 1,
+	}
+	for i := range circuit.ValidatorData {
+		if _, err := apis.accumulate(&acc, &circuit.ValidatorData[i], CircuitVersionV3); err != nil {
+			return err
+		}
+	}
+
+	api.AssertIsEqual(acc.valsetHash, circuit.ValsetHash)
+	api.AssertIsEqual(acc.prevIsFiller, circuit.LastIsFiller)
+	api.AssertIsEqual(acc.signersAggVotingPower, circuit.SignersVotingPower)
+	curveApi.AssertIsEqual(acc.signersAggKey, &circuit.SignersAggKey)
+
+	aggregatesHash, err := hashChunkAggregates(api, circuit.PrevValsetHash, circuit.ValsetHash, circuit.PrevIsFiller,
+		circuit.LastIsFiller, circuit.SignersVotingPower, &circuit.SignersAggKey)
+	if err != nil {
+		return err
+	}
+	api.AssertIsEqual(aggregatesHash, circuit.AggregatesHash)
+	return nil
+}
+
+// hashChunkAggregates hashes the partial aggregates of a chunk with MiMC, the key by its limbs as HashValset
+// does. The limbs are hashed as assigned, so every circuit hashes a key taken from its witness.
+func hashChunkAggregates(api frontend.API, prevValsetHash, valsetHash, prevIsFiller, lastIsFiller, signersVotingPower frontend.Variable, signersAggKey *sw_bn254.G1Affine) (frontend.Variable, error) {
+	h, err := mimc.NewMiMC(api)
+	if err != nil {
+		return nil, err
+	}
+	h.Write(prevValsetHash, valsetHash, prevIsFiller, lastIsFiller, signersVotingPower)
+	h.Write(signersAggKey.X.Limbs...)
+	h.Write(signersAggKey.Y.Limbs...)
+	return h.Sum(), nil
+}
+
+// ChunkProof is a ChunkCircuit or MergeCircuit proof together with its public input and the partial
+// aggregates it commits to, as verified inside MergeCircuit and AggregationCircuit.
+type ChunkProof struct {
+	Proof   stdgroth16.Proof[sw_bn254.G1Affine, sw_bn254.G2Affine]
+	Witness stdgroth16.Witness[sw_bn254.ScalarField]
+
+	PrevValsetHash     frontend.Variable
+	ValsetHash         frontend.Variable
+	PrevIsFiller       frontend.Variable
+	LastIsFiller       frontend.Variable
+	SignersVotingPower frontend.Variable
+	SignersAggKey      sw_bn254.G1Affine
+}
+
+// chunkVerifyingKey is the verifying key of the circuit a ChunkProof proves, a constant of the circuit
+// verifying it.
+type chunkVerifyingKey = stdgroth16.VerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]
+
+type chunkVerifier = stdgroth16.Verifier[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]
+
+// assertChunkProof verifies proof with vk and checks that its public input is the hash of its aggregates.
+func assertChunkProof(api frontend.API, verifier *chunkVerifier, vk chunkVerifyingKey, proof *ChunkProof) error {
+	// the keys are circuit constants, so only proofs of the level below are accepted
+	err := verifier.AssertProof(vk, proof.Proof, proof.Witness, stdgroth16.WithCompleteArithmetic())
+	if err != nil {
+		return err
+	}
+
+	public := proof.Witness.Public
+	if len(public) != chunkPublicInputs {
+		return errors.Errorf("chunk proof has %d public inputs, expected %d", len(public), chunkPublicInputs)
+	}
+	aggregatesHash, err := hashChunkAggregates(api, proof.PrevValsetHash, proof.ValsetHash, proof.PrevIsFiller,
+		proof.LastIsFiller, proof.SignersVotingPower, &proof.SignersAggKey)
+	if err != nil {
+		return err
+	}
+	api.AssertIsEqual(scalarToNative(api, &public[0]), aggregatesHash)
+	return nil
+}
+
+// MergeCircuit verifies the proofs of two consecutive slices of the valset, ChunkCircuit proofs or MergeCircuit
+// proofs of the level below, and proves the partial aggregates of both with the public input of ChunkCircuit.
+// Merging in a binary tree keeps every circuit at two verified proofs, whatever the number of chunks.
+type MergeCircuit struct {
+	ChildVerifyingKey chunkVerifyingKey `gnark:"-"`
+
+	AggregatesHash frontend.Variable `gnark:",public"`
+	SignersAggKey  sw_bn254.G1Affine `gnark:",private"` // the sum of the children's keys, hashed by its limbs
+	Children       [2]ChunkProof     `gnark:",private"`
+}
+
+// Define declares the circuit's constraints
+func (circuit *MergeCircuit) Define(api frontend.API) error {
+	verifier, err := stdgroth16.NewVerifier[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl](api)
+	if err != nil {
+		return err
+	}
+
+	curveApi, err := sw_emulated.New[emulated.BN254Fp, emulated.BN254Fr](api, sw_emulated.GetBN254Params())
+	if err != nil {
+		return err
+	}
+
+	fieldFpApi, err := emulated.NewField[emulated.BN254Fp](api)
+	if err != nil {
+		return err
+	}
+	apis := circuitApis{api: api, curve: curveApi, fieldFp: fieldFpApi}
+
+	left, right := &circuit.Children[0], &circuit.Children[1]
+	for i := range circuit.Children {
+		if err := assertChunkProof(api, verifier, circuit.ChildVerifyingKey, &circuit.Children[i]); err != nil {
+			return errors.Errorf("child %d: %w", i, err)
+		}
+	}
+	api.AssertIsEqual(right.PrevValsetHash, left.ValsetHash)
+	api.AssertIsEqual(right.PrevIsFiller, left.LastIsFiller)
+
+	signersAggKey, err := apis.addAggKeys(&left.SignersAggKey, &right.SignersAggKey)
+	if err != nil {
+		return err
+	}
+	curveApi.AssertIsEqual(signersAggKey, &circuit.SignersAggKey)
+
+	aggregatesHash, err := hashChunkAggregates(api, left.PrevValsetHash, right.ValsetHash, left.PrevIsFiller,
+		right.LastIsFiller, api.Add(left.SignersVotingPower, right.SignersVotingPower), &circuit.SignersAggKey)
+	if err != nil {
+		return err
+	}
+	api.AssertIsEqual(aggregatesHash, circuit.AggregatesHash)
+	return nil
+}
+
+// addAggKeys returns p + q for the aggregated keys of two slices, each on the curve or (0,0). Their limbs are
+// only bound by a hash, so (0,0) is detected modulo p, on Y alone as no curve point has Y = 0.
+func (a *circuitApis) addAggKeys(p, q *sw_bn254.G1Affine) (*sw_bn254.G1Affine, error) {
+	sum, _, err := a.addKey(p, a.fieldFp.IsZero(&p.Y), q)
+	if err != nil {
+		return nil, err
+	}
+	return a.curve.Select(a.fieldFp.IsZero(&q.Y), p, sum), nil
+}
+
+// AggregationCircuit verifies the proof of the whole valset, a ChunkCircuit proof for a single chunk and the
+// MergeCircuit proof at the root of the tree otherwise, and checks the aggregated signature. Its only public
+// input is the same input hash as Circuit's, so it is verified on-chain like any other tier. The signature
+// check applies the V3 constraints.
+type AggregationCircuit struct {
+	RootVerifyingKey chunkVerifyingKey `gnark:"-"`
+
+	InputHash             frontend.Variable `gnark:",public"`
+	SignersAggVotingPower frontend.Variable `gnark:",private"`
+	Message               sw_bn254.G1Affine `gnark:",private"`
+	Signature             sw_bn254.G1Affine `gnark:",private"`
+	SignersAggKeyG2       sw_bn254.G2Affine `gnark:",private"`
+	Root                  ChunkProof        `gnark:",private"`
+}
+
+// Define declares the circuit's constraints
+func (circuit *AggregationCircuit) Define(api frontend.API) error {
+	verifier, err := stdgroth16.NewVerifier[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl](api)
+	if err != nil {
+		return err
+	}
+
+	apis, err := newCircuitApis(api, true)
+	if err != nil {
+		return err
+	}
+
+	if err := assertChunkProof(api, verifier, circuit.RootVerifyingKey, &circuit.Root); err != nil {
+		return err
+	}
+	// the root starts from the empty valset
+	api.AssertIsEqual(circuit.Root.PrevValsetHash, 0)
+	api.AssertIsEqual(circuit.Root.PrevIsFiller, 0)
+
+	return apis.assertSignedInput(CircuitVersionV3, signedInput{
+		InputHash:             circuit.InputHash,
+		SignersAggVotingPower: circuit.SignersAggVotingPower,
+		Message:               &circuit.Message,
+		Signature:             &circuit.Signature,
+		SignersAggKeyG2:       &circuit.SignersAggKeyG2,
+	}, circuit.Root.ValsetHash, circuit.Root.SignersVotingPower, &circuit.Root.SignersAggKey)
+}
+
+// scalarToNative packs the limbs of an emulated scalar into a native variable. The scalar field of BN254 is
+// the native field, so the packed value equals the scalar modulo r.
+func scalarToNative(api frontend.API, e *emulated.Element[sw_bn254.ScalarField]) frontend.Variable {
+	limbBase := new(big.Int).Lsh(big.NewInt(1), uint(sw_bn254.ScalarField{}.BitsPerLimb()))
+	packed := frontend.Variable(0)
+	for i := len(e.Limbs) - 1; i >= 0; i-- {
+		packed = api.Add(api.Mul(packed, limbBase), e.Limbs[i])
+	}
+	return packed
+}
+
+// chunkAggregates are the partial aggregates of a consecutive slice of the valset, as ChunkCircuit and
+// MergeCircuit prove them.
+type chunkAggregates struct {
+	prevValsetHash     *big.Int
+	valsetHash         *big.Int
+	prevIsFiller       int
+	lastIsFiller       int
+	signersVotingPower *big.Int
+	signersAggKey      bn254.G1Affine
+}
+
+// hash computes hashChunkAggregates natively.
+func (c *chunkAggregates) hash() *big.Int {
+	h := mimc_native.NewMiMC()
+	for _, v := range []*big.Int{c.prevValsetHash, c.valsetHash, big.NewInt(int64(c.prevIsFiller)), big.NewInt(int64(c.lastIsFiller)), c.signersVotingPower} {
+		buf := make([]byte, 32)
+		v.FillBytes(buf)
+		h.Write(buf)
+	}
+
+	// hash by limbs as it's done inside circuit
+	xBytes := c.signersAggKey.X.Bytes()
+	yBytes := c.signersAggKey.Y.Bytes()
+	for _, b := range [][32]byte{xBytes, yBytes} {
+		h.Write(b[24:32])
+		h.Write(b[16:24])
+		h.Write(b[8:16])
+		h.Write(b[0:8])
+	}
+	return new(big.Int).SetBytes(h.Sum(nil))
+}
+
+// merge returns the aggregates of c followed by next, as MergeCircuit computes them.
+func (c *chunkAggregates) merge(next *chunkAggregates) chunkAggregates {
+	merged := chunkAggregates{
+		prevValsetHash:     c.prevValsetHash,
+		valsetHash:         next.valsetHash,
+		prevIsFiller:       c.prevIsFiller,
+		lastIsFiller:       next.lastIsFiller,
+		signersVotingPower: new(big.Int).Add(c.signersVotingPower, next.signersVotingPower),
+	}
+	merged.signersAggKey.Add(&c.signersAggKey, &next.signersAggKey)
+	return merged
+}
+
+// setChunkProofData assigns the aggregates of a ChunkProof.
+func setChunkProofData(proof *ChunkProof, aggregates *chunkAggregates) {
+	proof.PrevValsetHash = aggregates.prevValsetHash
+	proof.ValsetHash = aggregates.valsetHash
+	proof.PrevIsFiller = aggregates.prevIsFiller
+	proof.LastIsFiller = aggregates.lastIsFiller
+	proof.SignersVotingPower = aggregates.signersVotingPower
+	proof.SignersAggKey = sw_bn254.NewG1Affine(aggregates.signersAggKey)
+}
+
+// chunkAssignments splits a normalized valset into ChunkCircuit assignments of chunkSize validators each,
+// together with their aggregates.
+func chunkAssignments(valset []ValidatorData, chunkSize int) ([]ChunkCircuit, []chunkAggregates) {
+	chunks := make([]ChunkCircuit, 0, len(valset)/chunkSize)
+	aggregates := make([]chunkAggregates, 0, len(valset)/chunkSize)
+	prevValsetHash := new(big.Int)
+	prevIsFiller := 0
+	for start := 0; start < len(valset); start += chunkSize {
+		slice := valset[start : start+chunkSize]
+
+		agg := chunkAggregates{
+			prevValsetHash:     prevValsetHash,
+			prevIsFiller:       prevIsFiller,
+			signersVotingPower: new(big.Int),
+		}
+		agg.signersAggKey.SetInfinity()
+		for i := range slice {
+			if !slice[i].IsNonSigner {
+				agg.signersVotingPower.Add(agg.signersVotingPower, slice[i].VotingPower)
+				agg.signersAggKey.Add(&agg.signersAggKey, &slice[i].Key)
+			}
+		}
+
+		// HashValset stops at the first filler, which is the hash the chunk chain carries on
+		agg.valsetHash = new(big.Int).SetBytes(HashValset(valset[:start+chunkSize]))
+		if slice[len(slice)-1].Key.IsInfinity() {
+			agg.lastIsFiller = 1
+		}
+
+		chunk := ChunkCircuit{
+			AggregatesHash:     agg.hash(),
+			PrevValsetHash:     agg.prevValsetHash,
+			ValsetHash:         agg.valsetHash,
+			PrevIsFiller:       agg.prevIsFiller,
+			LastIsFiller:       agg.lastIsFiller,
+			SignersVotingPower: agg.signersVotingPower,
+			SignersAggKey:      sw_bn254.NewG1Affine(agg.signersAggKey),
+		}
+		setValidatorData(&chunk.ValidatorData, slice)
+		chunks = append(chunks, chunk)
+		aggregates = append(aggregates, agg)
+
+		prevValsetHash = agg.valsetHash
+		prevIsFiller = agg.lastIsFiller
+	}
+	return chunks, aggregates
+}
+
+// recursiveKeys are the constraint system and keys of one level of the recursive circuits.
+type recursiveKeys struct {
+	cs constraint.ConstraintSystem
+	pk groth16.ProvingKey
+	vk groth16.VerifyingKey
+}
+
+// RecursiveProver proves valsets beyond the largest tier in MaxValidators. The valset is split into slices of
+// chunkSize validators, each proven by a ChunkCircuit. MergeCircuit proofs combine them pairwise up to a single
+// proof of the whole valset, which an AggregationCircuit verifies. Its Verifier_<chunkSize>x<nbChunks>.sol takes
+// the same proof layout and public input as the tier verifiers, so it is registered in SigVerifierBlsBn254ZK
+// with chunkSize*nbChunks max validators.
+type RecursiveProver struct {
+	chunkSize int
+	nbChunks  int
+
+	chunk  recursiveKeys
+	merges []recursiveKeys // merges[l] combines 2^(l+1) chunks
+
+	cs constraint.ConstraintSystem
+	pk groth16.ProvingKey
+	vk groth16.VerifyingKey
+}
+
+// recursiveDir returns the directory holding the artifacts of the recursive circuits.
+func recursiveDir() string {
+	return circuitsDir + "/recursive"
+}
+
+func chunkSuffix(chunkSize int) string {
+	return fmt.Sprintf("chunk_%d", chunkSize)
+}
+
+func mergeSuffix(chunkSize, nbChunks int) string {
+	return fmt.Sprintf("merge_%dx%d", chunkSize, nbChunks)
+}
+
+func aggregationSuffix(chunkSize, nbChunks int) string {
+	return fmt.Sprintf("%dx%d", chunkSize, nbChunks)
+}
+
+// NewRecursiveProver loads or generates the artifacts for valsets of up to chunkSize*nbChunks validators.
+// nbChunks must be a power of two. Chunk and merge artifacts only depend on chunkSize and the number of chunks
+// below them, so they are shared by every nbChunks.
+func NewRecursiveProver(chunkSize, nbChunks int) (*RecursiveProver, error) {
+	if chunkSize <= 0 || nbChunks <= 0 || nbChunks&(nbChunks-1) != 0 {
+		return nil, errors.Errorf("invalid recursive layout %dx%d", chunkSize, nbChunks)
+	}
+	p := RecursiveProver{chunkSize: chunkSize, nbChunks: nbChunks}
+
+	slog.Warn("Recursive ZK prover initialization started (might take a while)", "chunkSize", chunkSize, "nbChunks", nbChunks)
+	var err error
+	p.chunk, err = loadOrInitChunk(chunkSize)
+	if err != nil {
+		return nil, err
+	}
+	child := p.chunk
+	for n := 2; n <= nbChunks; n *= 2 {
+		merge, err := loadOrInitMerge(chunkSize, n, child)
+		if err != nil {
+			return nil, err
+		}
+		p.merges = append(p.merges, merge)
+		child = merge
+	}
+	p.cs, p.pk, p.vk, err = loadOrInitAggregation(chunkSize, nbChunks, child)
+	if err != nil {
+		return nil, err
+	}
+	slog.Info("Recursive ZK prover initialization is done")
+
+	return &p, nil
+}
+
+// MaxValidators returns the number of validators the prover accepts.
+func (p *RecursiveProver) MaxValidators() int {
+	return p.chunkSize * p.nbChunks
+}
+
+// Prove generates an aggregation proof for the active validators in proveInput.ValidatorData.
+// Like ZkProver.Prove, the valset is sorted and padded internally.
+func (p *RecursiveProver) Prove(proveInput ProveInput) (ProofData, error) {
+	if len(proveInput.ValidatorData) > p.MaxValidators() {
+		return ProofData{}, &ErrValsetTooLarge{TotalActiveValidators: len(proveInput.ValidatorData), MaxValidators: p.MaxValidators()}
+	}
+
+	for i := range proveInput.ValidatorData {
+		if !isWord(proveInput.ValidatorData[i].VotingPower) {
+			return ProofData{}, errors.Errorf("voting power of validator %d is not a uint256", i)
+		}
+	}
+	// the circuits trust the keys, a bad one would only fail the proof
+	if err := ValidateKeys(proveInput, KeyValidationOptions{}).Err(); err != nil {
+		return ProofData{}, err
+	}
+
+	var err error
+	proveInput.ValidatorData, err = normalizeValset(CircuitVersionV3, proveInput.ValidatorData, p.MaxValidators())
+	if err != nil {
+		return ProofData{}, err
+	}
+	for i := range proveInput.ValidatorData {
+		if proveInput.ValidatorData[i].VotingPower.BitLen() > MaxVotingPowerBits {
+			return ProofData{}, errors.Errorf("voting power of validator %d exceeds %d bits", i, MaxVotingPowerBits)
+		}
+	}
+	_, nonSignersAggVotingPower, totalVotingPower := getNonSignersData(proveInput.ValidatorData)
+	if signersAggVotingPower := new(big.Int).Sub(totalVotingPower, nonSignersAggVotingPower); !isWord(signersAggVotingPower) {
+		return ProofData{}, errors.Errorf("signers voting power %s exceeds 256 bits", signersAggVotingPower)
+	}
+
+	assignment, err := p.aggregationAssignment(proveInput)
+	if err != nil {
+		return ProofData{}, err
+	}
+
+	witness, err := frontend.NewWitness(assignment, ecc.BN254.ScalarField())
+	if err != nil {
+		return ProofData{}, errors.Errorf("failed to create witness: %w", err)
+	}
+	publicWitness, err := witness.Public()
+	if err != nil {
+		return ProofData{}, errors.Errorf("failed to get public witness: %w", err)
+	}
+
+	proof, err := groth16.Prove(p.cs, p.pk, witness, backend.WithProverHashToFieldFunction(sha256.New()))
+	if err != nil {
+		return ProofData{}, errors.Errorf("failed to prove: %w", err)
+	}
+	err = groth16.Verify(proof, p.vk, publicWitness, backend.WithVerifierHashToFieldFunction(sha256.New()))
+	if err != nil {
+		return ProofData{}, err
+	}
+
+	return groth16ProofData(proof, assignment.SignersAggVotingPower.(*big.Int))
+}
+
+// Verify checks proofBytes against publicInputHash.
+func (p *RecursiveProver) Verify(publicInputHash common.Hash, proofBytes []byte) (bool, error) {
+	publicWitness, err := publicInputWitness(publicInputHash, false)
+	if err != nil {
+		return false, err
+	}
+	if err := verifyGroth16(p.vk, proofBytes, publicWitness); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// aggregationAssignment proves every chunk of the normalized valset, merges the proofs level by level and
+// assigns the root proof to an AggregationCircuit.
+func (p *RecursiveProver) aggregationAssignment(proveInput ProveInput) (*AggregationCircuit, error) {
+	chunks, aggregates := chunkAssignments(proveInput.ValidatorData, p.chunkSize)
+	proofs := make([]ChunkProof, len(chunks))
+	for i := range chunks {
+		var err error
+		proofs[i], err = proveChunk(p.chunk, &chunks[i], &aggregates[i])
+		if err != nil {
+			return nil, errors.Errorf("failed to prove chunk %d: %w", i, err)
+		}
+	}
+
+	child := p.chunk
+	for l, merge := range p.merges {
+		childVk, err := stdgroth16.ValueOfVerifyingKeyFixed[sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl](child.vk)
+		if err != nil {
+			return nil, errors.Errorf("failed to assign child verifying key: %w", err)
+		}
+		for i := range len(proofs) / 2 {
+			merged := aggregates[2*i].merge(&aggregates[2*i+1])
+			assignment := MergeCircuit{
+				ChildVerifyingKey: childVk,
+				AggregatesHash:    merged.hash(),
+				SignersAggKey:     sw_bn254.NewG1Affine(merged.signersAggKey),
+				Children:          [2]ChunkProof{proofs[2*i], proofs[2*i+1]},
+			}
+			proofs[i], err = proveChunk(merge, &assignment, &merged)
+			if err != nil {
+				return nil, errors.Errorf("failed to prove merge %d of level %d: %w", i, l+1, err)
+			}
+			aggregates[i] = merged
+		}
+		proofs, aggregates = proofs[:len(proofs)/2], aggregates[:len(aggregates)/2]
+		child = merge
+	}
+
+	rootVk, err := stdgroth16.ValueOfVerifyingKeyFixed[sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl](child.vk)
+	if err != nil {
+		return nil, errors.Errorf("failed to assign root verifying key: %w", err)
+	}
+
+	var signed Circuit
+	setCircuitData(&signed, proveInput)
+	return &AggregationCircuit{
+		RootVerifyingKey:      rootVk,
+		InputHash:             signed.InputHash,
+		SignersAggVotingPower: signed.SignersAggVotingPower,
+		Message:               signed.Message,
+		Signature:             signed.Signature,
+		SignersAggKeyG2:       signed.SignersAggKeyG2,
+		Root:                  proofs[0],
+	}, nil
+}
+
+// proveChunk proves a ChunkCircuit or MergeCircuit assignment with the hash-to-field function the circuit
+// verifying it checks commitments with.
+func proveChunk(keys recursiveKeys, assignment frontend.Circuit, aggregates *chunkAggregates) (ChunkProof, error) {
+	witness, err := frontend.NewWitness(assignment, ecc.BN254.ScalarField())
+	if err != nil {
+		return ChunkProof{}, errors.Errorf("failed to create witness: %w", err)
+	}
+	publicWitness, err := witness.Public()
+	if err != nil {
+		return ChunkProof{}, errors.Errorf("failed to get public witness: %w", err)
+	}
+
+	proof, err := groth16.Prove(keys.cs, keys.pk, witness, stdgroth16.GetNativeProverOptions(ecc.BN254.ScalarField(), ecc.BN254.ScalarField()))
+	if err != nil {
+		return ChunkProof{}, errors.Errorf("failed to prove: %w", err)
+	}
+	err = groth16.Verify(proof, keys.vk, publicWitness, stdgroth16.GetNativeVerifierOptions(ecc.BN254.ScalarField(), ecc.BN254.ScalarField()))
+	if err != nil {
+		return ChunkProof{}, err
+	}
+
+	var chunkProof ChunkProof
+	chunkProof.Proof, err = stdgroth16.ValueOfProof[sw_bn254.G1Affine, sw_bn254.G2Affine](proof)
+	if err != nil {
+		return ChunkProof{}, errors.Errorf("failed to assign proof: %w", err)
+	}
+	chunkProof.Witness, err = stdgroth16.ValueOfWitness[sw_bn254.ScalarField](publicWitness)
+	if err != nil {
+		return ChunkProof{}, errors.Errorf("failed to assign witness: %w", err)
+	}
+	setChunkProofData(&chunkProof, aggregates)
+	return chunkProof, nil
+}
+
+func loadOrInitChunk(chunkSize int) (recursiveKeys, error) {
+	return loadOrInitRecursive(chunkSuffix(chunkSize), func() (frontend.Circuit, error) {
+		return &ChunkCircuit{ValidatorData: make([]ValidatorDataCircuit, chunkSize)}, nil
+	})
+}
+
+func loadOrInitMerge(chunkSize, nbChunks int, child recursiveKeys) (recursiveKeys, error) {
+	return loadOrInitRecursive(mergeSuffix(chunkSize, nbChunks), func() (frontend.Circuit, error) {
+		return mergePlaceholder(child)
+	})
+}
+
+// loadOrInitRecursive loads the keys stored under suffix in the recursive directory, or compiles the circuit
+// placeholder returns and runs the setup.
+func loadOrInitRecursive(suffix string, placeholder func() (frontend.Circuit, error)) (recursiveKeys, error) {
+	dir := recursiveDir()
+	if exists(r1csPathTmp(dir, suffix)) && exists(pkPathTmp(dir, suffix)) && exists(vkPathTmp(dir, suffix)) {
+		cs, pk, vk, err := readGroth16(dir, suffix)
+		return recursiveKeys{cs: cs, pk: pk, vk: vk}, err
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return recursiveKeys{}, err
+	}
+	circuit, err := placeholder()
+	if err != nil {
+		return recursiveKeys{}, err
+	}
+	cs, err := frontend.Compile(bn254.ID.ScalarField(), r1cs.NewBuilder, circuit)
+	if err != nil {
+		return recursiveKeys{}, err
+	}
+	pk, vk, err := groth16.Setup(cs)
+	if err != nil {
+		return recursiveKeys{}, err
+	}
+	if err := writeGroth16(dir, suffix, cs, pk, vk); err != nil {
+		return recursiveKeys{}, err
+	}
+	return recursiveKeys{cs: cs, pk: pk, vk: vk}, nil
+}
+
+func loadOrInitAggregation(chunkSize, nbChunks int, root recursiveKeys) (constraint.ConstraintSystem, groth16.ProvingKey, groth16.VerifyingKey, error) {
+	dir := recursiveDir()
+	suffix := aggregationSuffix(chunkSize, nbChunks)
+	if exists(r1csPathTmp(dir, suffix)) && exists(pkPathTmp(dir, suffix)) && exists(vkPathTmp(dir, suffix)) && exists(solPathTmp(dir, suffix)) {
+		return readGroth16(dir, suffix)
+	}
+
+	circuit, err := aggregationPlaceholder(root)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	cs, err := frontend.Compile(bn254.ID.ScalarField(), r1cs.NewBuilder, circuit)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	pk, vk, err := groth16.Setup(cs)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if err := writeGroth16(dir, suffix, cs, pk, vk); err != nil {
+		return nil, nil, nil, err
+	}
+
+	f, err := os.Create(solPathTmp(dir, suffix))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	defer f.Close()
+	if err := vk.ExportSolidity(f, solidity.WithHashToFieldFunction(sha256.New())); err != nil {
+		return nil, nil, nil, err
+	}
+	return cs, pk, vk, nil
+}
+
+// placeholderChunkProof returns a ChunkProof placeholder for proofs of child.
+func placeholderChunkProof(child recursiveKeys) ChunkProof {
+	return ChunkProof{
+		Proof:   stdgroth16.PlaceholderProof[sw_bn254.G1Affine, sw_bn254.G2Affine](child.cs),
+		Witness: stdgroth16.PlaceholderWitness[sw_bn254.ScalarField](child.cs),
+	}
+}
+
+// mergePlaceholder returns a MergeCircuit for two proofs of child, with the child verifying key fixed.
+func mergePlaceholder(child recursiveKeys) (*MergeCircuit, error) {
+	vk, err := stdgroth16.ValueOfVerifyingKeyFixed[sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl](child.vk)
+	if err != nil {
+		return nil, errors.Errorf("failed to assign child verifying key: %w", err)
+	}
+	return &MergeCircuit{
+		ChildVerifyingKey: vk,
+		Children:          [2]ChunkProof{placeholderChunkProof(child), placeholderChunkProof(child)},
+	}, nil
+}
+
+// aggregationPlaceholder returns an AggregationCircuit for a proof of root, with the root verifying key fixed.
+func aggregationPlaceholder(root recursiveKeys) (*AggregationCircuit, error) {
+	vk, err := stdgroth16.ValueOfVerifyingKeyFixed[sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl](root.vk)
+	if err != nil {
+		return nil, errors.Errorf("failed to assign root verifying key: %w", err)
+	}
+	return &AggregationCircuit{
+		RootVerifyingKey: vk,
+		Root:             placeholderChunkProof(root),
+	}, nil
+}
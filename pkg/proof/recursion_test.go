@@ -0,0 +1,341 @@
+package proof
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark/backend/groth16"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/test"
+)
+
+const testChunkSize = 2
+
+func chunkPlaceholder() *ChunkCircuit {
+	return &ChunkCircuit{ValidatorData: make([]ValidatorDataCircuit, testChunkSize)}
+}
+
+func TestChunkCircuit(t *testing.T) {
+	valset := padValset(genValset(3, []int{1}), 2*testChunkSize)
+	chunks, _ := chunkAssignments(valset, testChunkSize)
+	if len(chunks) != 2 {
+		t.Fatalf("expected 2 chunks, got %d", len(chunks))
+	}
+
+	for i := range chunks {
+		if err := test.IsSolved(chunkPlaceholder(), &chunks[i], ecc.BN254.ScalarField()); err != nil {
+			t.Fatalf("expected chunk %d to be solved: %v", i, err)
+		}
+	}
+
+	// the chain ends at the hash of the whole valset
+	if chunks[1].ValsetHash.(*big.Int).Cmp(new(big.Int).SetBytes(HashValset(valset))) != 0 {
+		t.Fatal("chunk chain doesn't end at the valset hash")
+	}
+}
+
+func TestChunkCircuitValidatorAfterFiller(t *testing.T) {
+	chunks, aggregates := chunkAssignments(genValset(2*testChunkSize, nil), testChunkSize)
+	aggregates[1].prevIsFiller = 1
+	chunks[1].PrevIsFiller = 1
+	chunks[1].AggregatesHash = aggregates[1].hash()
+	if err := test.IsSolved(chunkPlaceholder(), &chunks[1], ecc.BN254.ScalarField()); err == nil {
+		t.Fatal("expected chunk not to be solved")
+	}
+}
+
+func TestChunkCircuitWrongAggregatesHash(t *testing.T) {
+	chunks, _ := chunkAssignments(genValset(testChunkSize, nil), testChunkSize)
+	chunks[0].AggregatesHash = new(big.Int).Add(chunks[0].AggregatesHash.(*big.Int), big.NewInt(1))
+	if err := test.IsSolved(chunkPlaceholder(), &chunks[0], ecc.BN254.ScalarField()); err == nil {
+		t.Fatal("expected chunk not to be solved")
+	}
+}
+
+// setupTestChunk compiles the test ChunkCircuit and runs its setup.
+func setupTestChunk(t *testing.T) recursiveKeys {
+	t.Helper()
+	cs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, chunkPlaceholder())
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk, vk, err := groth16.Setup(cs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return recursiveKeys{cs: cs, pk: pk, vk: vk}
+}
+
+func TestMergeCircuit(t *testing.T) {
+	chunk := setupTestChunk(t)
+
+	// the second chunk has no signers, so its key is the infinity
+	valset := padValset(genValset(3, []int{2}), 2*testChunkSize)
+	chunks, aggregates := chunkAssignments(valset, testChunkSize)
+	var proofs [2]ChunkProof
+	for i := range chunks {
+		var err error
+		proofs[i], err = proveChunk(chunk, &chunks[i], &aggregates[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	placeholder, err := mergePlaceholder(chunk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	merged := aggregates[0].merge(&aggregates[1])
+	assignment := &MergeCircuit{
+		AggregatesHash: merged.hash(),
+		SignersAggKey:  sw_bn254.NewG1Affine(merged.signersAggKey),
+		Children:       proofs,
+	}
+	if err := test.IsSolved(placeholder, assignment, ecc.BN254.ScalarField()); err != nil {
+		t.Fatalf("expected circuit to be solved: %v", err)
+	}
+
+	// the aggregates must be the ones the child proof commits to
+	signersVotingPower := assignment.Children[0].SignersVotingPower
+	assignment.Children[0].SignersVotingPower = new(big.Int).Add(signersVotingPower.(*big.Int), big.NewInt(1))
+	if err := test.IsSolved(placeholder, assignment, ecc.BN254.ScalarField()); err == nil {
+		t.Fatal("expected circuit not to be solved")
+	}
+	assignment.Children[0].SignersVotingPower = signersVotingPower
+
+	// children proven in the wrong order break the hash chain
+	assignment.Children[0], assignment.Children[1] = assignment.Children[1], assignment.Children[0]
+	if err := test.IsSolved(placeholder, assignment, ecc.BN254.ScalarField()); err == nil {
+		t.Fatal("expected circuit not to be solved")
+	}
+}
+
+func TestAddAggKeys(t *testing.T) {
+	_, _, g1, _ := bn254.Generators()
+	var p, negP bn254.G1Affine
+	p.ScalarMultiplication(&g1, big.NewInt(5))
+	negP.Neg(&p)
+
+	for _, tc := range []struct {
+		name string
+		p, q bn254.G1Affine
+	}{
+		{"both zero", bn254.G1Affine{}, bn254.G1Affine{}},
+		{"left zero", bn254.G1Affine{}, p},
+		{"right zero", p, bn254.G1Affine{}},
+		{"double", p, p},
+		{"negated", p, negP},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var expected bn254.G1Affine
+			expected.Add(&tc.p, &tc.q)
+			assignment := &addAggKeysCircuit{
+				P:   sw_bn254.NewG1Affine(tc.p),
+				Q:   sw_bn254.NewG1Affine(tc.q),
+				Sum: sw_bn254.NewG1Affine(expected),
+			}
+			if err := test.IsSolved(&addAggKeysCircuit{}, assignment, ecc.BN254.ScalarField()); err != nil {
+				t.Fatalf("expected circuit to be solved: %v", err)
+			}
+		})
+	}
+}
+
+// addAggKeysCircuit asserts that addAggKeys returns Sum for P and Q.
+type addAggKeysCircuit struct {
+	P, Q, Sum sw_bn254.G1Affine
+}
+
+func (c *addAggKeysCircuit) Define(api frontend.API) error {
+	apis, err := newCircuitApis(api, true)
+	if err != nil {
+		return err
+	}
+	sum, err := apis.addAggKeys(&c.P, &c.Q)
+	if err != nil {
+		return err
+	}
+	apis.curve.AssertIsEqual(sum, &c.Sum)
+	return nil
+}
+
+func TestAggregationCircuit(t *testing.T) {
+	p := &RecursiveProver{
+		chunkSize: 2 * testChunkSize,
+		nbChunks:  1,
+	}
+	cs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &ChunkCircuit{ValidatorData: make([]ValidatorDataCircuit, p.chunkSize)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk, vk, err := groth16.Setup(cs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.chunk = recursiveKeys{cs: cs, pk: pk, vk: vk}
+
+	valset, err := normalizeValset(CircuitVersionV3, genValset(3, []int{1}), p.MaxValidators())
+	if err != nil {
+		t.Fatal(err)
+	}
+	message := testMessageG1(t)
+	aggSignature, aggKeyG2, _ := getAggSignature(message, &valset)
+	proveInput := ProveInput{
+		ValidatorData:   valset,
+		MessageG1:       message,
+		Signature:       *aggSignature,
+		SignersAggKeyG2: *aggKeyG2,
+	}
+
+	assignment, err := p.aggregationAssignment(proveInput)
+	if err != nil {
+		t.Fatal(err)
+	}
+	placeholder, err := aggregationPlaceholder(p.chunk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the aggregation exposes the input hash of the non-recursive circuit
+	var expected Circuit
+	setCircuitData(&expected, proveInput)
+	if assignment.InputHash.(*big.Int).Cmp(expected.InputHash.(*big.Int)) != 0 {
+		t.Fatal("input hash differs from the non-recursive circuit")
+	}
+
+	if err := test.IsSolved(placeholder, assignment, ecc.BN254.ScalarField()); err != nil {
+		t.Fatalf("expected circuit to be solved: %v", err)
+	}
+
+	// the aggregates must be the ones the root proof commits to
+	assignment.Root.SignersVotingPower = new(big.Int).Add(assignment.Root.SignersVotingPower.(*big.Int), big.NewInt(1))
+	if err := test.IsSolved(placeholder, assignment, ecc.BN254.ScalarField()); err == nil {
+		t.Fatal("expected circuit not to be solved")
+	}
+}
+
+// TestNewRecursiveProverLayout rejects layouts that are not a binary tree of chunks.
+func TestNewRecursiveProverLayout(t *testing.T) {
+	for _, layout := range [][2]int{{0, 2}, {2, 0}, {2, 3}, {2, 6}} {
+		if _, err := NewRecursiveProver(layout[0], layout[1]); err == nil {
+			t.Fatalf("expected layout %dx%d to be rejected", layout[0], layout[1])
+		}
+	}
+}
+
+// TestRecursiveProverInvalidVotingPower rejects voting powers a chunk can't hash, before any key is needed.
+func TestRecursiveProverInvalidVotingPower(t *testing.T) {
+	p := &RecursiveProver{chunkSize: 2, nbChunks: 2}
+	for name, votingPower := range map[string]*big.Int{"nil": nil, "negative": big.NewInt(-1)} {
+		valset := genValset(3, []int{1})
+		valset[1].VotingPower = votingPower
+		message := testMessageG1(t)
+		aggSignature, aggKeyG2, _ := getAggSignature(message, &valset)
+		_, err := p.Prove(ProveInput{
+			ValidatorData:   valset,
+			MessageG1:       message,
+			Signature:       *aggSignature,
+			SignersAggKeyG2: *aggKeyG2,
+		})
+		if err == nil {
+			t.Fatalf("expected a %s voting power to be rejected", name)
+		}
+	}
+}
+
+// TestRecursiveProver proves a valset of 4 single-validator chunks end to end: the chunk proofs, two merge levels
+// and the aggregation proof, with the keys of a fresh setup. It takes about an hour on a single core.
+func TestRecursiveProver(t *testing.T) {
+	if testing.Short() {
+		t.Skip("recursive setup and proving are slow")
+	}
+	prevCircuitsDir := circuitsDir
+	InitCircuitsDir(t.TempDir())
+	t.Cleanup(func() { InitCircuitsDir(prevCircuitsDir) })
+
+	prover, err := NewRecursiveProver(1, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(prover.merges) != 2 {
+		t.Fatalf("expected 2 merge levels, got %d", len(prover.merges))
+	}
+
+	valset := genValset(3, []int{1})
+	message := testMessageG1(t)
+	aggSignature, aggKeyG2, _ := getAggSignature(message, &valset)
+	proofData, err := prover.Prove(ProveInput{
+		ValidatorData:   valset,
+		MessageG1:       message,
+		Signature:       *aggSignature,
+		SignersAggKeyG2: *aggKeyG2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proofData.SignersAggVotingPower.Cmp(big.NewInt(200)) != 0 {
+		t.Fatalf("expected signers voting power 200, got %s", proofData.SignersAggVotingPower)
+	}
+
+	normalized, err := normalizeValset(CircuitVersionV3, valset, prover.MaxValidators())
+	if err != nil {
+		t.Fatal(err)
+	}
+	inputHash := calculateInputHash(HashValset(normalized), proofData.SignersAggVotingPower, &message)
+	if ok, err := prover.Verify(inputHash, proofData.Marshal()); err != nil || !ok {
+		t.Fatalf("expected proof to verify: %v", err)
+	}
+	inputHash[31] ^= 1
+	if ok, _ := prover.Verify(inputHash, proofData.Marshal()); ok {
+		t.Fatal("expected proof not to verify against another input hash")
+	}
+}
+
+// BenchmarkCompileAggregation reports the number of R1CS constraints of a 100-validator chunk, of the merge of two
+// chunk proofs and of the aggregation of a chunk proof, e.g.
+//
+//	go test -run '^$' -bench CompileAggregation -benchtime 1x ./pkg/proof
+func BenchmarkCompileAggregation(b *testing.B) {
+	chunkCs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &ChunkCircuit{
+		ValidatorData: make([]ValidatorDataCircuit, 100),
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Run("chunk/100", func(b *testing.B) {
+		b.ReportMetric(float64(chunkCs.GetNbConstraints()), "constraints")
+	})
+
+	_, chunkVk, err := groth16.Setup(chunkCs)
+	if err != nil {
+		b.Fatal(err)
+	}
+	chunk := recursiveKeys{cs: chunkCs, vk: chunkVk}
+	for _, tc := range []struct {
+		name        string
+		placeholder func() (frontend.Circuit, error)
+	}{
+		{"merge", func() (frontend.Circuit, error) { return mergePlaceholder(chunk) }},
+		{"aggregation", func() (frontend.Circuit, error) { return aggregationPlaceholder(chunk) }},
+	} {
+		b.Run(tc.name, func(b *testing.B) {
+			var nbConstraints int
+			for range b.N {
+				placeholder, err := tc.placeholder()
+				if err != nil {
+					b.Fatal(err)
+				}
+				cs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, placeholder)
+				if err != nil {
+					b.Fatal(err)
+				}
+				nbConstraints = cs.GetNbConstraints()
+			}
+			b.ReportMetric(float64(nbConstraints), "constraints")
+		})
+	}
+}
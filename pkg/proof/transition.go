@@ -0,0 +1,544 @@
+package proof
+
+import (
+	"crypto/sha256"
+	"log/slog"
+	"math/big"
+	"os"
+	"strconv"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark/backend"
+	"github.com/consensys/gnark/backend/groth16"
+	"github.com/consensys/gnark/backend/solidity"
+	"github.com/consensys/gnark/constraint"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/hash/mimc"
+	gnarkSha3 "github.com/consensys/gnark/std/hash/sha3"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-errors/errors"
+)
+
+// ValSetHeader mirrors ISettlement.ValSetHeader.
+type ValSetHeader struct {
+	Version            uint8
+	RequiredKeyTag     uint8
+	Epoch              uint64 // uint48
+	CaptureTimestamp   uint64 // uint48
+	QuorumThreshold    *big.Int
+	TotalVotingPower   *big.Int
+	ValidatorsSszMRoot common.Hash // not checked by TransitionCircuit
+}
+
+var valSetHeaderCommitTypehash = crypto.Keccak256Hash([]byte("ValSetHeaderCommit(bytes32 subnetwork,uint48 epoch,bytes32 headerHash,bytes32 extraDataHash)"))
+
+// HeaderCommit is what a header commit is signed in besides the header: the EIP-712 domain separator of the
+// settlement, its subnetwork and keccak256(abi.encode(extraData)).
+type HeaderCommit struct {
+	DomainSeparator common.Hash
+	Subnetwork      common.Hash
+	ExtraDataHash   common.Hash
+}
+
+// Digest returns the digest Settlement.commitValSetHeader checks the quorum signature of,
+//
+//	keccak256(0x1901 || domainSeparator || keccak256(abi.encode(VALSET_HEADER_COMMIT_TYPEHASH, subnetwork,
+//	    epoch, keccak256(abi.encode(header)), extraDataHash)))
+//
+// The validators sign its hash to G1.
+func (c HeaderCommit) Digest(header ValSetHeader) common.Hash {
+	headerHash := header.Hash()
+	structHash := crypto.Keccak256Hash(
+		valSetHeaderCommitTypehash.Bytes(),
+		c.Subnetwork.Bytes(),
+		common.BigToHash(new(big.Int).SetUint64(header.Epoch)).Bytes(),
+		headerHash.Bytes(),
+		c.ExtraDataHash.Bytes(),
+	)
+	return crypto.Keccak256Hash([]byte("\x19\x01"), c.DomainSeparator.Bytes(), structHash.Bytes())
+}
+
+// Hash returns keccak256(abi.encode(header)), the header hash the committers of the next header sign.
+func (h ValSetHeader) Hash() common.Hash {
+	return crypto.Keccak256Hash(h.encode())
+}
+
+func (h ValSetHeader) encode() []byte {
+	words := []*big.Int{
+		new(big.Int).SetUint64(uint64(h.Version)),
+		new(big.Int).SetUint64(uint64(h.RequiredKeyTag)),
+		new(big.Int).SetUint64(h.Epoch),
+		new(big.Int).SetUint64(h.CaptureTimestamp),
+		h.QuorumThreshold,
+		h.TotalVotingPower,
+	}
+	encoded := make([]byte, 0, 32*(len(words)+1))
+	for _, w := range words {
+		encoded = append(encoded, common.BigToHash(w).Bytes()...)
+	}
+	return append(encoded, h.ValidatorsSszMRoot.Bytes()...)
+}
+
+// ValSetHeaderCircuit is the part of a ValSetHeader a TransitionCircuit doesn't take from elsewhere.
+type ValSetHeaderCircuit struct {
+	Version            frontend.Variable
+	RequiredKeyTag     frontend.Variable
+	CaptureTimestamp   frontend.Variable
+	QuorumThreshold    frontend.Variable
+	TotalVotingPower   frontend.Variable
+	ValidatorsSszMRoot [32]uints.U8 // hashed as given, not checked against the next valset
+}
+
+// HeaderCommitCircuit is a HeaderCommit in a TransitionCircuit.
+type HeaderCommitCircuit struct {
+	DomainSeparator [32]uints.U8
+	Subnetwork      [32]uints.U8
+	ExtraDataHash   [32]uints.U8
+}
+
+// TransitionCircuit proves a valset transition for light-client style header chains: signers of the current
+// valset with at least QuorumThreshold voting power signed the commit of the next header, whose hash is
+// virtually public, and that header has Epoch and the total voting power of the next valset, whose MiMC hash is
+// virtually public as well. InputHash is
+//
+//	keccak(valsetHash || quorumThreshold || epoch || nextHeaderHash || nextValsetHash || domainSeparator ||
+//	    subnetwork || extraDataHash)
+//
+// with the top three bits cleared. The circuit computes the commit digest of HeaderCommit.Digest from the
+// header fields and checks Message is its hash to G1. The SSZ root of the next valset is hashed into the header
+// as given and not checked. All V3 constraints apply.
+type TransitionCircuit struct {
+	InputHash         frontend.Variable      `gnark:",public"`
+	QuorumThreshold   frontend.Variable      `gnark:",private"` // virtually public
+	Epoch             frontend.Variable      `gnark:",private"` // virtually public
+	NextHeader        ValSetHeaderCircuit    `gnark:",private"` // virtually public through its hash
+	Commit            HeaderCommitCircuit    `gnark:",private"` // virtually public
+	Message           sw_bn254.G1Affine      `gnark:",private"`
+	Signature         sw_bn254.G1Affine      `gnark:",private"`
+	SignersAggKeyG2   sw_bn254.G2Affine      `gnark:",private"`
+	ValidatorData     []ValidatorDataCircuit `gnark:",private"`
+	NextValidatorData []ValidatorDataCircuit `gnark:",private"`
+}
+
+// epochBits bounds the uint48 epoch and capture timestamp of a header.
+const epochBits = 48
+
+// Define declares the circuit's constraints
+func (circuit *TransitionCircuit) Define(api frontend.API) error {
+	apis, err := newCircuitApis(api, true)
+	if err != nil {
+		return err
+	}
+
+	// --------------------------------------- Current valset quorum ---------------------------------------
+	acc := valsetAccumulator{
+		valsetHash:            0,
+		prevIsFiller:          0,
+		signersAggVotingPower: 0,
+		signersAggKey: &sw_bn254.G1Affine{
+			X: emulated.ValueOf[emulated.BN254Fp](0),
+			Y: emulated.ValueOf[emulated.BN254Fp](0),
+		},
+		signersAggKeyIsZero: 1,
+	}
+	for i := range circuit.ValidatorData {
+		if _, err := apis.accumulate(&acc, &circuit.ValidatorData[i], CircuitVersionV3); err != nil {
+			return err
+		}
+	}
+
+	apis.rangeChecker.Check(circuit.QuorumThreshold, signersVotingPowerBits)
+	api.AssertIsLessOrEqual(circuit.QuorumThreshold, acc.signersAggVotingPower)
+
+	// --------------------------------------- Next valset ---------------------------------------
+	nextMimcApi, err := mimc.NewMiMC(api)
+	if err != nil {
+		return err
+	}
+	nextApis := *apis
+	nextApis.mimc = &nextMimcApi
+
+	nextAcc := valsetAccumulator{valsetHash: 0, prevIsFiller: 0}
+	nextTotalVotingPower := frontend.Variable(0)
+	for i := range circuit.NextValidatorData {
+		isFiller := nextApis.absorb(&nextAcc, &circuit.NextValidatorData[i], CircuitVersionV3)
+		nextTotalVotingPower = api.Select(
+			isFiller,
+			nextTotalVotingPower,
+			api.Add(nextTotalVotingPower, circuit.NextValidatorData[i].VotingPower),
+		)
+	}
+	api.AssertIsEqual(circuit.NextHeader.TotalVotingPower, nextTotalVotingPower)
+
+	// --------------------------------------- Next header hash ---------------------------------------
+	byteValues := func(b [32]uints.U8) []uints.U8 {
+		res := make([]uints.U8, len(b))
+		for i := range res {
+			res[i] = apis.u64.ByteValueOf(b[i].Val)
+		}
+		return res
+	}
+	epochBytes, err := variableToBytesLookup(api, apis.u64, apis.rangeChecker, circuit.Epoch, epochBits)
+	if err != nil {
+		return err
+	}
+
+	headerKeccak256Api, err := gnarkSha3.NewLegacyKeccak256(api)
+	if err != nil {
+		return err
+	}
+	header := &circuit.NextHeader
+	if err := apis.writeWords(headerKeccak256Api, word{header.Version, 8}, word{header.RequiredKeyTag, 8}); err != nil {
+		return err
+	}
+	headerKeccak256Api.Write(epochBytes)
+	if err := apis.writeWords(headerKeccak256Api,
+		word{header.CaptureTimestamp, epochBits}, word{header.QuorumThreshold, signersVotingPowerBits},
+		word{header.TotalVotingPower, signersVotingPowerBits},
+	); err != nil {
+		return err
+	}
+	headerKeccak256Api.Write(byteValues(header.ValidatorsSszMRoot))
+	nextHeaderHash := headerKeccak256Api.Sum()
+
+	// --------------------------------------- Commit digest ---------------------------------------
+	domainSeparator := byteValues(circuit.Commit.DomainSeparator)
+	subnetwork := byteValues(circuit.Commit.Subnetwork)
+	extraDataHash := byteValues(circuit.Commit.ExtraDataHash)
+
+	structKeccak256Api, err := gnarkSha3.NewLegacyKeccak256(api)
+	if err != nil {
+		return err
+	}
+	structKeccak256Api.Write(uints.NewU8Array(valSetHeaderCommitTypehash.Bytes()))
+	structKeccak256Api.Write(subnetwork)
+	structKeccak256Api.Write(epochBytes)
+	structKeccak256Api.Write(nextHeaderHash)
+	structKeccak256Api.Write(extraDataHash)
+	structHash := structKeccak256Api.Sum()
+
+	digestKeccak256Api, err := gnarkSha3.NewLegacyKeccak256(api)
+	if err != nil {
+		return err
+	}
+	digestKeccak256Api.Write(uints.NewU8Array([]byte("\x19\x01")))
+	digestKeccak256Api.Write(domainSeparator)
+	digestKeccak256Api.Write(structHash)
+	digest := digestKeccak256Api.Sum()
+
+	// --------------------------------------- Prove Input consistency ---------------------------------------
+	in := signedInput{
+		InputHash:       circuit.InputHash,
+		Message:         &circuit.Message,
+		Signature:       &circuit.Signature,
+		SignersAggKeyG2: &circuit.SignersAggKeyG2,
+	}
+	apis.assertSignedPointsOnCurve(in)
+	if err := apis.assertHashToG1(digest, &circuit.Message); err != nil {
+		return err
+	}
+
+	// a non-canonical encoding of a valset hash can't reproduce the keccak preimage, so they aren't bounded
+	if err := apis.writeWords(apis.keccak256, word{acc.valsetHash, 256}, word{circuit.QuorumThreshold, signersVotingPowerBits}); err != nil {
+		return err
+	}
+	apis.keccak256.Write(epochBytes)
+	apis.keccak256.Write(nextHeaderHash)
+	if err := apis.writeWords(apis.keccak256, word{nextAcc.valsetHash, 256}); err != nil {
+		return err
+	}
+	apis.keccak256.Write(domainSeparator)
+	apis.keccak256.Write(subnetwork)
+	apis.keccak256.Write(extraDataHash)
+	inputDataHash := apis.keccak256.Sum()
+
+	apis.assertMaskedInputHash(true, inputDataHash, circuit.InputHash)
+
+	// --------------------------------------- Verify Signature ---------------------------------------
+	return apis.assertSignature(true, in, acc.signersAggKey)
+}
+
+// TransitionInput is the data a TransitionProver proves a valset transition from.
+type TransitionInput struct {
+	ValidatorData     []ValidatorData // current valset, signers are the committers of NextHeader
+	QuorumThreshold   *big.Int        // quorum threshold of the current header
+	NextHeader        ValSetHeader
+	NextValidatorData []ValidatorData
+	Commit            HeaderCommit // the signers signed HashToG1(Commit.Digest(NextHeader))
+	Signature         bn254.G1Affine
+	SignersAggKeyG2   bn254.G2Affine
+}
+
+// TransitionInputHash returns the public input of a TransitionCircuit, for the current valset hash and quorum
+// threshold, the next header, its commit and the MiMC hash of the next valset. The SSZ root of header enters
+// through the header hash only.
+func TransitionInputHash(valsetHash []byte, quorumThreshold *big.Int, header ValSetHeader, commit HeaderCommit, nextValsetHash []byte) common.Hash {
+	headerHash := header.Hash()
+
+	var preimage []byte
+	preimage = append(preimage, valsetHash...)
+	preimage = append(preimage, common.BigToHash(quorumThreshold).Bytes()...)
+	preimage = append(preimage, common.BigToHash(new(big.Int).SetUint64(header.Epoch)).Bytes()...)
+	preimage = append(preimage, headerHash.Bytes()...)
+	preimage = append(preimage, nextValsetHash...)
+	preimage = append(preimage, commit.DomainSeparator.Bytes()...)
+	preimage = append(preimage, commit.Subnetwork.Bytes()...)
+	preimage = append(preimage, commit.ExtraDataHash.Bytes()...)
+	return common.BigToHash(maskInputHash(crypto.Keccak256(preimage)))
+}
+
+func setTransitionCircuitData(circuit *TransitionCircuit, input TransitionInput) {
+	setValidatorData(&circuit.ValidatorData, input.ValidatorData)
+	setValidatorData(&circuit.NextValidatorData, input.NextValidatorData)
+
+	header := input.NextHeader
+	circuit.QuorumThreshold = input.QuorumThreshold
+	circuit.Epoch = header.Epoch
+	circuit.NextHeader = ValSetHeaderCircuit{
+		Version:          header.Version,
+		RequiredKeyTag:   header.RequiredKeyTag,
+		CaptureTimestamp: header.CaptureTimestamp,
+		QuorumThreshold:  header.QuorumThreshold,
+		TotalVotingPower: header.TotalVotingPower,
+	}
+	for i := range header.ValidatorsSszMRoot {
+		circuit.NextHeader.ValidatorsSszMRoot[i] = uints.NewU8(header.ValidatorsSszMRoot[i])
+	}
+	for i := range 32 {
+		circuit.Commit.DomainSeparator[i] = uints.NewU8(input.Commit.DomainSeparator[i])
+		circuit.Commit.Subnetwork[i] = uints.NewU8(input.Commit.Subnetwork[i])
+		circuit.Commit.ExtraDataHash[i] = uints.NewU8(input.Commit.ExtraDataHash[i])
+	}
+
+	message, _ := HashToG1(input.Commit.Digest(header))
+	circuit.Message = sw_bn254.NewG1Affine(message)
+	circuit.Signature = sw_bn254.NewG1Affine(input.Signature)
+	circuit.SignersAggKeyG2 = sw_bn254.NewG2Affine(input.SignersAggKeyG2)
+
+	circuit.InputHash = TransitionInputHash(
+		HashValset(input.ValidatorData),
+		input.QuorumThreshold,
+		header,
+		input.Commit,
+		HashValset(input.NextValidatorData),
+	).Big()
+}
+
+// prepareTransitionWitness normalizes input, checks it against the transition circuit and assigns the full
+// witness. It needs no proving keys.
+func prepareTransitionWitness(input TransitionInput) (preparedWitness, error) {
+	tier, err := SelectTier(max(len(input.ValidatorData), len(input.NextValidatorData)))
+	if err != nil {
+		return preparedWitness{}, err
+	}
+
+	// voting powers are hashed as 32-byte words, the header fields as their ABI types
+	for _, valset := range [][]ValidatorData{input.ValidatorData, input.NextValidatorData} {
+		for i := range valset {
+			if !isWord(valset[i].VotingPower) {
+				return preparedWitness{}, errors.Errorf("voting power of validator %d is not a uint256", i)
+			}
+		}
+	}
+	if !isWord(input.QuorumThreshold) {
+		return preparedWitness{}, errors.Errorf("quorum threshold %v is not a uint256", input.QuorumThreshold)
+	}
+	header := input.NextHeader
+	if !isWord(header.QuorumThreshold) {
+		return preparedWitness{}, errors.Errorf("next header quorum threshold %v is not a uint256", header.QuorumThreshold)
+	}
+	if !isWord(header.TotalVotingPower) {
+		return preparedWitness{}, errors.Errorf("next header total voting power %v is not a uint256", header.TotalVotingPower)
+	}
+	if header.Epoch >= 1<<epochBits {
+		return preparedWitness{}, errors.Errorf("next header epoch %d exceeds %d bits", header.Epoch, epochBits)
+	}
+	if header.CaptureTimestamp >= 1<<epochBits {
+		return preparedWitness{}, errors.Errorf("next header capture timestamp %d exceeds %d bits", header.CaptureTimestamp, epochBits)
+	}
+
+	// the circuit trusts the keys, a bad one would only fail the proof. The next valset signs nothing, so it has
+	// no aggregated key to check.
+	if err := ValidateKeys(ProveInput{ValidatorData: input.ValidatorData, SignersAggKeyG2: input.SignersAggKeyG2}, KeyValidationOptions{}).Err(); err != nil {
+		return preparedWitness{}, err
+	}
+	nextReport := ValidateKeys(ProveInput{ValidatorData: input.NextValidatorData}, KeyValidationOptions{})
+	nextReport.Aggregate = nil
+	if err := nextReport.Err(); err != nil {
+		return preparedWitness{}, errors.Errorf("next valset: %w", err)
+	}
+
+	// transition circuits commit to the MiMC valset hash, in the order of the versions that do
+	input.ValidatorData, err = normalizeValset(CircuitVersionV1, input.ValidatorData, int(tier))
+	if err != nil {
+		return preparedWitness{}, err
+	}
+	input.NextValidatorData, err = normalizeValset(CircuitVersionV1, input.NextValidatorData, int(tier))
+	if err != nil {
+		return preparedWitness{}, err
+	}
+	for _, valset := range [][]ValidatorData{input.ValidatorData, input.NextValidatorData} {
+		for i := range valset {
+			if valset[i].VotingPower.BitLen() > MaxVotingPowerBits {
+				return preparedWitness{}, errors.Errorf("voting power of validator %d exceeds %d bits", i, MaxVotingPowerBits)
+			}
+		}
+	}
+
+	_, nonSignersAggVotingPower, totalVotingPower := getNonSignersData(input.ValidatorData)
+	signersAggVotingPower := new(big.Int).Sub(totalVotingPower, nonSignersAggVotingPower)
+	if signersAggVotingPower.Cmp(input.QuorumThreshold) < 0 {
+		return preparedWitness{}, errors.Errorf("signers voting power %s is below quorum threshold %s", signersAggVotingPower, input.QuorumThreshold)
+	}
+	if header.QuorumThreshold.BitLen() > signersVotingPowerBits {
+		return preparedWitness{}, errors.Errorf("next header quorum threshold %s exceeds %d bits", header.QuorumThreshold, signersVotingPowerBits)
+	}
+	_, _, nextTotalVotingPower := getNonSignersData(input.NextValidatorData)
+	if header.TotalVotingPower.Cmp(nextTotalVotingPower) != 0 {
+		return preparedWitness{}, errors.Errorf("next header total voting power %s is not the next valset's %s", header.TotalVotingPower, nextTotalVotingPower)
+	}
+	if _, counter := HashToG1(input.Commit.Digest(header)); counter >= hashToG1MaxAttempts {
+		return preparedWitness{}, errors.Errorf("hash to G1 needs %d increments, at most %d are supported", counter, hashToG1MaxAttempts-1)
+	}
+
+	assignment := TransitionCircuit{}
+	setTransitionCircuitData(&assignment, input)
+
+	witness, err := frontend.NewWitness(&assignment, ecc.BN254.ScalarField())
+	if err != nil {
+		return preparedWitness{}, errors.Errorf("failed to create witness: %w", err)
+	}
+	return preparedWitness{tier: tier, witness: witness, signersAggVotingPower: signersAggVotingPower}, nil
+}
+
+// TransitionProver proves valset transitions with a TransitionCircuit per tier. The tier fits both the
+// current and the next valset.
+type TransitionProver struct {
+	cs map[Tier]constraint.ConstraintSystem
+	pk map[Tier]groth16.ProvingKey
+	vk map[Tier]groth16.VerifyingKey
+}
+
+// transitionDir returns the directory holding the artifacts of the transition circuits.
+func transitionDir() string {
+	return circuitsDir + "/transition"
+}
+
+// NewTransitionProver loads or generates the transition artifacts of every tier in MaxValidators.
+func NewTransitionProver() (*TransitionProver, error) {
+	p := TransitionProver{
+		cs: make(map[Tier]constraint.ConstraintSystem),
+		pk: make(map[Tier]groth16.ProvingKey),
+		vk: make(map[Tier]groth16.VerifyingKey),
+	}
+
+	slog.Warn("Transition ZK prover initialization started (might take a while)")
+	for _, size := range MaxValidators {
+		tier := Tier(size)
+		cs, pk, vk, err := loadOrInitTransition(tier)
+		if err != nil {
+			return nil, err
+		}
+		p.cs[tier] = cs
+		p.pk[tier] = pk
+		p.vk[tier] = vk
+	}
+	slog.Info("Transition ZK prover initialization is done")
+
+	return &p, nil
+}
+
+// Prove generates a transition proof. Both valsets must hold active validators only, they are sorted and padded
+// to the tier of the larger one internally. The proof doesn't check the ValidatorsSszMRoot of the next header
+// against the next valset, verifiers that rely on it have to check it themselves.
+func (p *TransitionProver) Prove(input TransitionInput) (ProofData, error) {
+	prepared, err := prepareTransitionWitness(input)
+	if err != nil {
+		return ProofData{}, err
+	}
+	tier, witness, signersAggVotingPower := prepared.tier, prepared.witness, prepared.signersAggVotingPower
+	cs, ok := p.cs[tier]
+	if !ok {
+		return ProofData{}, errors.Errorf("failed to load cs, vk, pk for tier: %d", tier)
+	}
+
+	publicWitness, err := witness.Public()
+	if err != nil {
+		return ProofData{}, errors.Errorf("failed to get public witness: %w", err)
+	}
+
+	proof, err := groth16.Prove(cs, p.pk[tier], witness, backend.WithProverHashToFieldFunction(sha256.New()))
+	if err != nil {
+		return ProofData{}, errors.Errorf("failed to prove: %w", err)
+	}
+	err = groth16.Verify(proof, p.vk[tier], publicWitness, backend.WithVerifierHashToFieldFunction(sha256.New()))
+	if err != nil {
+		return ProofData{}, err
+	}
+
+	return groth16ProofData(proof, signersAggVotingPower)
+}
+
+// Verify checks proofBytes against publicInputHash using the tier selected for totalActiveValidators, the
+// larger count of the current and the next valset.
+func (p *TransitionProver) Verify(totalActiveValidators int, publicInputHash common.Hash, proofBytes []byte) (bool, error) {
+	tier, err := SelectTier(totalActiveValidators)
+	if err != nil {
+		return false, err
+	}
+	vk, ok := p.vk[tier]
+	if !ok {
+		return false, errors.Errorf("failed to find verification key for tier %d", tier)
+	}
+
+	publicWitness, err := publicInputWitness(publicInputHash, false)
+	if err != nil {
+		return false, err
+	}
+	if err := verifyGroth16(vk, proofBytes, publicWitness); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func loadOrInitTransition(tier Tier) (constraint.ConstraintSystem, groth16.ProvingKey, groth16.VerifyingKey, error) {
+	dir := transitionDir()
+	suffix := strconv.Itoa(int(tier))
+	if exists(r1csPathTmp(dir, suffix)) && exists(pkPathTmp(dir, suffix)) && exists(vkPathTmp(dir, suffix)) && exists(solPathTmp(dir, suffix)) {
+		return readGroth16(dir, suffix)
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, nil, nil, err
+	}
+	cs, err := frontend.Compile(bn254.ID.ScalarField(), r1cs.NewBuilder, &TransitionCircuit{
+		ValidatorData:     make([]ValidatorDataCircuit, tier),
+		NextValidatorData: make([]ValidatorDataCircuit, tier),
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	pk, vk, err := groth16.Setup(cs)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if err := writeGroth16(dir, suffix, cs, pk, vk); err != nil {
+		return nil, nil, nil, err
+	}
+
+	f, err := os.Create(solPathTmp(dir, suffix))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	defer f.Close()
+	if err := vk.ExportSolidity(f, solidity.WithHashToFieldFunction(sha256.New())); err != nil {
+		return nil, nil, nil, err
+	}
+	return cs, pk, vk, nil
+}
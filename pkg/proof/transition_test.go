@@ -0,0 +1,243 @@
+package proof
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/test"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func testNextHeader(nextValset []ValidatorData) ValSetHeader {
+	totalVotingPower := new(big.Int)
+	for i := range nextValset {
+		totalVotingPower.Add(totalVotingPower, nextValset[i].VotingPower)
+	}
+	return ValSetHeader{
+		Version:            1,
+		RequiredKeyTag:     15,
+		Epoch:              2,
+		CaptureTimestamp:   1_700_000_000,
+		QuorumThreshold:    big.NewInt(200),
+		TotalVotingPower:   totalVotingPower,
+		ValidatorsSszMRoot: common.HexToHash("0x1234"),
+	}
+}
+
+var testHeaderCommit = HeaderCommit{
+	DomainSeparator: common.HexToHash("0xd0"),
+	Subnetwork:      common.HexToHash("0x5b"),
+	ExtraDataHash:   common.HexToHash("0xed"),
+}
+
+// newTestTransition returns a placeholder and a satisfying assignment for a transition from a valset of three
+// validators with one non-signer to a valset of four validators.
+func newTestTransition(t *testing.T, quorumThreshold *big.Int, nextHeader func([]ValidatorData) ValSetHeader) (*TransitionCircuit, *TransitionCircuit) {
+	t.Helper()
+	valset := padValset(genValset(3, []int{1}), testCircuitSize)
+	nextValset := genValset(4, nil)
+	header := nextHeader(nextValset)
+	message, _ := HashToG1(testHeaderCommit.Digest(header))
+	aggSignature, aggKeyG2, _ := getAggSignature(message, &valset)
+
+	assignment := &TransitionCircuit{}
+	setTransitionCircuitData(assignment, TransitionInput{
+		ValidatorData:     valset,
+		QuorumThreshold:   quorumThreshold,
+		NextHeader:        header,
+		NextValidatorData: nextValset,
+		Commit:            testHeaderCommit,
+		Signature:         *aggSignature,
+		SignersAggKeyG2:   *aggKeyG2,
+	})
+
+	placeholder := &TransitionCircuit{
+		ValidatorData:     make([]ValidatorDataCircuit, testCircuitSize),
+		NextValidatorData: make([]ValidatorDataCircuit, testCircuitSize),
+	}
+	return placeholder, assignment
+}
+
+func TestValSetHeaderHash(t *testing.T) {
+	header := testNextHeader(genValset(4, nil))
+
+	tuple, err := abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{Name: "version", Type: "uint8"},
+		{Name: "requiredKeyTag", Type: "uint8"},
+		{Name: "epoch", Type: "uint48"},
+		{Name: "captureTimestamp", Type: "uint48"},
+		{Name: "quorumThreshold", Type: "uint256"},
+		{Name: "totalVotingPower", Type: "uint256"},
+		{Name: "validatorsSszMRoot", Type: "bytes32"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded, err := abi.Arguments{{Type: tuple}}.Pack(struct {
+		Version            uint8
+		RequiredKeyTag     uint8
+		Epoch              *big.Int
+		CaptureTimestamp   *big.Int
+		QuorumThreshold    *big.Int
+		TotalVotingPower   *big.Int
+		ValidatorsSszMRoot [32]byte
+	}{
+		Version:            header.Version,
+		RequiredKeyTag:     header.RequiredKeyTag,
+		Epoch:              new(big.Int).SetUint64(header.Epoch),
+		CaptureTimestamp:   new(big.Int).SetUint64(header.CaptureTimestamp),
+		QuorumThreshold:    header.QuorumThreshold,
+		TotalVotingPower:   header.TotalVotingPower,
+		ValidatorsSszMRoot: header.ValidatorsSszMRoot,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if header.Hash() != crypto.Keccak256Hash(encoded) {
+		t.Fatalf("header hash %s doesn't match abi.encode", header.Hash())
+	}
+}
+
+func TestTransitionCircuitValid(t *testing.T) {
+	placeholder, assignment := newTestTransition(t, big.NewInt(200), testNextHeader)
+	if err := test.IsSolved(placeholder, assignment, ecc.BN254.ScalarField()); err != nil {
+		t.Fatalf("expected circuit to be solved: %v", err)
+	}
+}
+
+func TestTransitionCircuitQuorumNotReached(t *testing.T) {
+	// two signers with 100 voting power each
+	placeholder, assignment := newTestTransition(t, big.NewInt(201), testNextHeader)
+	if err := test.IsSolved(placeholder, assignment, ecc.BN254.ScalarField()); err == nil {
+		t.Fatal("expected circuit not to be solved")
+	}
+}
+
+func TestTransitionCircuitWrongTotalVotingPower(t *testing.T) {
+	placeholder, assignment := newTestTransition(t, big.NewInt(200), func(nextValset []ValidatorData) ValSetHeader {
+		header := testNextHeader(nextValset)
+		header.TotalVotingPower = new(big.Int).Add(header.TotalVotingPower, big.NewInt(1))
+		return header
+	})
+	if err := test.IsSolved(placeholder, assignment, ecc.BN254.ScalarField()); err == nil {
+		t.Fatal("expected circuit not to be solved")
+	}
+}
+
+func TestTransitionCircuitWrongMessage(t *testing.T) {
+	// signed by the committers, but not the commit message of the header
+	placeholder, assignment := newTestTransition(t, big.NewInt(200), testNextHeader)
+	valset := padValset(genValset(3, []int{1}), testCircuitSize)
+	message := testMessageG1(t)
+	aggSignature, _, _ := getAggSignature(message, &valset)
+	assignment.Message = sw_bn254.NewG1Affine(message)
+	assignment.Signature = sw_bn254.NewG1Affine(*aggSignature)
+	if err := test.IsSolved(placeholder, assignment, ecc.BN254.ScalarField()); err == nil {
+		t.Fatal("expected circuit not to be solved")
+	}
+}
+
+func TestTransitionCircuitWrongCommit(t *testing.T) {
+	// the header was signed in another subnetwork
+	placeholder, assignment := newTestTransition(t, big.NewInt(200), testNextHeader)
+	commit := testHeaderCommit
+	commit.Subnetwork = common.HexToHash("0x5c")
+	valset := padValset(genValset(3, []int{1}), testCircuitSize)
+	header := testNextHeader(genValset(4, nil))
+	assignment.Commit.Subnetwork[31] = uints.NewU8(0x5c)
+	assignment.InputHash = TransitionInputHash(HashValset(valset), big.NewInt(200), header, commit, HashValset(genValset(4, nil))).Big()
+	if err := test.IsSolved(placeholder, assignment, ecc.BN254.ScalarField()); err == nil {
+		t.Fatal("expected circuit not to be solved")
+	}
+}
+
+func TestTransitionInputInvalid(t *testing.T) {
+	valset := genValset(3, []int{1})
+	nextValset := genValset(4, nil)
+	header := testNextHeader(nextValset)
+	message, _ := HashToG1(testHeaderCommit.Digest(header))
+	aggSignature, aggKeyG2, _ := getAggSignature(message, &valset)
+	input := TransitionInput{
+		ValidatorData:     valset,
+		QuorumThreshold:   big.NewInt(200),
+		NextHeader:        header,
+		NextValidatorData: nextValset,
+		Commit:            testHeaderCommit,
+		Signature:         *aggSignature,
+		SignersAggKeyG2:   *aggKeyG2,
+	}
+	if _, err := prepareTransitionWitness(input); err != nil {
+		t.Fatal(err)
+	}
+
+	tooWide := new(big.Int).Lsh(big.NewInt(1), 256)
+	tests := map[string]func(input *TransitionInput){
+		"nil quorum threshold":          func(input *TransitionInput) { input.QuorumThreshold = nil },
+		"negative quorum threshold":     func(input *TransitionInput) { input.QuorumThreshold = big.NewInt(-1) },
+		"quorum not reached":            func(input *TransitionInput) { input.QuorumThreshold = big.NewInt(201) },
+		"nil voting power":              func(input *TransitionInput) { input.ValidatorData[0].VotingPower = nil },
+		"wide next voting power":        func(input *TransitionInput) { input.NextValidatorData[0].VotingPower = tooWide },
+		"nil next quorum threshold":     func(input *TransitionInput) { input.NextHeader.QuorumThreshold = nil },
+		"wide next quorum threshold":    func(input *TransitionInput) { input.NextHeader.QuorumThreshold = tooWide },
+		"nil next total voting power":   func(input *TransitionInput) { input.NextHeader.TotalVotingPower = nil },
+		"wrong next total voting power": func(input *TransitionInput) { input.NextHeader.TotalVotingPower = big.NewInt(401) },
+		"wide next epoch":               func(input *TransitionInput) { input.NextHeader.Epoch = 1 << epochBits },
+		"wrong signers aggregated key":  func(input *TransitionInput) { input.SignersAggKeyG2 = input.ValidatorData[0].KeyG2 },
+		"mismatched key":                func(input *TransitionInput) { input.ValidatorData[2].KeyG2 = input.ValidatorData[0].KeyG2 },
+		"mismatched next key":           func(input *TransitionInput) { input.NextValidatorData[3].KeyG2 = input.NextValidatorData[0].KeyG2 },
+	}
+	for name, tamper := range tests {
+		t.Run(name, func(t *testing.T) {
+			tampered := input
+			tampered.ValidatorData = append([]ValidatorData(nil), valset...)
+			tampered.NextValidatorData = append([]ValidatorData(nil), nextValset...)
+			tamper(&tampered)
+			if _, err := prepareTransitionWitness(tampered); err == nil {
+				t.Fatal("prepared a witness")
+			}
+		})
+	}
+}
+
+func TestTransitionInputHash(t *testing.T) {
+	_, assignment := newTestTransition(t, big.NewInt(200), testNextHeader)
+	header := testNextHeader(genValset(4, nil))
+	valset := padValset(genValset(3, []int{1}), testCircuitSize)
+	inputHash := TransitionInputHash(HashValset(valset), big.NewInt(200), header, testHeaderCommit, HashValset(genValset(4, nil)))
+	if inputHash.Big().Cmp(assignment.InputHash.(*big.Int)) != 0 {
+		t.Fatal("input hash differs from the assignment's")
+	}
+	// the SSZ root is not checked, but bound through the header hash
+	header.ValidatorsSszMRoot = common.HexToHash("0x1235")
+	if TransitionInputHash(HashValset(valset), big.NewInt(200), header, testHeaderCommit, HashValset(genValset(4, nil))) == inputHash {
+		t.Fatal("input hash doesn't depend on the SSZ root")
+	}
+}
+
+// BenchmarkCompileTransition reports the number of R1CS constraints of the transition circuit of the smallest
+// tier, e.g.
+//
+//	go test -run '^$' -bench CompileTransition -benchtime 1x ./pkg/proof
+func BenchmarkCompileTransition(b *testing.B) {
+	tier := MaxValidators[0]
+	var nbConstraints int
+	for range b.N {
+		cs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &TransitionCircuit{
+			ValidatorData:     make([]ValidatorDataCircuit, tier),
+			NextValidatorData: make([]ValidatorDataCircuit, tier),
+		})
+		if err != nil {
+			b.Fatal(err)
+		}
+		nbConstraints = cs.GetNbConstraints()
+	}
+	b.ReportMetric(float64(nbConstraints), "constraints")
+}
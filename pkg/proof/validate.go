@@ -0,0 +1,226 @@
+package proof
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/go-errors/errors"
+)
+
+// PossessionProof is a signature of a message by a validator's key. It proves possession of the key if the
+// message commits to the key, as the KeyRegistry ownership message does (see keyregistry.PossessionProof).
+type PossessionProof struct {
+	MessageHash [32]byte // signed as HashToG1(MessageHash)
+	Signature   bn254.G1Affine
+	KeyG2       bn254.G2Affine // the key in G2, if the validator data has none
+}
+
+// KeyValidationOptions configure ValidateKeys.
+type KeyValidationOptions struct {
+	// ProofsOfPossession are checked for the validators they are given for, by G1 key.
+	ProofsOfPossession map[bn254.G1Affine]PossessionProof
+	// RequirePossession fails validators without a proof of possession.
+	RequirePossession bool
+}
+
+// KeyReport is the result of the key checks of one validator.
+type KeyReport struct {
+	Index             int // in ProveInput.ValidatorData
+	Key               bn254.G1Affine
+	IsNonSigner       bool
+	HasKeyG2          bool
+	PossessionChecked bool
+	Err               error // the first failed check, nil if the keys are valid
+}
+
+// KeyValidationReport is the result of ValidateKeys.
+type KeyValidationReport struct {
+	Validators []KeyReport
+	// Aggregate is the failed check of the signers' aggregated keys, nil if they are consistent.
+	Aggregate error
+	// Unchecked is why the G1/G2 pairs couldn't be checked, e.g. no randomness for the batch weights. The keys
+	// aren't blamed then, but the report isn't valid either.
+	Unchecked error
+}
+
+// Invalid returns the reports of the validators whose keys failed a check.
+func (r KeyValidationReport) Invalid() []KeyReport {
+	var invalid []KeyReport
+	for _, validator := range r.Validators {
+		if validator.Err != nil {
+			invalid = append(invalid, validator)
+		}
+	}
+	return invalid
+}
+
+// Err summarizes the failed checks, or returns nil if there is none.
+func (r KeyValidationReport) Err() error {
+	invalid := r.Invalid()
+	if len(invalid) == 0 && r.Aggregate == nil && r.Unchecked == nil {
+		return nil
+	}
+	var problems []string
+	for _, validator := range invalid {
+		problems = append(problems, fmt.Sprintf("validator %d: %v", validator.Index, validator.Err))
+	}
+	if r.Aggregate != nil {
+		problems = append(problems, fmt.Sprintf("signers' aggregated key: %v", r.Aggregate))
+	}
+	if r.Unchecked != nil {
+		problems = append(problems, fmt.Sprintf("G2 keys unchecked: %v", r.Unchecked))
+	}
+	return errors.Errorf("invalid keys: %s", strings.Join(problems, "; "))
+}
+
+// ValidateKeys checks the keys of proveInput, which the circuit trusts, so that bad keys are reported by validator
+// instead of failing the proof:
+//
+//   - every key is a point of G1, and its G2 key, when the validator data has one, a point of G2
+//   - e(key, g2) = e(g1, keyG2) for every validator with a G2 key
+//   - SignersAggKeyG2 is a point of G2 and e(Σ signers' keys, g2) = e(g1, SignersAggKeyG2)
+//   - the proofs of possession of opts: e(signature, g2) = e(HashToG1(messageHash), keyG2)
+//
+// The G1/G2 pairs are checked in one randomized batch, and one by one only if the batch fails. If the batch
+// weights can't be drawn, the report's Unchecked holds the error.
+func ValidateKeys(proveInput ProveInput, opts KeyValidationOptions) KeyValidationReport {
+	report := KeyValidationReport{Validators: make([]KeyReport, len(proveInput.ValidatorData))}
+	_, _, g1, g2 := bn254.Generators()
+
+	var pairs []int
+	var signersAggKey bn254.G1Affine
+	for i := range proveInput.ValidatorData {
+		validator := &proveInput.ValidatorData[i]
+		r := &report.Validators[i]
+		*r = KeyReport{Index: i, Key: validator.Key, IsNonSigner: validator.IsNonSigner, HasKeyG2: !validator.KeyG2.IsInfinity()}
+		if r.Err = checkG1Key(validator.Key); r.Err != nil {
+			continue
+		}
+		if !validator.IsNonSigner {
+			signersAggKey.Add(&signersAggKey, &validator.Key)
+		}
+		if r.HasKeyG2 {
+			if r.Err = checkG2Key(validator.KeyG2); r.Err != nil {
+				continue
+			}
+			pairs = append(pairs, i)
+		}
+		pop, ok := opts.ProofsOfPossession[validator.Key]
+		if !ok {
+			if opts.RequirePossession {
+				r.Err = errors.Errorf("no proof of possession")
+			}
+			continue
+		}
+		r.PossessionChecked = true
+		r.Err = checkPossession(validator, pop)
+	}
+
+	if len(pairs) > 0 {
+		ok, err := keyPairsBatch(proveInput.ValidatorData, pairs)
+		if err != nil {
+			report.Unchecked = err
+		} else if !ok {
+			for _, i := range pairs {
+				if report.Validators[i].Err == nil && !keysMatch(proveInput.ValidatorData[i].Key, proveInput.ValidatorData[i].KeyG2, g1, g2) {
+					report.Validators[i].Err = errors.Errorf("G2 key doesn't match the key")
+				}
+			}
+		}
+	}
+
+	if err := checkG2Key(proveInput.SignersAggKeyG2); err != nil {
+		report.Aggregate = err
+	} else if !keysMatch(signersAggKey, proveInput.SignersAggKeyG2, g1, g2) {
+		report.Aggregate = errors.Errorf("SignersAggKeyG2 doesn't match the sum of the signers' keys")
+	}
+	return report
+}
+
+func checkG1Key(key bn254.G1Affine) error {
+	if key.IsInfinity() {
+		return errors.Errorf("key is the point at infinity")
+	}
+	if !key.IsOnCurve() || !key.IsInSubGroup() {
+		return errors.Errorf("key is not a point of G1")
+	}
+	return nil
+}
+
+func checkG2Key(key bn254.G2Affine) error {
+	if key.IsInfinity() {
+		return errors.Errorf("G2 key is the point at infinity")
+	}
+	if !key.IsOnCurve() {
+		return errors.Errorf("G2 key is not on the curve")
+	}
+	if !key.IsInSubGroup() {
+		return errors.Errorf("G2 key is not in the subgroup")
+	}
+	return nil
+}
+
+// checkPossession checks pop is a signature by the validator's key, and its G2 key the validator's one.
+func checkPossession(validator *ValidatorData, pop PossessionProof) error {
+	keyG2 := validator.KeyG2
+	if keyG2.IsInfinity() {
+		if err := checkG2Key(pop.KeyG2); err != nil {
+			return errors.Errorf("proof of possession: %w", err)
+		}
+		keyG2 = pop.KeyG2
+		_, _, g1, g2 := bn254.Generators()
+		if !keysMatch(validator.Key, keyG2, g1, g2) {
+			return errors.Errorf("proof of possession: G2 key doesn't match the key")
+		}
+	} else if !pop.KeyG2.IsInfinity() && !pop.KeyG2.Equal(&keyG2) {
+		return errors.Errorf("proof of possession: G2 key differs from the validator's")
+	}
+	if !pop.Signature.IsOnCurve() || pop.Signature.IsInfinity() {
+		return errors.Errorf("proof of possession: signature is not a point of G1")
+	}
+
+	_, _, _, g2 := bn254.Generators()
+	message, _ := HashToG1(pop.MessageHash)
+	var negMessage bn254.G1Affine
+	negMessage.Neg(&message)
+	ok, err := bn254.PairingCheck([]bn254.G1Affine{pop.Signature, negMessage}, []bn254.G2Affine{g2, keyG2})
+	if err != nil || !ok {
+		return errors.Errorf("proof of possession: invalid signature")
+	}
+	return nil
+}
+
+// keysMatch reports whether e(key, g2) = e(g1, keyG2).
+func keysMatch(key bn254.G1Affine, keyG2 bn254.G2Affine, g1 bn254.G1Affine, g2 bn254.G2Affine) bool {
+	var negG1 bn254.G1Affine
+	negG1.Neg(&g1)
+	ok, err := bn254.PairingCheck([]bn254.G1Affine{key, negG1}, []bn254.G2Affine{g2, keyG2})
+	return err == nil && ok
+}
+
+// keyPairsBatch checks e(key_i, g2) = e(g1, keyG2_i) for the validators of indices at once, with random 128-bit
+// weights r_i:
+//
+//	e(Σ r_i·key_i, -g2) · Π e(r_i·g1, keyG2_i) = 1
+func keyPairsBatch(valset []ValidatorData, indices []int) (bool, error) {
+	_, _, g1, g2 := bn254.Generators()
+	p := make([]bn254.G1Affine, 1, len(indices)+1)
+	q := make([]bn254.G2Affine, 1, len(indices)+1)
+	q[0].Neg(&g2)
+	var term bn254.G1Affine
+	for _, i := range indices {
+		r, err := randomWeight()
+		if err != nil {
+			return false, err
+		}
+		term.ScalarMultiplication(&valset[i].Key, r)
+		p[0].Add(&p[0], &term)
+		var weighted bn254.G1Affine
+		weighted.ScalarMultiplication(&g1, r)
+		p = append(p, weighted)
+		q = append(q, valset[i].KeyG2)
+	}
+	ok, err := bn254.PairingCheck(p, q)
+	return err == nil && ok, nil
+}
@@ -0,0 +1,145 @@
+package proof
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/go-errors/errors"
+)
+
+func newTestValidationInput(t *testing.T, valset []ValidatorData) ProveInput {
+	t.Helper()
+	message := testMessageG1(t)
+	aggSignature, aggKeyG2, _ := getAggSignature(message, &valset)
+	return ProveInput{
+		ValidatorData:   valset,
+		MessageG1:       message,
+		Signature:       *aggSignature,
+		SignersAggKeyG2: *aggKeyG2,
+		QuorumThreshold: big.NewInt(100),
+	}
+}
+
+func TestValidateKeys(t *testing.T) {
+	input := newTestValidationInput(t, genValset(4, []int{1}))
+	// like the KeyRegistry's, the non-signer has no G2 key
+	input.ValidatorData[1].KeyG2 = bn254.G2Affine{}
+	report := ValidateKeys(input, KeyValidationOptions{})
+	if err := report.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if report.Validators[1].HasKeyG2 || !report.Validators[1].IsNonSigner || !report.Validators[2].HasKeyG2 {
+		t.Fatalf("got reports %+v", report.Validators)
+	}
+
+	// validator 2 registered validator 3's G2 key
+	input.ValidatorData[2].KeyG2 = input.ValidatorData[3].KeyG2
+	report = ValidateKeys(input, KeyValidationOptions{})
+	if invalid := report.Invalid(); len(invalid) != 1 || invalid[0].Index != 2 {
+		t.Fatalf("got invalid validators %+v", invalid)
+	}
+	if report.Aggregate != nil {
+		t.Fatalf("aggregate failed: %v", report.Aggregate)
+	}
+}
+
+func TestValidateKeysNotInSubgroup(t *testing.T) {
+	var u bn254.E2
+	u.A0.SetUint64(7)
+	point := bn254.MapToCurve2(&u)
+
+	input := newTestValidationInput(t, genValset(3, nil))
+	input.ValidatorData[0].KeyG2 = point
+	report := ValidateKeys(input, KeyValidationOptions{})
+	if invalid := report.Invalid(); len(invalid) != 1 || invalid[0].Index != 0 {
+		t.Fatalf("got invalid validators %+v", invalid)
+	}
+
+	input = newTestValidationInput(t, genValset(3, nil))
+	input.SignersAggKeyG2 = point
+	if report := ValidateKeys(input, KeyValidationOptions{}); report.Aggregate == nil || len(report.Invalid()) != 0 {
+		t.Fatalf("got report %+v", report)
+	}
+}
+
+func TestValidateKeysAggregate(t *testing.T) {
+	// SignersAggKeyG2 of another signer set
+	input := newTestValidationInput(t, genValset(3, []int{1}))
+	other := newTestValidationInput(t, genValset(3, nil))
+	input.SignersAggKeyG2 = other.SignersAggKeyG2
+	report := ValidateKeys(input, KeyValidationOptions{})
+	if report.Aggregate == nil || len(report.Invalid()) != 0 {
+		t.Fatalf("got report %+v", report)
+	}
+
+	_, err := prepareWitness(Config{CircuitVersion: CircuitVersionV2}, input)
+	if err == nil {
+		t.Fatal("prepared a witness with an inconsistent aggregated key")
+	}
+}
+
+func TestValidateKeysPossession(t *testing.T) {
+	input := newTestValidationInput(t, genValset(3, nil))
+	proofs := make(map[bn254.G1Affine]PossessionProof)
+	for i, validator := range input.ValidatorData[:2] {
+		messageHash := [32]byte{byte(i)}
+		message, _ := HashToG1(messageHash)
+		signature, err := testSigner(validator.Key).Sign(message)
+		if err != nil {
+			t.Fatal(err)
+		}
+		proofs[validator.Key] = PossessionProof{MessageHash: messageHash, Signature: signature, KeyG2: validator.KeyG2}
+	}
+
+	report := ValidateKeys(input, KeyValidationOptions{ProofsOfPossession: proofs})
+	if err := report.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if !report.Validators[0].PossessionChecked || report.Validators[2].PossessionChecked {
+		t.Fatalf("got reports %+v", report.Validators)
+	}
+	report = ValidateKeys(input, KeyValidationOptions{ProofsOfPossession: proofs, RequirePossession: true})
+	if invalid := report.Invalid(); len(invalid) != 1 || invalid[0].Index != 2 {
+		t.Fatalf("got invalid validators %+v", invalid)
+	}
+
+	// the proof of validator 1 signs another message
+	forged := proofs[input.ValidatorData[1].Key]
+	forged.MessageHash[0]++
+	proofs[input.ValidatorData[1].Key] = forged
+	report = ValidateKeys(input, KeyValidationOptions{ProofsOfPossession: proofs})
+	if invalid := report.Invalid(); len(invalid) != 1 || invalid[0].Index != 1 {
+		t.Fatalf("got invalid validators %+v", invalid)
+	}
+
+	// without a G2 key in the validator data, the proof's is bound to the key
+	input.ValidatorData[0].KeyG2 = bn254.G2Affine{}
+	pop := proofs[input.ValidatorData[0].Key]
+	pop.KeyG2 = input.ValidatorData[2].KeyG2
+	proofs[input.ValidatorData[0].Key] = pop
+	report = ValidateKeys(input, KeyValidationOptions{ProofsOfPossession: proofs})
+	if report.Validators[0].Err == nil {
+		t.Fatal("accepted a proof of possession with another G2 key")
+	}
+}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.Errorf("no entropy")
+}
+
+func TestValidateKeysNoRandomness(t *testing.T) {
+	weightSource = failingReader{}
+	t.Cleanup(func() { weightSource = rand.Reader })
+
+	report := ValidateKeys(newTestValidationInput(t, genValset(3, nil)), KeyValidationOptions{})
+	if report.Unchecked == nil || report.Err() == nil {
+		t.Fatalf("got report %+v", report)
+	}
+	if invalid := report.Invalid(); len(invalid) != 0 {
+		t.Fatalf("blamed validators %+v", invalid)
+	}
+}
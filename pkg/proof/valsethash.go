@@ -0,0 +1,223 @@
+package proof
+
+import (
+	"math/big"
+	"strconv"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/poseidon2"
+	"github.com/consensys/gnark/frontend"
+)
+
+// ValsetHashFunction is the hash a circuit version commits to the valset with. Its digest is stored in the
+// settlement's extra data under ExtraDataName.
+type ValsetHashFunction int
+
+const (
+	// ValsetHashMimc hashes the 64-bit limbs of every key and the 32-byte voting power with MiMC.
+	ValsetHashMimc ValsetHashFunction = iota
+	// ValsetHashPoseidon2 hashes the 128-bit halves of every key coordinate and the voting power with the
+	// width 2 Poseidon2 compression of gnark-crypto in Merkle-Damgard mode.
+	ValsetHashPoseidon2
+	// ValsetHashKeccak256 is the commitment of SigVerifierBlsBn254Simple: keccak256 of the number of validators
+	// followed by every compressed key and voting power, see Config.CompressedKeys.
+	ValsetHashKeccak256
+)
+
+func (f ValsetHashFunction) String() string {
+	switch f {
+	case ValsetHashMimc:
+		return "mimc"
+	case ValsetHashPoseidon2:
+		return "poseidon2"
+	case ValsetHashKeccak256:
+		return "keccak256"
+	default:
+		return "unknown(" + strconv.Itoa(int(f)) + ")"
+	}
+}
+
+// ExtraDataName returns the extra-data name the valset hash is committed under, e.g. validatorSetHashMimc.
+func (f ValsetHashFunction) ExtraDataName() string {
+	switch f {
+	case ValsetHashPoseidon2:
+		return "validatorSetHashPoseidon2"
+	case ValsetHashKeccak256:
+		return "validatorSetHashKeccak256"
+	default:
+		return "validatorSetHashMimc"
+	}
+}
+
+// ValsetHash returns the valset hash function of the circuit version: Poseidon2 from V5 on, MiMC before.
+func (v CircuitVersion) ValsetHash() ValsetHashFunction {
+	if v >= CircuitVersionV5 {
+		return ValsetHashPoseidon2
+	}
+	return ValsetHashMimc
+}
+
+// HashValsetWith hashes a normalized valset with f. Every function stops at the first filler entry like
+// HashValset, so padding a valset doesn't change its hash.
+func HashValsetWith(f ValsetHashFunction, valset []ValidatorData) []byte {
+	switch f {
+	case ValsetHashPoseidon2:
+		return hashValsetPoseidon2(valset)
+	case ValsetHashKeccak256:
+		return hashValsetKeccak256(valset)
+	default:
+		return HashValset(valset)
+	}
+}
+
+// hashValsetPoseidon2 computes the Poseidon2 valset hash of a normalized valset: the coordinate halves of every
+// key and its voting power, one 32-byte block each.
+func hashValsetPoseidon2(valset []ValidatorData) []byte {
+	h := poseidon2.NewMerkleDamgardHasher()
+	for i := range valset {
+		if valset[i].Key.IsInfinity() {
+			break
+		}
+
+		// every block is a 32-byte word, the coordinate halves are left-padded with zeros
+		xBytes := valset[i].Key.X.Bytes()
+		yBytes := valset[i].Key.Y.Bytes()
+		for _, half := range [][]byte{xBytes[:16], xBytes[16:], yBytes[:16], yBytes[16:]} {
+			block := make([]byte, 32)
+			copy(block[16:], half)
+			h.Write(block)
+		}
+
+		votingPowerBuf := make([]byte, 32)
+		valset[i].VotingPower.FillBytes(votingPowerBuf)
+		h.Write(votingPowerBuf)
+	}
+	return h.Sum(nil)
+}
+
+// writeValidator feeds a validator into the valset hash of the circuit.
+// Keccak commitments are computed from the compressed keys instead, see compressedValsetHash.
+func (a *circuitApis) writeValidator(validator *ValidatorDataCircuit) {
+	switch a.valsetHash {
+	case ValsetHashKeccak256:
+		return
+	case ValsetHashMimc:
+		hashAffineG1(a.mimc, &validator.Key)
+		a.mimc.Write(validator.VotingPower)
+		return
+	}
+	// the emulated field checks the limbs of the key to 64 bits, so packing two of them is injective
+	x, y := validator.Key.X.Limbs, validator.Key.Y.Limbs
+	a.poseidon2.Write(
+		a.packLimbs(x[3], x[2]), a.packLimbs(x[1], x[0]),
+		a.packLimbs(y[3], y[2]), a.packLimbs(y[1], y[0]),
+		validator.VotingPower,
+	)
+}
+
+// valsetDigest returns the valset hash over the validators written so far. It is zero for keccak commitments,
+// which don't fit a native variable.
+func (a *circuitApis) valsetDigest() frontend.Variable {
+	switch a.valsetHash {
+	case ValsetHashKeccak256:
+		return 0
+	case ValsetHashPoseidon2:
+		return a.poseidon2.Sum()
+	default:
+		return a.mimc.Sum()
+	}
+}
+
+// packLimbs returns hi*2^64 + lo.
+func (a *circuitApis) packLimbs(hi, lo frontend.Variable) frontend.Variable {
+	return a.api.Add(a.api.Mul(hi, new(big.Int).Lsh(big.NewInt(1), 64)), lo)
+}
+
+// poseidon2Hasher mirrors poseidon2.NewMerkleDamgardHasher of gnark-crypto in-circuit: every written element is
+// a block, compressed into the state by the width 2 permutation with the default parameters. gnark's own
+// Poseidon2 gadget doesn't build against gnark-crypto v0.17.
+type poseidon2Hasher struct {
+	api       frontend.API
+	roundKeys [][]frontend.Variable
+	nbFull    int
+	nbPartial int
+	state     frontend.Variable
+	data      []frontend.Variable
+}
+
+func newPoseidon2Hasher(api frontend.API) *poseidon2Hasher {
+	params := poseidon2.GetDefaultParameters()
+	roundKeys := make([][]frontend.Variable, len(params.RoundKeys))
+	for i := range params.RoundKeys {
+		roundKeys[i] = make([]frontend.Variable, len(params.RoundKeys[i]))
+		for j := range params.RoundKeys[i] {
+			roundKeys[i][j] = params.RoundKeys[i][j].BigInt(new(big.Int))
+		}
+	}
+	return &poseidon2Hasher{
+		api:       api,
+		roundKeys: roundKeys,
+		nbFull:    params.NbFullRounds,
+		nbPartial: params.NbPartialRounds,
+		state:     0,
+	}
+}
+
+func (h *poseidon2Hasher) Write(data ...frontend.Variable) {
+	h.data = append(h.data, data...)
+}
+
+// Sum compresses the pending blocks into the state and returns it. Like MiMC, the state carries over, so Sum
+// can be called after every validator.
+func (h *poseidon2Hasher) Sum() frontend.Variable {
+	for _, block := range h.data {
+		h.state = h.compress(h.state, block)
+	}
+	h.data = nil
+	return h.state
+}
+
+func (h *poseidon2Hasher) Reset() {
+	h.state = 0
+	h.data = nil
+}
+
+// compress is poseidon2.Permutation.Compress: the right half of the permuted pair plus the right input.
+func (h *poseidon2Hasher) compress(left, right frontend.Variable) frontend.Variable {
+	x := [2]frontend.Variable{left, right}
+	h.permute(&x)
+	return h.api.Add(x[1], right)
+}
+
+func (h *poseidon2Hasher) permute(x *[2]frontend.Variable) {
+	api := h.api
+	h.matMulExternal(x)
+
+	rf := h.nbFull / 2
+	for i := range h.nbFull + h.nbPartial {
+		if i < rf || i >= rf+h.nbPartial {
+			x[0] = h.sBox(api.Add(x[0], h.roundKeys[i][0]))
+			x[1] = h.sBox(api.Add(x[1], h.roundKeys[i][1]))
+			h.matMulExternal(x)
+			continue
+		}
+		x[0] = h.sBox(api.Add(x[0], h.roundKeys[i][0]))
+		// internal matrix [[2,1],[1,3]]
+		sum := api.Add(x[0], x[1])
+		x[0] = api.Add(x[0], sum)
+		x[1] = api.Add(api.Mul(x[1], 2), sum)
+	}
+}
+
+// matMulExternal multiplies by circ(2,1).
+func (h *poseidon2Hasher) matMulExternal(x *[2]frontend.Variable) {
+	sum := h.api.Add(x[0], x[1])
+	x[0] = h.api.Add(x[0], sum)
+	x[1] = h.api.Add(x[1], sum)
+}
+
+// sBox returns x^5.
+func (h *poseidon2Hasher) sBox(x frontend.Variable) frontend.Variable {
+	x2 := h.api.Mul(x, x)
+	x4 := h.api.Mul(x2, x2)
+	return h.api.Mul(x4, x)
+}
@@ -0,0 +1,75 @@
+package proof
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/poseidon2"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test"
+)
+
+type poseidon2Circuit struct {
+	Blocks [3]frontend.Variable
+	Digest frontend.Variable `gnark:",public"`
+}
+
+func (c *poseidon2Circuit) Define(api frontend.API) error {
+	h := newPoseidon2Hasher(api)
+	h.Write(c.Blocks[:2]...)
+	h.Sum()
+	h.Write(c.Blocks[2])
+	api.AssertIsEqual(h.Sum(), c.Digest)
+	return nil
+}
+
+func TestPoseidon2Hasher(t *testing.T) {
+	blocks := [3]*big.Int{big.NewInt(1), new(big.Int).Lsh(big.NewInt(1), 200), big.NewInt(7)}
+	h := poseidon2.NewMerkleDamgardHasher()
+	for _, block := range blocks {
+		buf := make([]byte, 32)
+		block.FillBytes(buf)
+		h.Write(buf)
+	}
+
+	assignment := &poseidon2Circuit{Digest: new(big.Int).SetBytes(h.Sum(nil))}
+	for i := range blocks {
+		assignment.Blocks[i] = blocks[i]
+	}
+	if err := test.IsSolved(&poseidon2Circuit{}, assignment, ecc.BN254.ScalarField()); err != nil {
+		t.Fatalf("expected circuit to be solved: %v", err)
+	}
+
+	assignment.Blocks[2] = big.NewInt(8)
+	if err := test.IsSolved(&poseidon2Circuit{}, assignment, ecc.BN254.ScalarField()); err == nil {
+		t.Fatal("expected circuit not to be solved")
+	}
+}
+
+func TestHashValsetWith(t *testing.T) {
+	valset := padValset(genValset(3, nil), testCircuitSize)
+	if string(HashValsetWith(ValsetHashMimc, valset)) != string(HashValset(valset)) {
+		t.Fatal("expected the MiMC valset hash to be HashValset")
+	}
+	poseidon2Hash := HashValsetWith(ValsetHashPoseidon2, valset)
+	if string(poseidon2Hash) == string(HashValset(valset)) {
+		t.Fatal("expected the Poseidon2 valset hash to differ from MiMC")
+	}
+	// fillers end the valset
+	if string(HashValsetWith(ValsetHashPoseidon2, valset[:3])) != string(poseidon2Hash) {
+		t.Fatal("expected fillers not to change the Poseidon2 valset hash")
+	}
+
+	for _, tc := range []struct {
+		version CircuitVersion
+		name    string
+	}{
+		{CircuitVersionV4, "validatorSetHashMimc"},
+		{CircuitVersionV5, "validatorSetHashPoseidon2"},
+	} {
+		if name := tc.version.ValsetHash().ExtraDataName(); name != tc.name {
+			t.Fatalf("version %d: extra data name = %s, want %s", tc.version, name, tc.name)
+		}
+	}
+}
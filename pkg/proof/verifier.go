@@ -0,0 +1,91 @@
+package proof
+
+import (
+	"crypto/sha256"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark/backend/groth16"
+	"github.com/consensys/gnark/backend/plonk"
+	"github.com/consensys/gnark/backend/solidity"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+)
+
+// ExportVerifier writes the Solidity verifier of tier for the configured circuit from its verifying key on disk,
+// the same Verifier_N.sol the setup writes.
+func ExportVerifier(cfg Config, tier Tier, w io.Writer) error {
+	if cfg.Backend == BackendPlonk {
+		vk, err := readPlonkVerifyingKey(plonkDir(cfg), strconv.Itoa(int(tier)))
+		if err != nil {
+			return err
+		}
+		if err := vk.ExportSolidity(w); err != nil {
+			return errors.Errorf("failed to export verifier: %w", err)
+		}
+		return nil
+	}
+
+	vk, err := readVerifyingKey(cfg.artifactsDir(), strconv.Itoa(int(tier)))
+	if err != nil {
+		return err
+	}
+	if err := vk.ExportSolidity(w, solidity.WithHashToFieldFunction(sha256.New())); err != nil {
+		return errors.Errorf("failed to export verifier: %w", err)
+	}
+	return nil
+}
+
+// readVerifyingKey loads the Groth16 verifying key stored under suffix in dir.
+func readVerifyingKey(dir, suffix string) (groth16.VerifyingKey, error) {
+	f, err := os.Open(vkPathTmp(dir, suffix))
+	if err != nil {
+		return nil, errors.Errorf("failed to open vk: %w", err)
+	}
+	defer f.Close()
+	vk := groth16.NewVerifyingKey(bn254.ID)
+	if _, err := vk.UnsafeReadFrom(f); err != nil {
+		return nil, errors.Errorf("failed to read vk: %w", err)
+	}
+	return vk, nil
+}
+
+// readPlonkVerifyingKey loads the PLONK verifying key stored under suffix in dir.
+func readPlonkVerifyingKey(dir, suffix string) (plonk.VerifyingKey, error) {
+	f, err := os.Open(vkPathTmp(dir, suffix))
+	if err != nil {
+		return nil, errors.Errorf("failed to open vk: %w", err)
+	}
+	defer f.Close()
+	vk := plonk.NewVerifyingKey(bn254.ID)
+	if _, err := vk.UnsafeReadFrom(f); err != nil {
+		return nil, errors.Errorf("failed to read vk: %w", err)
+	}
+	return vk, nil
+}
+
+// VerifyProof checks a proof like ZkProver.Verify, loading only the verifying key of the selected tier from disk.
+// Missing artifacts are an error, the setup is never run.
+func VerifyProof(cfg Config, totalActiveValidators int, publicInputHash common.Hash, proofBytes []byte) (bool, error) {
+	tier, err := SelectTier(totalActiveValidators)
+	if err != nil {
+		return false, err
+	}
+	if err := checkManifest(cfg.artifactsDir(), cfg); err != nil {
+		return false, err
+	}
+	p := newZkProver(cfg)
+	suffix := strconv.Itoa(int(tier))
+	if cfg.Backend == BackendPlonk {
+		if p.plonkVk[tier], err = readPlonkVerifyingKey(plonkDir(cfg), suffix); err != nil {
+			return false, err
+		}
+	} else {
+		if p.vk[tier], err = readVerifyingKey(cfg.artifactsDir(), suffix); err != nil {
+			return false, err
+		}
+	}
+	return p.Verify(totalActiveValidators, publicInputHash, proofBytes)
+}
@@ -0,0 +1,22 @@
+package proof
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+// TestExportVerifier checks the verifier exported from the cached verifying key is the one the setup wrote.
+func TestExportVerifier(t *testing.T) {
+	var exported bytes.Buffer
+	if err := ExportVerifier(DefaultConfig(), 10, &exported); err != nil {
+		t.Fatal(err)
+	}
+	written, err := os.ReadFile(solPathTmp(DefaultConfig().artifactsDir(), "10"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(exported.Bytes(), written) {
+		t.Fatal("exported verifier differs from Verifier_10.sol")
+	}
+}
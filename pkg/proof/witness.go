@@ -0,0 +1,156 @@
+package proof
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"math/big"
+	"os"
+	"strconv"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark/backend/witness"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/go-errors/errors"
+)
+
+// WitnessFileVersion is the format version of WitnessFile.
+const WitnessFileVersion = 1
+
+// WitnessFile is a full circuit witness exported for proving on another machine, e.g. a GPU prover without access
+// to the validators' key material. It only holds the circuit assignment: public keys, voting powers, signer
+// flags, the aggregated signature and the message, never a private key.
+type WitnessFile struct {
+	Version  int              `json:"version"`
+	Manifest ArtifactManifest `json:"manifest"`
+	Backend  Backend          `json:"backend"`
+	Tier     Tier             `json:"tier"`
+	// Fingerprint is the sha256 of the verifying key of the tier, see ArtifactFingerprint.
+	Fingerprint           common.Hash   `json:"fingerprint"`
+	SignersAggVotingPower *big.Int      `json:"signersAggVotingPower"`
+	SignerBitmap          hexutil.Bytes `json:"signerBitmap,omitempty"`
+	// Witness is the gnark binary encoding of the full witness.
+	Witness hexutil.Bytes `json:"witness"`
+}
+
+// ArtifactFingerprint returns the sha256 of the verifying key file of tier for the configured circuit. A witness
+// is only proven with keys of the same fingerprint, so a proof always matches the exported Verifier_N.sol.
+func ArtifactFingerprint(cfg Config, tier Tier) (common.Hash, error) {
+	dir := cfg.artifactsDir()
+	if cfg.Backend == BackendPlonk {
+		dir = plonkDir(cfg)
+	}
+	data, err := os.ReadFile(vkPathTmp(dir, strconv.Itoa(int(tier))))
+	if err != nil {
+		return common.Hash{}, errors.Errorf("failed to read vk: %w", err)
+	}
+	return sha256.Sum256(data), nil
+}
+
+// Fingerprint returns the fingerprint of the verifying key the prover loaded for tier, see ArtifactFingerprint.
+func (p *ZkProver) Fingerprint(tier Tier) (common.Hash, error) {
+	h := sha256.New()
+	if p.cfg.Backend == BackendPlonk {
+		vk, ok := p.plonkVk[tier]
+		if !ok {
+			return common.Hash{}, errors.Errorf("failed to load vk for tier: %d", tier)
+		}
+		if _, err := vk.WriteRawTo(h); err != nil {
+			return common.Hash{}, errors.Errorf("failed to write vk: %w", err)
+		}
+	} else {
+		vk, ok := p.vk[tier]
+		if !ok {
+			return common.Hash{}, errors.Errorf("failed to load vk for tier: %d", tier)
+		}
+		if _, err := vk.WriteRawTo(h); err != nil {
+			return common.Hash{}, errors.Errorf("failed to write vk: %w", err)
+		}
+	}
+	return common.BytesToHash(h.Sum(nil)), nil
+}
+
+// ExportWitness builds the full witness of proveInput for the configured circuit and encodes it as a WitnessFile.
+// It runs every check Prove runs before proving and only needs the verifying key of the tier on disk.
+func ExportWitness(cfg Config, proveInput ProveInput) ([]byte, error) {
+	prepared, err := prepareWitness(cfg, proveInput)
+	if err != nil {
+		return nil, err
+	}
+	fingerprint, err := ArtifactFingerprint(cfg, prepared.tier)
+	if err != nil {
+		return nil, err
+	}
+	witnessBytes, err := prepared.witness.MarshalBinary()
+	if err != nil {
+		return nil, errors.Errorf("failed to encode witness: %w", err)
+	}
+
+	data, err := json.MarshalIndent(WitnessFile{
+		Version:               WitnessFileVersion,
+		Manifest:              cfg.Manifest(),
+		Backend:               cfg.Backend,
+		Tier:                  prepared.tier,
+		Fingerprint:           fingerprint,
+		SignersAggVotingPower: prepared.signersAggVotingPower,
+		SignerBitmap:          prepared.signerBitmap,
+		Witness:               witnessBytes,
+	}, "", "  ")
+	if err != nil {
+		return nil, errors.Errorf("failed to encode witness file: %w", err)
+	}
+	return data, nil
+}
+
+// ProveFromWitness proves a witness exported by ExportWitness. The witness must have been built for the circuit,
+// backend and keys the prover loaded.
+func (p *ZkProver) ProveFromWitness(data []byte) (ProofData, error) {
+	var file WitnessFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return ProofData{}, errors.Errorf("failed to decode witness file: %w", err)
+	}
+	if file.Version != WitnessFileVersion {
+		return ProofData{}, errors.Errorf("unsupported witness file version %d", file.Version)
+	}
+	if file.Manifest != p.cfg.Manifest() {
+		return ProofData{}, errors.Errorf("witness was built for %+v, not %+v", file.Manifest, p.cfg.Manifest())
+	}
+	if file.Backend != p.cfg.Backend {
+		return ProofData{}, errors.Errorf("witness was built for backend %s, not %s", file.Backend, p.cfg.Backend)
+	}
+	fingerprint, err := p.Fingerprint(file.Tier)
+	if err != nil {
+		return ProofData{}, err
+	}
+	if file.Fingerprint != fingerprint {
+		return ProofData{}, errors.Errorf("witness was built for keys %s, loaded keys are %s", file.Fingerprint, fingerprint)
+	}
+	if file.SignersAggVotingPower == nil {
+		return ProofData{}, errors.Errorf("witness file has no signers voting power")
+	}
+
+	fullWitness, err := witness.New(ecc.BN254.ScalarField())
+	if err != nil {
+		return ProofData{}, errors.Errorf("failed to create witness: %w", err)
+	}
+	if err := fullWitness.UnmarshalBinary(file.Witness); err != nil {
+		return ProofData{}, errors.Errorf("failed to decode witness: %w", err)
+	}
+	// the one wire is not part of the witness
+	cs := p.cs[file.Tier]
+	if nbWitness, nbCircuit := len(fullWitness.Vector().(fr.Vector)), cs.GetNbPublicVariables()-1+cs.GetNbSecretVariables(); nbWitness != nbCircuit {
+		return ProofData{}, errors.Errorf("witness has %d variables, the circuit has %d", nbWitness, nbCircuit)
+	}
+
+	var signerBitmap []byte
+	if len(file.SignerBitmap) > 0 {
+		signerBitmap = file.SignerBitmap
+	}
+	return p.proveWitness(preparedWitness{
+		tier:                  file.Tier,
+		witness:               fullWitness,
+		signersAggVotingPower: file.SignersAggVotingPower,
+		signerBitmap:          signerBitmap,
+	})
+}
@@ -0,0 +1,82 @@
+package proof
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// TestProveFromWitness exports a witness, proves it the way a separate prover would and checks a witness built
+// for other keys or another circuit is rejected.
+func TestProveFromWitness(t *testing.T) {
+	prover := NewZkProver()
+
+	valset := genValset(10, []int{2})
+	validatorData, err := NormalizeValset(CircuitVersionV1, valset)
+	if err != nil {
+		t.Fatal(err)
+	}
+	messageG1 := testMessageG1(t)
+	aggSignature, aggKeyG2, _ := getAggSignature(messageG1, &valset)
+
+	data, err := ExportWitness(DefaultConfig(), ProveInput{
+		ValidatorData:   valset,
+		MessageG1:       messageG1,
+		Signature:       *aggSignature,
+		SignersAggKeyG2: *aggKeyG2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(strings.ToLower(string(data)), "privatekey") {
+		t.Fatal("witness file contains private keys")
+	}
+
+	var file WitnessFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		t.Fatal(err)
+	}
+	fingerprint, err := prover.Fingerprint(file.Tier)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file.Tier != 10 || file.Fingerprint != fingerprint {
+		t.Fatalf("witness file is for tier %d and keys %s, want tier 10 and keys %s", file.Tier, file.Fingerprint, fingerprint)
+	}
+
+	proofData, err := prover.ProveFromWitness(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inputHash := calculateInputHash(HashValset(validatorData), proofData.SignersAggVotingPower, &messageG1)
+	ok, err := prover.Verify(len(valset), inputHash, proofData.Marshal())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("failed to verify")
+	}
+
+	tampered := file
+	tampered.Fingerprint = common.Hash{1}
+	if _, err := prover.ProveFromWitness(marshalWitnessFile(t, tampered)); err == nil {
+		t.Fatal("witness for other keys was proven")
+	}
+
+	tampered = file
+	tampered.Manifest.CircuitVersion = CircuitVersionV4
+	if _, err := prover.ProveFromWitness(marshalWitnessFile(t, tampered)); err == nil {
+		t.Fatal("witness for another circuit was proven")
+	}
+}
+
+func marshalWitnessFile(t *testing.T, file WitnessFile) []byte {
+	t.Helper()
+	data, err := json.Marshal(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}